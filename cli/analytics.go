@@ -0,0 +1,247 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HandleAnalyticsCommand handles reports computed from the cached tasks
+func (c *CLI) HandleAnalyticsCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpAnalyticsCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "capacity", "cap":
+		c.HandleCapacityCommand()
+	case "stale", "st":
+		c.HandleStaleCommand()
+	case "cycle-time", "ct":
+		c.HandleCycleTimeCommand()
+	default:
+		c.HelpAnalyticsCommand()
+	}
+}
+
+func (c *CLI) HelpAnalyticsCommand() {
+	fmt.Println("Analytics Commands:")
+	fmt.Println("  analytics capacity (cap) [-sprint <name>]  Story points per assignee in the current sprint")
+	fmt.Println("  analytics stale (st) [-days 14] [-refresh true]  Active tasks not updated for some days, per assignee")
+	fmt.Println("  analytics cycle-time (ct) [-days 30]  Time spent in each status, from the board activity log")
+}
+
+// HandleCapacityCommand sums the estimates per assignee for the current sprint
+func (c *CLI) HandleCapacityCommand() {
+	sprintName := c.reportSprintName()
+	analytics := monday.NewAnalyticsService(nil, monday.NewDataStore())
+	summary, tasks, err := analytics.Capacity(c.config.GetBoardID(), sprintName)
+	if err != nil {
+		c.exitWithoutSprintTasks(err, sprintName)
+	}
+
+	printf("🏃 Capacity for %s\n", tasks[0].Sprint)
+	printLine("=" + strings.Repeat("=", 50))
+	for _, capacity := range summary {
+		printf("👤 %-24s %6s pts  (%s done, %s remaining, %d tasks",
+			capacity.Assignee,
+			monday.FormatPoints(capacity.Points),
+			monday.FormatPoints(capacity.DonePoints),
+			monday.FormatPoints(capacity.RemainingPoints()),
+			capacity.Tasks,
+		)
+		if capacity.Unestimated > 0 {
+			printf(", %d unestimated", capacity.Unestimated)
+		}
+		printf(")\n")
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 Total: %s pts in %d tasks\n", monday.FormatPoints(monday.TotalEstimate(tasks)), len(tasks))
+}
+
+// HandleStaleCommand lists tasks in an active status that were not updated for a number of days.
+// The cached updated_at values are used unless -refresh fetches the board first.
+func (c *CLI) HandleStaleCommand() {
+	days := 14
+	refresh := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-days", "--days", "-d":
+			value, err := strconv.Atoi(flag.Value)
+			if err != nil || value < 1 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			days = value
+		case "-refresh", "--refresh", "-r":
+			refresh = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	dataStore := monday.NewDataStore()
+	if refresh {
+		progressf("🔄 Refreshing tasks of board %s...\n", boardID)
+		tasks, rawItems, err := c.newClient().GetBoardItems(boardID)
+		if err != nil {
+			exitWithError("Error fetching tasks", err)
+		}
+		users, _, _ := dataStore.GetCachedBoardUsers(boardID)
+		dataStore.StoreTasksRequest(boardID, tasks, rawItems)
+		dataStore.StoreBoardUsers(boardID, users)
+	}
+
+	now := time.Now()
+	groups, err := monday.NewAnalyticsService(nil, dataStore).StaleTasks(boardID, days, now)
+	if err != nil {
+		fmt.Println("❌ No tasks found in cache")
+		fmt.Println("💡 Run 'tasks fetch' first or pass -refresh true")
+		os.Exit(ExitNotFound)
+	}
+	if len(groups) == 0 {
+		fmt.Printf("🎉 No active tasks older than %d days\n", days)
+		return
+	}
+
+	stale := make(map[string]bool)
+	for _, group := range groups {
+		printf("\n👤 %s (%d)\n", colorize(group.Assignee, ColorCyan), len(group.Tasks))
+		for _, task := range group.Tasks {
+			age := int(now.Sub(task.UpdatedAt).Hours() / 24)
+			printf("%s. %s %s [%s] %s\n",
+				formatLocalId(task),
+				getTypeIcon(string(task.Type)),
+				task.Name,
+				colorize(string(task.Status), getStatusColor(string(task.Status))),
+				colorize(fmt.Sprintf("%dd", age), ColorYellow),
+			)
+			stale[task.ID] = true
+		}
+	}
+	printf("\n⏳ %d active tasks not updated for %d days or more\n", len(stale), days)
+}
+
+// HandleCycleTimeCommand shows the time tasks spent in each status, from the status changes in the
+// board activity log of the last days, per task and summed up over all tasks
+func (c *CLI) HandleCycleTimeCommand() {
+	days := 30
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-days", "--days", "-d":
+			value, err := strconv.Atoi(flag.Value)
+			if err != nil || value < 1 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			days = value
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	now := time.Now()
+	progressf("📜 Reading the activity log of the last %d days...\n", days)
+	analytics := monday.NewAnalyticsService(c.newClient(), monday.NewDataStore())
+	cycles, err := analytics.CycleTimes(boardID, now.AddDate(0, 0, -days), now)
+	if err != nil {
+		exitWithError("Error computing cycle times", err)
+	}
+	if len(cycles) == 0 {
+		fmt.Printf("📭 No status changes in the last %d days\n", days)
+		return
+	}
+
+	printf("⏱️  Time in status, last %d days\n", days)
+	printLine("=" + strings.Repeat("=", 50))
+	for _, cycle := range cycles {
+		state := colorize(string(cycle.Task.Status), getStatusColor(string(cycle.Task.Status)))
+		if cycle.Done() {
+			state = colorize("cycle "+formatDuration(cycle.CycleTime), ColorGreen)
+		}
+		printf("%s. %s [%s]\n", formatLocalId(cycle.Task), cycle.Task.Name, state)
+		for _, statusTime := range monday.SummarizeStatusTimes([]monday.TaskCycle{cycle}) {
+			printf("        %-20s %s\n", statusTime.Status, formatDuration(statusTime.Total))
+		}
+	}
+
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 Average per status over %d tasks\n", len(cycles))
+	for _, statusTime := range monday.SummarizeStatusTimes(cycles) {
+		printf("   %s %8s avg  %8s total  (%d tasks)\n",
+			colorize(fmt.Sprintf("%-20s", statusTime.Status), getStatusColor(string(statusTime.Status))),
+			formatDuration(statusTime.Average()),
+			formatDuration(statusTime.Total),
+			statusTime.Tasks,
+		)
+	}
+	if average, finished := monday.AverageCycleTime(cycles); finished > 0 {
+		printf("✅ Average cycle time: %s over %d finished tasks\n", formatDuration(average), finished)
+	}
+}
+
+// formatDuration formats a duration in days and hours, or minutes below an hour
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		days := int(d / (24 * time.Hour))
+		hours := int((d % (24 * time.Hour)) / time.Hour)
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	}
+}
+
+// reportSprintName returns the -sprint flag or the current sprint, exiting when there is neither
+func (c *CLI) reportSprintName() string {
+	sprintName := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-sprint", "--sprint":
+			sprintName = flag.Value
+		}
+	}
+	if sprintName == "" {
+		sprintName = c.currentSprintName()
+	}
+	if sprintName == "" {
+		fmt.Println("❌ No current sprint found")
+		fmt.Println("💡 Run 'config set-sprint-id <sprint-id>' or pass -sprint <name>")
+		os.Exit(ExitConfigMissing)
+	}
+	return sprintName
+}
+
+// exitWithoutSprintTasks explains why the analytics service found no tasks and exits
+func (c *CLI) exitWithoutSprintTasks(err error, sprintName string) {
+	if _, _, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID()); !ok {
+		fmt.Println("❌ No tasks found in cache")
+		fmt.Println("💡 Run 'tasks fetch' first to fetch tasks")
+		os.Exit(ExitNotFound)
+	}
+	if errors.Is(err, monday.ErrNotFound) {
+		fmt.Printf("❌ No cached tasks in sprint %s\n", sprintName)
+		os.Exit(ExitNotFound)
+	}
+	exitWithError("Failed to read cached tasks", err)
+}
+
+// currentSprintName resolves the configured sprint ID to a name with the cached sprints,
+// falling back to the sprint running today. Without cached sprints the ID is used as the
+// name, like the sprint filters do, unless it is 'auto'.
+func (c *CLI) currentSprintName() string {
+	sprintID := c.config.GetSprintID()
+	dataStore := monday.NewDataStore()
+	sprints, _, _ := dataStore.GetCachedBoardSprints(c.config.GetSprintBoardID())
+	if sprint, ok := monday.CurrentSprint(sprints, sprintID, time.Now()); ok {
+		return sprint.Name
+	}
+	if c.config.IsSprintAuto() {
+		return ""
+	}
+	return sprintID
+}
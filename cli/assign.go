@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// HandleTaskAssignCommand assigns a task to a user or a team, without either it unassigns the task
+func (c *CLI) HandleTaskAssignCommand() {
+	task := c.cachedTaskArg("task assign <task-index> [-user <name|id|me>] [-team <team-name>]")
+	var userArg, teamArg string
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-user", "--user", "-u":
+			userArg = flag.Value
+		case "-team", "--team":
+			teamArg = flag.Value
+		}
+	}
+	if userArg != "" && teamArg != "" {
+		fmt.Println("❌ Use either -user or -team, the owner column is replaced")
+		os.Exit(ExitValidation)
+	}
+
+	client := c.newClient()
+	boardID := c.config.GetBoardID()
+	var updatedTask *monday.Task
+	var err error
+	assignee := ""
+	switch {
+	case teamArg != "":
+		teams, teamsErr := client.GetTeams()
+		if teamsErr != nil {
+			exitWithError("Error fetching teams", teamsErr)
+		}
+		team, findErr := monday.FindTeam(teams, teamArg)
+		if findErr != nil {
+			names := make([]string, len(teams))
+			for i, t := range teams {
+				names[i] = t.Name
+			}
+			fmt.Printf("❌ %v\n", findErr)
+			fmt.Printf("💡 Teams: %s\n", strings.Join(names, ", "))
+			os.Exit(ExitNotFound)
+		}
+		assignee = "team " + team.Name
+		updatedTask, err = client.AssignTeam(boardID, task, team.ID)
+	case userArg != "":
+		user := c.resolveUser(client, userArg)
+		assignee = user.Name
+		updatedTask, err = client.AssignTask(boardID, task, user.ID)
+	default:
+		updatedTask, err = client.AssignTask(boardID, task, "")
+	}
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error assigning task", err)
+	}
+	c.saveAssignedTask(task, updatedTask)
+	if assignee == "" {
+		fmt.Printf("✅ Task %d unassigned\n", task.LocalId)
+	} else {
+		fmt.Printf("✅ Task %d assigned to %s\n", task.LocalId, assignee)
+	}
+	PrintTask(*updatedTask)
+}
+
+// resolveUser finds a user by ID, "me" or a unique match in the user directory, it exits otherwise
+func (c *CLI) resolveUser(client *monday.Client, nameOrID string) monday.User {
+	if nameOrID == "me" {
+		if !c.config.HasUserInfo() {
+			fmt.Println("❌ No user info configured")
+			fmt.Println("💡 Run 'user info' first")
+			os.Exit(ExitConfigMissing)
+		}
+		return *c.config.GetUserInfo()
+	}
+	dataStore := monday.NewDataStore()
+	if strings.Trim(nameOrID, "0123456789") == "" {
+		users, err := dataStore.ResolveUsers(client, []string{nameOrID})
+		if err == nil {
+			if user, ok := users[nameOrID]; ok {
+				return user
+			}
+		}
+		return monday.User{ID: nameOrID, Name: nameOrID}
+	}
+	directory, err := dataStore.GetUserDirectory()
+	if err != nil || directory.IsStale() {
+		if directory, err = dataStore.RefreshUserDirectory(client); err != nil {
+			exitWithError("Error loading user directory", err)
+		}
+	}
+	matches := directory.Search(nameOrID)
+	switch len(matches) {
+	case 0:
+		fmt.Printf("❌ No user matching %q\n", nameOrID)
+		os.Exit(ExitNotFound)
+	case 1:
+		return matches[0]
+	}
+	fmt.Printf("❌ %d users match %q, use the user ID:\n", len(matches), nameOrID)
+	for i, user := range matches {
+		PrintDirectoryUser(i+1, user)
+	}
+	os.Exit(ExitValidation)
+	return monday.User{}
+}
+
+// saveAssignedTask stores a reassigned task in the cache and records the change
+func (c *CLI) saveAssignedTask(task monday.Task, updatedTask *monday.Task) {
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(c.config.GetBoardID(), task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"time"
+)
+
+// oauthLoginTimeout is how long 'auth login' waits for the user to grant access in the browser
+const oauthLoginTimeout = 5 * time.Minute
+
+// HandleAuthCommand handles logging in with a Monday OAuth app
+func (c *CLI) HandleAuthCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpAuthCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "login":
+		c.HandleAuthLoginCommand()
+	case "logout":
+		c.HandleAuthLogoutCommand()
+	case "status", "st":
+		c.HandleAuthStatusCommand()
+	default:
+		c.HelpAuthCommand()
+	}
+}
+
+func (c *CLI) HelpAuthCommand() {
+	fmt.Println("Auth Commands:")
+	fmt.Println("  auth login [-client-id <id>] [-client-secret <secret>] [-scopes <scopes>] [-port 8976]")
+	fmt.Println("    Log in with a Monday OAuth app in the browser instead of a personal API token. The app")
+	fmt.Println("    must allow http://localhost:<port>/callback as redirect URL, the app settings are saved")
+	fmt.Println("  auth logout                Delete the saved OAuth token")
+	fmt.Println("  auth status (st)           Show how requests are authenticated")
+}
+
+// HandleAuthLoginCommand runs the OAuth flow in the browser, saves the token and switches to it
+func (c *CLI) HandleAuthLoginCommand() {
+	app := monday.OAuthApp{}
+	if c.config.OAuth != nil {
+		app = *c.config.OAuth
+	}
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-client-id", "--client-id":
+			app.ClientID = flag.Value
+		case "-client-secret", "--client-secret":
+			app.ClientSecret = flag.Value
+		case "-scopes", "--scopes":
+			app.Scopes = flag.Value
+		case "-port", "--port":
+			port, err := strconv.Atoi(flag.Value)
+			if err != nil || port <= 0 || port > 65535 {
+				fmt.Printf("❌ Invalid port %q\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			app.RedirectPort = port
+		}
+	}
+	if app.ClientID == "" || app.ClientSecret == "" {
+		fmt.Println("Usage: monday-cli auth login -client-id <id> -client-secret <secret> [-scopes <scopes>] [-port 8976]")
+		fmt.Println("💡 Create an app under your avatar > Developers > My apps, its OAuth section lists the credentials")
+		os.Exit(ExitValidation)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oauthLoginTimeout)
+	defer cancel()
+	httpOpts, err := c.config.HTTPOptions()
+	if err != nil {
+		exitWithError("Error in the connection settings", err)
+	}
+	transport := monday.NewHTTPClient(append(httpOpts, c.connectionFlags()...)...)
+	token, err := app.Login(ctx, transport, func(authURL string) error {
+		progressf("🌐 Opening the browser to grant access, waiting for %s\n", app.RedirectURI())
+		if err := openURL(authURL); err != nil {
+			fmt.Println("Open this URL in your browser:")
+			fmt.Println(authURL)
+		}
+		return nil
+	})
+	if err != nil {
+		exitWithError("Login failed", err)
+	}
+	if err := monday.SaveOAuthToken(monday.GetOAuthTokenPath(), token); err != nil {
+		exitWithError("Error saving token", err)
+	}
+	c.config.SetOAuth(app)
+
+	user, err := c.newClient().GetUserInfo()
+	if err != nil {
+		exitWithError("Error getting user info", err)
+	}
+	c.config.SetUserInfo(user)
+	if err := c.config.Save(monday.GetConfigPath()); err != nil {
+		exitWithError("Error saving configuration", err)
+	}
+	fmt.Printf("✅ Logged in as %s (%s)\n", user.Name, user.Email)
+	if !token.Expiry.IsZero() {
+		fmt.Printf("🔄 The token expires %s and is refreshed automatically\n", token.Expiry.Local().Format("2006-01-02 15:04"))
+	}
+}
+
+// HandleAuthLogoutCommand deletes the saved OAuth token
+func (c *CLI) HandleAuthLogoutCommand() {
+	if err := monday.DeleteOAuthToken(monday.GetOAuthTokenPath()); err != nil {
+		exitWithError("Error logging out", err)
+	}
+	fmt.Println("✅ Logged out")
+	if c.config.GetAuthProviderType() == monday.AuthOAuth {
+		fmt.Println("💡 Log in again with 'auth login', or use an API key with 'config set-api-key <api-key>'")
+	}
+}
+
+// HandleAuthStatusCommand shows the auth provider and the state of the OAuth token
+func (c *CLI) HandleAuthStatusCommand() {
+	provider := c.config.GetAuthProviderType()
+	fmt.Println("Auth Provider:", provider)
+	switch provider {
+	case monday.AuthStatic:
+		fmt.Println("API Key:", maskAPIKey(c.config.GetAPIKey()))
+	case monday.AuthCommand:
+		fmt.Println("Auth Command:", c.config.AuthCommand)
+	case monday.AuthOAuth:
+		if c.config.OAuth != nil {
+			fmt.Println("Client ID:", c.config.OAuth.ClientID)
+			fmt.Println("Redirect URL:", c.config.OAuth.RedirectURI())
+		}
+		token, err := monday.LoadOAuthToken(monday.GetOAuthTokenPath())
+		if errors.Is(err, monday.ErrNotFound) {
+			fmt.Println("Token: not logged in, run 'auth login'")
+			os.Exit(ExitConfigMissing)
+		}
+		if err != nil {
+			exitWithError("Error reading token", err)
+		}
+		fmt.Println("Token:", maskAPIKey(token.AccessToken))
+		if token.Scope != "" {
+			fmt.Println("Scopes:", token.Scope)
+		}
+		switch {
+		case token.Expiry.IsZero():
+			fmt.Println("Expires: never")
+		case token.RefreshToken != "":
+			fmt.Println("Expires:", token.Expiry.Local().Format("2006-01-02 15:04"), "(refreshed automatically)")
+		default:
+			fmt.Println("Expires:", token.Expiry.Local().Format("2006-01-02 15:04"))
+		}
+	}
+	if c.config.HasUserInfo() {
+		fmt.Printf("User: %s (%s)\n", c.config.UserName, c.config.UserEmail)
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"sort"
+)
+
+// webhookTriggers are the CLI commands whose changes fire a webhook event, for auditing
+// what reacts to them on the server
+var webhookTriggers = map[monday.WebhookEventType]string{
+	"create_item":                  "task create, quick, import, tasks create-batch, task clone, recur run, task restore",
+	"create_subitem":               "none",
+	"change_column_value":          "task edit, task start/done/block, task assign, task sprint, task describe, tasks bulk, tasks board",
+	"change_status_column_value":   "task edit -s, task start/done/block, tasks bulk, tasks board",
+	"change_specific_column_value": "changes of the column in the config",
+	"change_name":                  "task rename, task edit",
+	"create_update":                "task start/done/block -comment, comments from task pick",
+	"item_archived":                "none",
+	"item_deleted":                 "none",
+	"item_restored":                "task restore",
+	"item_moved_to_any_group":      "task move",
+	"move_item_to_board":           "task move",
+}
+
+// HandleBoardAutomationsCommand lists the webhooks subscribed to the board, the part of its
+// server-side automation the API exposes, with the CLI commands whose changes fire them
+func (c *CLI) HandleBoardAutomationsCommand() {
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+
+	client := c.newClient()
+	progressf("📡 Fetching webhooks of board %s...\n", boardID)
+	webhooks, err := client.ListWebhooks(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch webhooks", err)
+	}
+	// Column titles read better than IDs, the IDs are shown when the board cannot be read
+	columns := make(map[string]string)
+	if board, err := client.GetBoard(boardID); err == nil {
+		for _, column := range board.Columns {
+			columns[column.ID] = column.Title
+		}
+	}
+
+	if len(webhooks) == 0 {
+		fmt.Println("📭 No webhooks on the board")
+	} else {
+		sort.Slice(webhooks, func(i, j int) bool {
+			if webhooks[i].Event != webhooks[j].Event {
+				return webhooks[i].Event < webhooks[j].Event
+			}
+			return webhooks[i].ID < webhooks[j].ID
+		})
+		printf("🔔 %d webhook(s) on board %s:\n", len(webhooks), boardID)
+		for _, webhook := range webhooks {
+			event := string(webhook.Event)
+			if columnID := webhook.ConfigColumnID(); columnID != "" {
+				title := columns[columnID]
+				if title == "" {
+					title = columnID
+				}
+				event += " (" + title + ")"
+			}
+			printf("  %s %s\n", colorize(event, ColorCyan), colorize("#"+webhook.ID, ColorGray))
+			if triggers, ok := webhookTriggers[webhook.Event]; ok {
+				printf("      fired by: %s\n", triggers)
+			}
+		}
+	}
+	progressf("💡 Automation recipes are not exposed by the API, review them in the board's Automate menu\n")
+	if slug := c.config.GetAccountSlug(); slug != "" {
+		progressf("   https://%s.monday.com/boards/%s\n", slug, boardID)
+	}
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const createBatchUsage = "tasks create-batch <file | -> [-s <status>] [-p <priority>] [-t <type>] [-assignee <user|none>] [-sprint <name>] [-due <date>] [-batch <n>]"
+
+// HandleTasksCreateBatchCommand creates a task per line of a file or stdin, e.g. a list of
+// names piped from another tool. The flags set the fields of all tasks, JSON lines can set
+// their own. Every line is checked before the first task is created, then the tasks are
+// created a batch per request.
+func (c *CLI) HandleTasksCreateBatchCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + createBatchUsage)
+		fmt.Println("Lines hold a task name, or a JSON object such as")
+		fmt.Println(`  {"name": "Fix login", "priority": "high", "assignee": "alice", "sprint": "Sprint 12", "due": "friday"}`)
+		os.Exit(ExitValidation)
+	}
+
+	var defaults monday.BatchLine
+	batchSize := 10
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-status", "--status", "-s":
+			defaults.Status = flag.Value
+		case "-priority", "--priority", "-p":
+			defaults.Priority = flag.Value
+		case "-type", "--type", "-t":
+			defaults.Type = flag.Value
+		case "-assignee", "--assignee", "-a":
+			defaults.Assignee = flag.Value
+		case "-sprint", "--sprint":
+			defaults.Sprint = flag.Value
+		case "-due", "--due":
+			defaults.Due = flag.Value
+		case "-batch", "--batch", "-b":
+			n, err := strconv.Atoi(flag.Value)
+			if err != nil || n < 1 || n > 50 {
+				fmt.Printf("❌ Invalid batch size: %s (use 1 to 50)\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			batchSize = n
+		}
+	}
+
+	var input io.Reader = os.Stdin
+	source := c.command.Args[1]
+	if source == "-" {
+		if isInteractive() {
+			progressf("📝 Enter one task per line, end with Ctrl-D\n")
+		}
+	} else {
+		file, err := os.Open(source)
+		if err != nil {
+			fmt.Printf("❌ Cannot open %s: %v\n", source, err)
+			os.Exit(ExitNotFound)
+		}
+		defer file.Close()
+		input = file
+	}
+	lines, err := monday.ReadBatchLines(input)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if len(lines) == 0 {
+		fmt.Println("📭 No tasks in the input")
+		return
+	}
+
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+	assignees := make(map[string]string) // Assignee as written to user ID
+	now := time.Now()
+
+	items := make([]monday.ItemInput, 0, len(lines))
+	var problems []string
+	for _, line := range lines {
+		line = line.WithDefaults(defaults)
+		details := monday.TaskDetails{
+			Status:   labelAlias(line.Status, getStatusValue),
+			Priority: labelAlias(line.Priority, getPriorityValue),
+			Type:     labelAlias(line.Type, getTypeValue),
+		}
+		if line.Sprint != "" {
+			details.SprintID = findSprint(sprints, line.Sprint).ID
+		}
+		if line.Due != "" {
+			if details.DueDate, err = monday.ParseDueDate(line.Due, now); err != nil {
+				problems = append(problems, fmt.Sprintf("line %d: %v", line.Line, err))
+				continue
+			}
+		}
+		switch assignee := strings.ToLower(line.Assignee); assignee {
+		case "none":
+		case "":
+			details.AssigneeID = c.config.GetUserInfo().ID
+		default:
+			if _, ok := assignees[assignee]; !ok {
+				assignees[assignee] = c.resolveUser(client, line.Assignee).ID
+			}
+			details.AssigneeID = assignees[assignee]
+		}
+		item, err := monday.NewItemInput(board, line.Name, details)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line.Line, err))
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Printf("❌ %s\n", problem)
+		}
+		fmt.Println("No tasks created, fix the lines above and run again")
+		os.Exit(ExitValidation)
+	}
+
+	if c.command.HasSwitch("dry-run") {
+		fmt.Printf("🧪 Dry run, %d tasks would be created:\n", len(items))
+		for _, item := range items {
+			fmt.Printf("  %s\n", item.Name)
+		}
+		return
+	}
+
+	progressf("🚀 Creating %d tasks in batches of %d...\n", len(items), batchSize)
+	ids, createErr := client.CreateItems(boardID, items, batchSize)
+	var localIds []string
+	if len(ids) > 0 {
+		dataStore := monday.NewDataStore()
+		if tasks, rawItems, err := client.GetBoardItems(boardID); err == nil {
+			c.storeFetchedTasks(dataStore, boardID, tasks, rawItems)
+		} else {
+			fmt.Printf("⚠️  Warning: Could not refresh the cache: %v\n", err)
+		}
+		cached, _, _ := dataStore.GetCachedTasks(boardID)
+		for _, id := range ids {
+			if task, ok := cached[id]; ok {
+				c.recordHistory(monday.HistoryCreate, monday.Task{}, task, "")
+				localIds = append(localIds, strconv.Itoa(task.LocalId))
+			}
+		}
+	}
+	if createErr != nil {
+		fmt.Printf("❌ Created %d of %d tasks before an error, the first one not created is line %d\n",
+			len(ids), len(items), lines[len(ids)].Line)
+		if len(localIds) > 0 {
+			fmt.Printf("Created: %s\n", strings.Join(localIds, ", "))
+		}
+		exitWithError("Batch failed", createErr)
+	}
+	if len(localIds) < len(ids) {
+		fmt.Printf("✅ Created %d tasks\n", len(ids))
+		fmt.Println("💡 Run 'tasks fetch' to see their indexes")
+		return
+	}
+	fmt.Printf("✅ Created %d tasks: %s\n", len(ids), strings.Join(localIds, ", "))
+}
@@ -0,0 +1,521 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HandleBoardCommand handles board introspection commands
+func (c *CLI) HandleBoardCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpBoardCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleBoardListCommand()
+	case "columns", "cols", "c":
+		c.HandleBoardColumnsCommand()
+	case "activity", "act":
+		c.HandleBoardActivityCommand()
+	case "snapshot", "snap":
+		c.HandleBoardSnapshotCommand()
+	case "restore":
+		c.HandleBoardRestoreCommand()
+	case "diff":
+		c.HandleBoardDiffCommand()
+	case "trash":
+		c.HandleBoardTrashCommand()
+	case "create":
+		c.HandleBoardCreateCommand()
+	case "apply":
+		c.HandleBoardApplyCommand()
+	case "automations", "auto":
+		c.HandleBoardAutomationsCommand()
+	default:
+		c.HelpBoardCommand()
+	}
+}
+
+// HandleBoardListCommand lists the active boards of all workspaces
+func (c *CLI) HandleBoardListCommand() {
+	boards, err := monday.NewBoardService(c.newClient()).ListBoards()
+	if err != nil {
+		exitWithError("Error fetching boards", err)
+	}
+	if len(boards) == 0 {
+		fmt.Println("No boards found")
+		return
+	}
+	for _, board := range boards {
+		marker := "  "
+		if _, ok := c.config.FindBoard(board.ID); ok {
+			marker = colorize("* ", ColorGreen)
+		}
+		printf("%s%-12s %-30s %s\n", marker, board.ID, board.Name, colorize(fmt.Sprintf("%d items", board.ItemsCount), ColorGray))
+	}
+	progressf("💡 Configure a board with 'config add-board <board-id>'\n")
+}
+
+func (c *CLI) HelpBoardCommand() {
+	fmt.Println("Board Commands:")
+	fmt.Println("  board list (ls)        List all active boards you can access, * marks configured ones")
+	fmt.Println("  " + boardCreateUsage)
+	fmt.Println("    Create a board with the columns and groups of a template, or the columns the CLI uses")
+	fmt.Println("  " + boardApplyUsage)
+	fmt.Println("    Add the columns and groups of a template the board is missing, after showing the plan")
+	fmt.Println("  board columns (cols)   Show the columns of the configured board with their labels")
+	fmt.Println("  board activity (act) [-days 7] [-task <index>] [-limit 50]  Show the activity log of the board")
+	fmt.Println("  board snapshot (snap) [-out snap.json]  Save all items with full column values to a file")
+	fmt.Println("  board restore <snap.json> [-batch 10] [--dry-run]")
+	fmt.Println("    Recreates missing items and updates changed ones from a snapshot. Use --board <id> to")
+	fmt.Println("    restore to another board with matching columns and duplicate the snapshot board")
+	fmt.Println("  board diff <old.json> [new.json]  Show items added, removed and changed between two snapshots,")
+	fmt.Println("    or between a snapshot and the live board")
+	fmt.Println("  board diff -since <2006-01-02[T15:04]>  Show what changed on the live board from its activity log")
+	fmt.Println("  board trash [-days 30]  List recently archived and deleted items, bring them back with 'task restore'")
+	fmt.Println("  board automations (auto)  List the webhooks of integrations and apps on the board and the commands that fire them")
+}
+
+// HandleBoardColumnsCommand prints every column of the board with its parsed settings
+func (c *CLI) HandleBoardColumnsCommand() {
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+
+	client := c.newClient()
+	progressf("📡 Fetching columns of board %s...\n", boardID)
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+
+	printf("📋 %s (%s)\n", board.Name, board.ID)
+	printLine("=" + strings.Repeat("=", 50))
+	for _, column := range board.Columns {
+		printf("%s %s\n", colorize(column.Title, ColorCyan), colorize("("+column.Type+")", ColorWhite))
+		printf("   🆔 ID: %s\n", column.ID)
+
+		settings, err := monday.ParseColumnSettings(column)
+		if err != nil {
+			printf("   ⚠️  %v\n", err)
+			continue
+		}
+		if len(settings.Labels) > 0 {
+			printf("   🏷️  Labels: %s\n", strings.Join(settings.LabelNames(), ", "))
+		}
+		if len(settings.BoardIDs) > 0 {
+			printf("   🔗 Boards: %s\n", strings.Join(settings.BoardIDs, ", "))
+		}
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 Total columns: %d\n", len(board.Columns))
+}
+
+// HandleSetColumnsCommand saves the columns fetched with the tasks. The IDs are checked against
+// the default board, whose columns the CLI relies on are added so tasks keep their status,
+// owner, priority and sprint.
+func (c *CLI) HandleSetColumnsCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config set-columns <column-id,...|all>")
+		fmt.Println("💡 Run 'board columns' to find the column IDs")
+		os.Exit(ExitValidation)
+	}
+	ids := parseColumnIDs(c.command.Args[1])
+	if len(ids) == 0 {
+		c.config.SetColumnIDs(nil)
+		c.config.Save(monday.GetConfigPath())
+		fmt.Println("✅ All columns are fetched with the tasks")
+		return
+	}
+
+	boardID := c.config.GetBoardID()
+	board, err := c.newClient().GetBoard(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+	var unknown []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(board.Columns, func(column monday.Column) bool { return column.ID == id }) {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		fmt.Printf("❌ Board %s has no columns %s\n", board.Name, strings.Join(unknown, ", "))
+		fmt.Println("💡 Run 'board columns' to find the column IDs")
+		os.Exit(ExitNotFound)
+	}
+
+	var added []string
+	for _, check := range monday.CheckBoardColumns(board) {
+		if check.Column != nil && !slices.Contains(ids, check.Column.ID) {
+			ids = append(ids, check.Column.ID)
+			added = append(added, fmt.Sprintf("%s (%s)", check.Column.ID, check.Name))
+		}
+	}
+	if prColumn := c.config.GetPRColumnID(); prColumn != "" && !slices.Contains(ids, prColumn) {
+		ids = append(ids, prColumn)
+		added = append(added, prColumn+" (PR link)")
+	}
+	c.config.SetColumnIDs(ids)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Tasks are fetched with %d of %d columns: %s\n", len(ids), len(board.Columns), strings.Join(ids, ", "))
+	if len(added) > 0 {
+		fmt.Printf("💡 Added the columns the CLI needs: %s\n", strings.Join(added, ", "))
+	}
+	fmt.Println("💡 Run 'tasks fetch' to refresh the cache")
+}
+
+// parseColumnIDs splits a comma separated list of column IDs, "all" or an empty list select all columns
+func parseColumnIDs(value string) []string {
+	if strings.TrimSpace(value) == "all" {
+		return nil
+	}
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// defaultActivityLimit is the number of activity events shown without -limit
+const defaultActivityLimit = 50
+
+// HandleBoardActivityCommand prints the recent activity log of the board, newest day last
+func (c *CLI) HandleBoardActivityCommand() {
+	days := 7
+	limit := defaultActivityLimit
+	taskID := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-days", "--days", "-d":
+			value, err := strconv.Atoi(flag.Value)
+			if err != nil || value < 1 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			days = value
+		case "-task", "--task", "-t":
+			taskID = c.historyTaskID(flag.Value)
+		case "-limit", "--limit", "-n":
+			value, err := strconv.Atoi(flag.Value)
+			if err != nil || value < 1 {
+				fmt.Printf("❌ Invalid limit: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			limit = value
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	dataStore := monday.NewDataStore()
+	progressf("📜 Reading the activity log of the last %d days...\n", days)
+	events, err := monday.NewAnalyticsService(client, dataStore).BoardActivity(boardID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		exitWithError("Error reading board activity", err)
+	}
+
+	var shown []monday.ActivityEvent
+	for _, event := range events {
+		if taskID != "" && event.Info().ItemID != taskID {
+			continue
+		}
+		shown = append(shown, event)
+	}
+	if len(shown) == 0 {
+		fmt.Printf("📭 No activity in the last %d days\n", days)
+		return
+	}
+	if len(shown) > limit {
+		shown = shown[len(shown)-limit:]
+	}
+	userIDs := make([]string, 0, len(shown))
+	for _, event := range shown {
+		userIDs = append(userIDs, event.Info().UserID)
+	}
+	// Without a user directory the user IDs are shown instead of names
+	users, _ := dataStore.ResolveUsers(client, userIDs)
+	cached, _, _ := dataStore.GetCachedTasks(boardID)
+
+	day := ""
+	for _, event := range shown {
+		info := event.Info()
+		if date := info.At.Local().Format("Mon 2006-01-02"); date != day {
+			day = date
+			printf("\n📅 %s\n", colorize(day, ColorCyan))
+		}
+		itemID := "   -"
+		if task, ok := cached[info.ItemID]; ok {
+			itemID = formatLocalId(task)
+		}
+		who := info.UserID
+		if user, ok := users[info.UserID]; ok {
+			who = user.Name
+		}
+		printf("  %s %s. %s %s · %s %s\n",
+			colorize(info.At.Local().Format("15:04"), ColorGray),
+			itemID,
+			activityIcon(event),
+			info.ItemName,
+			event.Describe(),
+			colorize("👤 "+who, ColorGray),
+		)
+	}
+}
+
+// activityIcon returns the icon of an activity event type
+func activityIcon(event monday.ActivityEvent) string {
+	switch event := event.(type) {
+	case monday.ItemCreated:
+		return "🆕"
+	case monday.ItemMovedToGroup:
+		return "🔀"
+	case monday.ItemRenamed:
+		return "✏️"
+	case monday.ItemDeleted:
+		if event.Archived {
+			return "📦"
+		}
+		return "🗑️"
+	case monday.ColumnValueChanged:
+		return "🔄"
+	default:
+		return "•"
+	}
+}
+
+// HandleBoardSnapshotCommand saves the board with all items and column values to a JSON file
+func (c *CLI) HandleBoardSnapshotCommand() {
+	boardID := c.config.GetBoardID()
+	out := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-out", "--out", "-o":
+			out = flag.Value
+		}
+	}
+	if out == "" {
+		out = fmt.Sprintf("snapshot-%s-%s.json", boardID, time.Now().Format("20060102-150405"))
+	}
+
+	client := c.newClient()
+	progressf("📸 Taking a snapshot of board %s...\n", boardID)
+	snapshot, err := client.GetBoardSnapshot(boardID)
+	if err != nil {
+		exitWithError("Failed to take snapshot", err)
+	}
+	if err := monday.SaveSnapshot(out, snapshot); err != nil {
+		exitWithError("Failed to save snapshot", err)
+	}
+	fmt.Printf("✅ Saved %d items of %s to %s\n", len(snapshot.Items), snapshot.Board.Name, out)
+}
+
+// HandleBoardRestoreCommand creates and updates items so the board matches a snapshot
+func (c *CLI) HandleBoardRestoreCommand() {
+	if len(c.command.Args) < 2 {
+		c.HelpBoardCommand()
+		os.Exit(ExitValidation)
+	}
+	path := c.command.Args[1]
+	boardID := c.config.GetBoardID()
+	batchSize := 10
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-batch", "--batch", "-b":
+			n, err := strconv.Atoi(flag.Value)
+			if err != nil || n < 1 || n > 50 {
+				fmt.Printf("❌ Invalid batch size: %s (use 1 to 50)\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			batchSize = n
+		}
+	}
+
+	snapshot, err := monday.LoadSnapshot(path)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	client := c.newClient()
+	progressf("📡 Fetching board %s to compare with the snapshot...\n", boardID)
+	target, err := client.GetBoardSnapshot(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+	plan := monday.PlanRestore(snapshot, &target.Board, target.Items)
+
+	printf("♻️  Restore of %s (%s, %s) to %s\n", path, snapshot.Board.Name,
+		snapshot.TakenAt.Local().Format("2006-01-02 15:04"), target.Board.Name)
+	printLine("=" + strings.Repeat("=", 50))
+	for _, restore := range plan.Create {
+		printf("➕ %s\n", restore.Source.Name)
+	}
+	for _, restore := range plan.Update {
+		printf("🔄 %s %s\n", restore.Source.Name, colorize(strings.Join(restore.Changed, ", "), ColorGray))
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 %d to create, %d to update, %d unchanged\n", len(plan.Create), len(plan.Update), plan.Unchanged)
+	if len(plan.SkippedColumns) > 0 {
+		printf("⚠️  Columns without a match on the board are skipped: %s\n", strings.Join(plan.SkippedColumns, ", "))
+	}
+
+	if c.command.HasSwitch("dry-run") {
+		fmt.Println("🧪 Dry run, nothing was changed")
+		return
+	}
+	if len(plan.Create) == 0 && len(plan.Update) == 0 {
+		fmt.Println("✅ The board already matches the snapshot")
+		return
+	}
+
+	progressf("🚀 Restoring...\n")
+	created, updated, restoreErr := client.ApplyRestore(boardID, plan, batchSize)
+	if created+updated > 0 {
+		dataStore := monday.NewDataStore()
+		if tasks, rawItems, err := client.GetBoardItems(boardID); err == nil {
+			c.storeFetchedTasks(dataStore, boardID, tasks, rawItems)
+		} else {
+			fmt.Printf("⚠️  Warning: Could not refresh the cache: %v\n", err)
+		}
+	}
+	if restoreErr != nil {
+		fmt.Printf("❌ Created %d and updated %d items before an error\n", created, updated)
+		exitWithError("Restore failed", restoreErr)
+	}
+	fmt.Printf("✅ Created %d and updated %d items\n", created, updated)
+}
+
+// HandleBoardDiffCommand shows what changed between two snapshots, a snapshot and the live
+// board, or on the live board since a time
+func (c *CLI) HandleBoardDiffCommand() {
+	since := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-since", "--since", "-s":
+			since = flag.Value
+		}
+	}
+	files := c.command.Args[1:]
+	boardID := c.config.GetBoardID()
+
+	var diff *monday.BoardDiff
+	switch {
+	case since != "" && len(files) == 0:
+		from, err := parseSinceTime(since)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+		progressf("📜 Reading the activity log since %s...\n", from.Local().Format("2006-01-02 15:04"))
+		now := time.Now()
+		events, err := monday.NewAnalyticsService(c.newClient(), monday.NewDataStore()).BoardActivity(boardID, from)
+		if err != nil {
+			exitWithError("Error reading board activity", err)
+		}
+		diff = monday.DiffActivity(events, from, now)
+	case since == "" && (len(files) == 1 || len(files) == 2):
+		older, err := monday.LoadSnapshot(files[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+		var newer *monday.BoardSnapshot
+		if len(files) == 2 {
+			newer, err = monday.LoadSnapshot(files[1])
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+		} else {
+			progressf("📸 Fetching board %s to compare with the snapshot...\n", boardID)
+			newer, err = c.newClient().GetBoardSnapshot(boardID)
+			if err != nil {
+				exitWithError("Failed to fetch board", err)
+			}
+		}
+		if older.Board.ID != newer.Board.ID {
+			fmt.Printf("⚠️  Comparing different boards %s and %s, items are matched by ID\n", older.Board.ID, newer.Board.ID)
+		}
+		diff = monday.DiffSnapshots(older, newer)
+	default:
+		c.HelpBoardCommand()
+		os.Exit(ExitValidation)
+	}
+	PrintBoardDiff(diff)
+}
+
+// parseSinceTime reads an RFC 3339 time, or a local date with an optional time of day
+func parseSinceTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, use 2006-01-02, 2006-01-02T15:04 or RFC 3339", value)
+}
+
+// PrintBoardDiff prints added and removed items and the field changes of the other items
+func PrintBoardDiff(diff *monday.BoardDiff) {
+	printf("🔍 Changes from %s to %s\n", diff.From.Local().Format("2006-01-02 15:04"), diff.To.Local().Format("2006-01-02 15:04"))
+	if len(diff.Changes) == 0 {
+		fmt.Println("✅ No changes")
+		return
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	for _, change := range diff.Changes {
+		id := colorize("("+change.ID+")", ColorGray)
+		switch change.Kind {
+		case monday.ChangeAdded:
+			printf("%s %s %s\n", colorize("+", ColorGreen), colorize(change.Name, ColorGreen), id)
+		case monday.ChangeRemoved:
+			printf("%s %s %s\n", colorize("-", ColorRed), colorize(change.Name, ColorRed), id)
+		case monday.ChangeModified:
+			printf("%s %s %s\n", colorize("~", ColorYellow), change.Name, id)
+			for _, field := range change.Fields {
+				printf("    %s: %s -> %s\n", field.Field, colorize(displayValue(field.Old), ColorRed), colorize(displayValue(field.New), ColorGreen))
+			}
+		}
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 %d added, %d removed, %d changed\n",
+		diff.Count(monday.ChangeAdded), diff.Count(monday.ChangeRemoved), diff.Count(monday.ChangeModified))
+}
+
+// printTaskColumns fetches every column of a task and prints the values under it, empty
+// columns are left out
+func (c *CLI) printTaskColumns(task monday.Task) {
+	progressf("📡 Fetching the columns of task %d...\n", task.LocalId)
+	fields, err := c.newClient().GetTaskColumns(c.config.GetBoardID(), task.ID)
+	if err != nil {
+		exitWithError("Failed to fetch task columns", err)
+	}
+	const indent = "        "
+	titleWidth := 0
+	for _, field := range fields {
+		if field.Text != "" {
+			titleWidth = max(titleWidth, displayWidth(field.Title))
+		}
+	}
+	printf("%s%s\n", indent, colorize("🗂️  Columns", ColorGray))
+	for _, field := range fields {
+		if field.Text == "" {
+			continue
+		}
+		text := strings.ReplaceAll(field.Text, "\n", " ")
+		printf("%s%s  %s\n", indent, colorize(pad(field.Title, titleWidth), ColorCyan), text)
+	}
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+const boardApplyUsage = "board apply <spec.yaml|spec.json> [--confirm] [--dry-run]"
+
+// HandleBoardApplyCommand makes the configured board match a spec. The plan is shown first and
+// applied after confirmation: missing columns and groups are added and the name and
+// description updated. Differences the API cannot change are listed to fix by hand.
+func (c *CLI) HandleBoardApplyCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + boardApplyUsage)
+		os.Exit(ExitValidation)
+	}
+	spec, err := monday.LoadBoardSpec(c.command.Args[1])
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if err := c.linkSprintColumns(spec); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		fmt.Println("💡 Configure the sprint board with 'config set-sprint-board-id <board-id>' or name it in the spec")
+		os.Exit(ExitConfigMissing)
+	}
+	if err := spec.Validate(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first, or create the board with 'board create <name> -template <spec>'")
+		os.Exit(ExitConfigMissing)
+	}
+
+	service := monday.NewBoardService(c.newClient())
+	progressf("📡 Comparing board %s with %s...\n", boardID, c.command.Args[1])
+	plan, err := service.PlanBoard(boardID, spec)
+	if err != nil {
+		exitWithError("Failed to read board", err)
+	}
+	printBoardPlan(plan)
+	if plan.Applicable() == 0 {
+		return
+	}
+
+	if !c.command.HasSwitch("confirm") && !c.command.HasSwitch("dry-run") {
+		if !isInteractive() {
+			fmt.Println("💡 Add --confirm to apply the plan without a question")
+			os.Exit(ExitValidation)
+		}
+		if !confirm(bufio.NewReader(os.Stdin), fmt.Sprintf("Apply %d changes to %s?", plan.Applicable(), plan.Board.Name), false) {
+			fmt.Println("Board not changed")
+			return
+		}
+	}
+	applied := 0
+	err = service.ApplyBoardPlan(plan, func(step monday.BoardPlanStep, err error) {
+		switch {
+		case errors.Is(err, monday.ErrDryRun):
+		case err != nil:
+			printf("  ❌ %s: %v\n", describePlanStep(step), err)
+		default:
+			applied++
+			printf("  ✅ %s\n", describePlanStep(step))
+		}
+	})
+	if err != nil {
+		exitWithError(fmt.Sprintf("Applied %d of %d changes", applied, plan.Applicable()), err)
+	}
+	if applied > 0 {
+		printf("✅ Applied %d changes to %s\n", applied, plan.Board.Name)
+	}
+}
+
+// printBoardPlan prints the steps of a plan like Terraform: + for additions, ~ for updates and
+// ! for differences to fix by hand
+func printBoardPlan(plan *monday.BoardPlan) {
+	manual := len(plan.Steps) - plan.Applicable()
+	if len(plan.Steps) == 0 {
+		printf("✅ Board %s matches the spec\n", plan.Board.Name)
+	} else {
+		printf("📋 Plan for board %s (%s):\n", plan.Board.Name, plan.Board.ID)
+		for _, step := range plan.Steps {
+			switch step.Action {
+			case monday.PlanAddColumn, monday.PlanAddGroup:
+				printf("  %s %s\n", colorize("+", ColorGreen), describePlanStep(step))
+			case monday.PlanUpdateBoard:
+				printf("  %s %s\n", colorize("~", ColorYellow), describePlanStep(step))
+			default:
+				printf("  %s %s\n", colorize("!", ColorRed), describePlanStep(step))
+			}
+		}
+	}
+	if len(plan.Unmanaged) > 0 {
+		titles := make([]string, len(plan.Unmanaged))
+		for i, column := range plan.Unmanaged {
+			titles[i] = column.Title
+		}
+		printf("%s\n", colorize("Columns not in the spec, left as they are: "+strings.Join(titles, ", "), ColorGray))
+	}
+	if len(plan.Steps) > 0 {
+		printf("Plan: %d to change, %d to do by hand\n", plan.Applicable(), manual)
+	}
+	if manual > 0 {
+		progressf("💡 The API cannot change column types and labels, make the ! changes in the column settings on Monday\n")
+	}
+}
+
+// describePlanStep describes a step of a board plan in one line
+func describePlanStep(step monday.BoardPlanStep) string {
+	switch step.Action {
+	case monday.PlanAddColumn:
+		description := fmt.Sprintf("column %s (%s)", step.Column.Title, step.Column.Type)
+		if len(step.Column.Labels) > 0 {
+			description += ": " + strings.Join(step.Column.Labels, ", ")
+		}
+		return description
+	case monday.PlanAddGroup:
+		return "group " + step.Group
+	case monday.PlanUpdateBoard:
+		return fmt.Sprintf("board %s: %q -> %q", step.Attribute, step.Old, step.New)
+	default:
+		return fmt.Sprintf("column %s: %s", step.Column.Title, step.Detail)
+	}
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"slices"
+	"strings"
+)
+
+const boardCreateUsage = "board create <name> [-template <spec.yaml|spec.json>] [-workspace <id>] [-kind public|private|share] [-as <board-name>] [--dry-run]"
+
+// defaultBoardSpec is the board created without a template: the columns the CLI reads with
+// the labels its flags set, see getStatusValue, getPriorityValue and getTypeValue. The
+// Sprint column is left out without a sprint board to link it to.
+func defaultBoardSpec(sprintBoardID string) *monday.BoardSpec {
+	spec := &monday.BoardSpec{
+		Groups: []string{"Backlog"},
+		Columns: []monday.ColumnSpec{
+			{Title: "Owner", Type: "people"},
+			{Title: "Status", Type: "status", Labels: []string{"In Progress", "Done", "Stuck", "Waiting for review", "Ready for testing", "Removed"}},
+			{Title: "Priority", Type: "status", Labels: []string{"Critical", "High", "Medium", "Low"}},
+			{Title: "Type", Type: "status", Labels: []string{"Bug", "Feature", "Test", "Security", "Quality"}},
+			{Title: "Sprint", Type: "board_relation"},
+			{Title: "Due date", Type: "date"},
+		},
+	}
+	if sprintBoardID == "" {
+		spec.Columns = slices.DeleteFunc(spec.Columns, func(column monday.ColumnSpec) bool { return column.Title == "Sprint" })
+	}
+	return spec
+}
+
+// HandleBoardCreateCommand creates a board with the columns and groups of a spec. Sprint
+// columns without a linked board are linked to the configured sprint board.
+func (c *CLI) HandleBoardCreateCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + boardCreateUsage)
+		os.Exit(ExitValidation)
+	}
+	spec := defaultBoardSpec(c.config.GetSprintBoardID())
+	var kind, workspaceID, alias string
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-template", "--template", "-spec", "--spec":
+			loaded, err := monday.LoadBoardSpec(flag.Value)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+			spec = loaded
+		case "-workspace", "--workspace", "-ws":
+			workspaceID = flag.Value
+		case "-kind", "--kind":
+			kind = flag.Value
+		case "-as", "--as":
+			alias = flag.Value
+		}
+	}
+	spec.Name = strings.Join(c.command.Args[1:], " ")
+	if kind != "" {
+		spec.Kind = kind
+	}
+	if workspaceID != "" {
+		spec.WorkspaceID = workspaceID
+	}
+	if err := c.linkSprintColumns(spec); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		fmt.Println("💡 Configure the sprint board with 'config set-sprint-board-id <board-id>' or name it in the template")
+		os.Exit(ExitConfigMissing)
+	}
+	if err := spec.Validate(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if strings.ContainsAny(alias, ": ") {
+		fmt.Printf("❌ Invalid board name %q, use letters, digits and dashes\n", alias)
+		os.Exit(ExitValidation)
+	}
+
+	progressf("🛠️  Creating board %s with %d columns...\n", spec.Name, len(spec.Columns))
+	board, err := monday.NewBoardService(c.newClient()).CreateBoardFromSpec(spec)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		if board != nil {
+			fmt.Printf("⚠️  Board %s was created with %d of %d columns\n", board.ID, len(board.Columns), len(spec.Columns))
+		}
+		exitWithError("Failed to create board", err)
+	}
+
+	printf("✅ Created board %s (%s)\n", board.Name, board.ID)
+	for _, column := range board.Columns {
+		printf("   %s %s\n", colorize(column.Title, ColorCyan), colorize("("+column.Type+", "+column.ID+")", ColorGray))
+	}
+	if len(spec.Groups) > 0 {
+		printf("   Groups: %s\n", strings.Join(spec.Groups, ", "))
+	}
+	if alias == "" {
+		progressf("💡 Add it with 'config add-board %s', or 'config set-board-id %s' to make it the default\n", board.ID, board.ID)
+		return
+	}
+	c.config.AddBoard(monday.BoardRef{ID: board.ID, Name: alias})
+	if c.config.BoardID == "" {
+		c.config.SetBoardID(board.ID)
+	}
+	c.config.Save(monday.GetConfigPath())
+	printf("✅ Added board %s as %s\n", board.ID, alias)
+}
+
+// linkSprintColumns links the board relation columns titled Sprint without a linked board
+// to the configured sprint board
+func (c *CLI) linkSprintColumns(spec *monday.BoardSpec) error {
+	for i, column := range spec.Columns {
+		if column.Type != "board_relation" || column.LinkedBoardID != "" || !strings.Contains(strings.ToLower(column.Title), "sprint") {
+			continue
+		}
+		sprintBoardID := c.config.GetSprintBoardID()
+		if sprintBoardID == "" {
+			return fmt.Errorf("column %s links to the sprint board, but no sprint board is configured", column.Title)
+		}
+		spec.Columns[i].LinkedBoardID = sprintBoardID
+	}
+	return nil
+}
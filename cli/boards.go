@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+// applyBoardSelection switches to the board given with -board for this run, and to the
+// board of a task given as board:index
+func (c *CLI) applyBoardSelection() {
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-board", "--board":
+			c.config.SetBoardOverride(c.resolveBoard(flag.Value).ID)
+		}
+	}
+	if c.command.Command != "task" && c.command.Command != "t" || len(c.command.Args) < 2 {
+		return
+	}
+	if board, index, found := strings.Cut(c.command.Args[1], ":"); found {
+		c.config.SetBoardOverride(c.resolveBoard(board).ID)
+		c.command.Args[1] = index
+	}
+}
+
+// resolveBoard finds a configured board by name or ID. Unknown numeric IDs are used as they are.
+func (c *CLI) resolveBoard(nameOrID string) monday.BoardRef {
+	if board, ok := c.config.FindBoard(nameOrID); ok {
+		return board
+	}
+	if strings.Trim(nameOrID, "0123456789") == "" && nameOrID != "" {
+		return monday.BoardRef{ID: nameOrID, Name: nameOrID}
+	}
+	fmt.Printf("❌ Unknown board %q\n", nameOrID)
+	fmt.Println("💡 Add it with 'config add-board <board-id> [name]', see 'config boards'")
+	os.Exit(ExitValidation)
+	return monday.BoardRef{}
+}
+
+// HandleAddBoardCommand adds a board to the configured boards, named after its Monday name
+// unless a name is given
+func (c *CLI) HandleAddBoardCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config add-board <board-id> [name]")
+		os.Exit(ExitValidation)
+	}
+	ref := monday.BoardRef{ID: c.command.Args[1]}
+	if len(c.command.Args) > 2 {
+		ref.Name = c.command.Args[2]
+	} else {
+		board, err := c.newClient().GetBoard(ref.ID)
+		if err != nil {
+			exitWithError("Error fetching board", err)
+		}
+		ref.Name = monday.Slugify(board.Name)
+	}
+	if strings.ContainsAny(ref.Name, ": ") || ref.Name == "" {
+		fmt.Printf("❌ Invalid board name %q, use letters, digits and dashes\n", ref.Name)
+		os.Exit(ExitValidation)
+	}
+	if existing, ok := c.config.FindBoard(ref.Name); ok && existing.ID != ref.ID {
+		fmt.Printf("❌ Board name %q is already used by board %s\n", ref.Name, existing.ID)
+		os.Exit(ExitValidation)
+	}
+	c.config.AddBoard(ref)
+	if c.config.BoardID == "" {
+		c.config.SetBoardID(ref.ID)
+	}
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Added board %s as %s\n", ref.ID, ref.Name)
+	fmt.Printf("💡 Fetch it with 'tasks fetch --board %s'\n", ref.Name)
+}
+
+// HandleRemoveBoardCommand removes a configured board, the default board stays configured
+func (c *CLI) HandleRemoveBoardCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config remove-board <name|board-id>")
+		os.Exit(ExitValidation)
+	}
+	if !c.config.RemoveBoard(c.command.Args[1]) {
+		fmt.Printf("❌ Board %s not found\n", c.command.Args[1])
+		os.Exit(ExitNotFound)
+	}
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Removed board %s\n", c.command.Args[1])
+}
+
+// HandleListBoardsCommand lists the configured boards with their cache age
+func (c *CLI) HandleListBoardsCommand() {
+	boards := c.config.GetBoards()
+	if len(boards) == 0 {
+		fmt.Println("No boards configured")
+		return
+	}
+	dataStore := monday.NewDataStore()
+	for _, board := range boards {
+		marker := "  "
+		if board.ID == c.config.BoardID {
+			marker = colorize("* ", ColorGreen)
+		}
+		cached := colorize("not fetched", ColorGray)
+		if tasks, timestamp, ok := dataStore.GetCachedTasks(board.ID); ok {
+			cached = fmt.Sprintf("%d tasks, fetched %s", len(tasks), timestamp.Local().Format("2006-01-02 15:04"))
+		}
+		printf("%s%-20s %-12s %s\n", marker, board.Name, board.ID, cached)
+	}
+}
+
+// HandleUseBoardCommand makes a configured board the default board
+func (c *CLI) HandleUseBoardCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config use-board <name|board-id>")
+		os.Exit(ExitValidation)
+	}
+	board := c.resolveBoard(c.command.Args[1])
+	c.config.SetBoardID(board.ID)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Using board %s (%s)\n", board.Name, board.ID)
+}
+
+// HandleFetchAllBoardsCommand fetches every configured board
+func (c *CLI) HandleFetchAllBoardsCommand() {
+	for _, board := range c.config.GetBoards() {
+		c.config.SetBoardOverride(board.ID)
+		c.HandleFetchCommand()
+		fmt.Println("")
+	}
+	c.config.SetBoardOverride("")
+}
+
+// HandleListAllBoardsCommand lists the cached tasks of all configured boards together,
+// each task shows its board and board:index ID
+func (c *CLI) HandleListAllBoardsCommand() {
+	merged, oldest := c.cachedTasksOfAllBoards()
+	fmt.Println("Tasks cached at: " + oldest.Format(time.RFC3339))
+	c.PrintItems(merged)
+}
+
+// cachedTasksOfAllBoards merges the cached tasks of all configured boards, each task with the
+// name of its board, and returns the time of the oldest fetch. Boards not fetched yet are skipped.
+func (c *CLI) cachedTasksOfAllBoards() (map[string]monday.Task, time.Time) {
+	dataStore := monday.NewDataStore()
+	merged := make(map[string]monday.Task)
+	var oldest time.Time
+	for _, board := range c.config.GetBoards() {
+		tasks, timestamp, ok := dataStore.GetCachedTasks(board.ID)
+		if !ok {
+			fmt.Printf("⚠️  Board %s is not cached, run 'tasks fetch --board %s'\n", board.Name, board.Name)
+			continue
+		}
+		if oldest.IsZero() || timestamp.Before(oldest) {
+			oldest = timestamp
+		}
+		for id, task := range tasks {
+			task.Board = board.Name
+			merged[id] = task
+		}
+	}
+	return merged, oldest
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"sort"
+	"strings"
+)
+
+const bulkUsage = `tasks bulk -filter "status:ready for testing" [-set-status <status>] [-set-priority <priority>] [-set-type <type>] [--confirm]`
+
+// HandleTasksBulkCommand changes every cached task matching a filter expression, e.g. moves
+// all tasks ready for testing to done. The tasks are listed and the change is confirmed before
+// the first mutation, --confirm skips the question for scripts. Every task gets its own
+// mutation and its own line in the report, so one failing task does not stop the others.
+func (c *CLI) HandleTasksBulkCommand() {
+	expr := ""
+	var edit monday.TaskEdit
+	var changes []string
+	for _, flag := range c.command.Flags {
+		value := flag.Value
+		switch flag.Flag {
+		case "-filter", "--filter", "-f":
+			expr = value
+		case "-set-status", "--set-status":
+			value = labelAlias(value, getStatusValue)
+			edit.Status = &value
+			changes = append(changes, "status to "+value)
+		case "-set-priority", "--set-priority":
+			value = labelAlias(value, getPriorityValue)
+			edit.Priority = &value
+			changes = append(changes, "priority to "+value)
+		case "-set-type", "--set-type":
+			value = labelAlias(value, getTypeValue)
+			edit.Type = &value
+			changes = append(changes, "type to "+value)
+		}
+	}
+	if strings.TrimSpace(expr) == "" || len(changes) == 0 {
+		fmt.Println("Usage: monday-cli " + bulkUsage)
+		fmt.Println("The filter takes the fields of 'tasks search': status:, priority:, type:, sprint: and assignee:")
+		os.Exit(ExitValidation)
+	}
+	query, err := monday.ParseFilterExpression(expr)
+	if err != nil {
+		fmt.Printf("❌ Invalid filter: %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if query.IsEmpty() {
+		fmt.Println("❌ Empty filter, use -filter to pick the tasks")
+		os.Exit(ExitValidation)
+	}
+	if c.config.HasUserInfo() {
+		query.ReplaceValue("assignee", "me", c.config.GetUserInfo().Name)
+	}
+
+	boardID := c.config.GetBoardID()
+	dataStore := monday.NewDataStore()
+	cached, _, ok := dataStore.GetCachedTasks(boardID)
+	if !ok {
+		fmt.Println("❌ No cached tasks, run 'tasks fetch' first")
+		os.Exit(ExitNotFound)
+	}
+	var tasks []monday.Task
+	for _, task := range cached {
+		if query.Matches(task) && !bulkEditApplied(task, edit) {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		fmt.Printf("📭 No tasks matching %q need a change\n", expr)
+		return
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].LocalId < tasks[j].LocalId })
+
+	summary := strings.Join(changes, ", ")
+	printf("📋 %d tasks match %q, setting %s:\n", len(tasks), expr, summary)
+	for _, task := range tasks {
+		PrintTask(task)
+	}
+	if !c.command.HasSwitch("confirm") && !c.command.HasSwitch("dry-run") {
+		if !isInteractive() {
+			fmt.Println("💡 Add --confirm to apply the change without a question")
+			os.Exit(ExitValidation)
+		}
+		if !confirm(bufio.NewReader(os.Stdin), fmt.Sprintf("Change %d tasks?", len(tasks)), false) {
+			fmt.Println("No tasks changed")
+			return
+		}
+	}
+
+	client := c.newClient()
+	progressf("🚀 Changing %d tasks...\n", len(tasks))
+	changed, failed := 0, 0
+	results, err := client.BulkEditTasks(boardID, tasks, edit, func(result monday.BulkResult) {
+		switch {
+		case errors.Is(result.Err, monday.ErrDryRun):
+		case result.Err != nil:
+			failed++
+			printf("  ❌ %s. %s: %v\n", formatLocalId(result.Task), result.Task.Name, result.Err)
+		default:
+			changed++
+			printf("  ✅ %s. %s\n", formatLocalId(result.Task), result.Task.Name)
+		}
+	})
+	if err != nil {
+		exitWithError("Bulk change failed", err)
+	}
+	if changed == 0 && failed == 0 {
+		return // Dry run
+	}
+
+	if changed > 0 {
+		if fetched, rawItems, err := client.GetBoardItems(boardID); err == nil {
+			c.storeFetchedTasks(dataStore, boardID, fetched, rawItems)
+			updated, _, _ := dataStore.GetCachedTasks(boardID)
+			for _, result := range results {
+				if after, ok := updated[result.Task.ID]; ok && result.Err == nil {
+					c.recordHistory(monday.HistoryUpdate, result.Task, after, "bulk change of tasks matching "+expr)
+				}
+			}
+		} else {
+			fmt.Printf("⚠️  Warning: Could not refresh the cache: %v\n", err)
+		}
+	}
+	if failed > 0 {
+		fmt.Printf("❌ Changed %d of %d tasks, %d failed\n", changed, len(tasks), failed)
+		os.Exit(ExitError)
+	}
+	fmt.Printf("✅ Changed %d tasks: %s\n", changed, summary)
+}
+
+// bulkEditApplied reports whether a task already has the labels of a bulk edit
+func bulkEditApplied(task monday.Task, edit monday.TaskEdit) bool {
+	applied := func(current string, value *string) bool {
+		return value == nil || strings.EqualFold(current, *value)
+	}
+	return applied(string(task.Status), edit.Status) && applied(string(task.Priority), edit.Priority) && applied(string(task.Type), edit.Type)
+}
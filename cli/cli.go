@@ -2,8 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"log/slog"
 	"monday-cli/monday"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Flag struct {
@@ -12,48 +16,222 @@ type Flag struct {
 }
 
 type Command struct {
-	Command string
-	Args    []string
-	Flags   []Flag
+	Command  string
+	Args     []string
+	Flags    []Flag
+	Switches []string
+}
+
+// globalSwitches are boolean options accepted anywhere on the command line, mapped to their name
+var globalSwitches = map[string]string{
+	"--dry-run":          "dry-run",
+	"-dry-run":           "dry-run",
+	"--verbose":          "verbose",
+	"--debug":            "debug",
+	"--quiet":            "quiet",
+	"-q":                 "quiet",
+	"--no-color":         "no-color",
+	"--trace":            "trace",
+	"--reverse":          "reverse",
+	"--print":            "print",
+	"--interactive":      "interactive",
+	"--all-boards":       "all-boards",
+	"--no-secrets":       "no-secrets",
+	"--summary":          "summary",
+	"--porcelain":        "porcelain",
+	"--editor":           "editor",
+	"--all-columns":      "all-columns",
+	"--confirm":          "confirm",
+	"--check-duplicates": "check-duplicates",
+}
+
+// HasSwitch reports whether a global switch was given
+func (cmd Command) HasSwitch(name string) bool {
+	for _, s := range cmd.Switches {
+		if s == name {
+			return true
+		}
+	}
+	return false
 }
 
 type CLI struct {
-	command Command
-	config  *monday.Config
+	command  Command
+	config   *monday.Config
+	firstRun bool // No config file existed before this run
+
+	transport monday.Transport // Replaces the HTTP client when set, e.g. by a fake server
 }
 
 func NewCLI() *CLI {
-	fmt.Println("Loading config...")
+	c := &CLI{}
+	c.ReadCommand()
+	c.configureLogging()
+	c.configureOutput()
+
+	logger := monday.Logger()
+	logger.Debug("loading config", "path", monday.GetConfigPath())
+	c.firstRun = !monday.ConfigExists(monday.GetConfigPath())
 	config, err := monday.LoadConfig(monday.GetConfigPath())
 	if err != nil {
 		fmt.Println("Error loading config:", err)
 		return nil
 	}
-	fmt.Println("Config loaded successfully")
-	c := &CLI{
-		config: config,
+	c.config = config
+	statusAgeLimits = config.GetStatusAgeLimits()
+	if config.CacheBackend != "" || config.CacheLocation != "" {
+		store, err := config.OpenCacheStore()
+		if err != nil {
+			fmt.Printf("⚠️  Warning: %v, using the default cache\n", err)
+		} else {
+			monday.SetDefaultStore(store)
+		}
 	}
-	fmt.Println("Reading command...")
-	c.ReadCommand()
-	fmt.Println("Command read successfully")
+	logger.Debug("command read", "command", c.command.Command, "args", c.command.Args, "flags", c.command.Flags)
 	return c
 }
 
-func (c *CLI) SetCommand( command Command ){
+// configureLogging sets the log level from MONDAY_CLI_LOG, overridden by --verbose and --debug
+func (c *CLI) configureLogging() {
+	if name := os.Getenv(monday.LogEnvVar); name != "" {
+		level, err := monday.ParseLogLevel(name)
+		if err != nil {
+			fmt.Printf("⚠️  Warning: %v (use debug, info, warn or error)\n", err)
+		}
+		monday.SetLogLevel(level)
+	}
+	if c.command.HasSwitch("verbose") {
+		monday.SetLogLevel(slog.LevelInfo)
+	}
+	if c.command.HasSwitch("debug") {
+		monday.SetLogLevel(slog.LevelDebug)
+	}
+	slog.SetDefault(monday.Logger())
+}
+
+// newClient creates an API client using the auth provider from the configuration
+func (c *CLI) newClient() *monday.Client {
+	auth, err := c.config.NewAuthProvider()
+	if err != nil {
+		fmt.Printf("❌ Error setting up authentication: %v\n", err)
+		os.Exit(ExitConfigMissing)
+	}
+	httpOpts, err := c.config.HTTPOptions()
+	if err != nil {
+		fmt.Printf("❌ Error in the connection settings: %v\n", err)
+		os.Exit(ExitConfigMissing)
+	}
+	opts := append([]monday.ClientOption{monday.WithAuth(auth), monday.WithProgress(printPageProgress)}, httpOpts...)
+	if ids := c.config.GetColumnIDs(); len(ids) > 0 {
+		opts = append(opts, monday.WithColumnIDs(ids))
+	}
+	opts = append(opts, c.connectionFlags()...)
+	if c.transport != nil {
+		opts = append(opts, monday.WithTransport(c.transport))
+	}
+	if c.command.HasSwitch("dry-run") {
+		opts = append(opts, monday.WithDryRun(os.Stdout))
+	}
+	client := monday.NewClient(opts...)
+	if c.command.HasSwitch("trace") {
+		path, err := monday.GetTracePath()
+		if err == nil {
+			err = client.EnableTracing(path)
+		}
+		if err != nil {
+			fmt.Printf("⚠️  Warning: Could not enable tracing: %v\n", err)
+		}
+	}
+	return client
+}
+
+// httpTransport returns the HTTP client of requests outside the API, e.g. to webhooks, with
+// the configured connection settings
+func (c *CLI) httpTransport() monday.Transport {
+	httpOpts, err := c.config.HTTPOptions()
+	if err != nil {
+		exitWithError("Error in the connection settings", err)
+	}
+	return monday.NewHTTPClient(append(httpOpts, c.connectionFlags()...)...)
+}
+
+// connectionFlags returns the client options of -timeout, -proxy, -ca-bundle, -page-size and
+// -columns, which override the configured connection and fetch settings for a single run
+func (c *CLI) connectionFlags() []monday.ClientOption {
+	var opts []monday.ClientOption
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-timeout", "--timeout":
+			timeout, err := time.ParseDuration(flag.Value)
+			if seconds, convErr := strconv.Atoi(flag.Value); convErr == nil {
+				timeout, err = time.Duration(seconds)*time.Second, nil // Plain numbers are seconds like timeout_seconds
+			}
+			if err != nil || timeout <= 0 {
+				fmt.Printf("❌ Invalid timeout %q, use seconds or a duration like 90s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			opts = append(opts, monday.WithTimeout(timeout))
+		case "-proxy", "--proxy":
+			proxy, err := monday.ParseProxyURL(flag.Value)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+			opts = append(opts, monday.WithProxy(proxy))
+		case "-ca-bundle", "--ca-bundle":
+			pool, err := monday.LoadCABundle(flag.Value)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+			opts = append(opts, monday.WithRootCAs(pool))
+		case "-page-size", "--page-size":
+			size, err := strconv.Atoi(flag.Value)
+			if err != nil || size <= 0 || size > monday.MaxPageSize {
+				fmt.Printf("❌ Invalid page size %q, use 1 to %d items\n", flag.Value, monday.MaxPageSize)
+				os.Exit(ExitValidation)
+			}
+			opts = append(opts, monday.WithPageSize(size))
+		case "-columns", "--columns":
+			opts = append(opts, monday.WithColumnIDs(parseColumnIDs(flag.Value)))
+		}
+	}
+	return opts
+}
+
+func (c *CLI) SetCommand(command Command) {
 	c.command = command
 }
 
+// SetTransport makes all API clients send their requests through transport
+func (c *CLI) SetTransport(transport monday.Transport) {
+	c.transport = transport
+}
+
 func (c *CLI) ReadCommand() Command {
-	if len(os.Args) < 2 {
+	var rawArgs []string
+	for i, arg := range os.Args[1:] {
+		if name, ok := globalSwitches[arg]; ok {
+			c.command.Switches = append(c.command.Switches, name)
+			continue
+		}
+		// -i is the interactive switch unless it has a value, like -interval in tasks watch
+		if arg == "-i" && (i+2 >= len(os.Args) || strings.HasPrefix(os.Args[i+2], "-")) {
+			c.command.Switches = append(c.command.Switches, "interactive")
+			continue
+		}
+		rawArgs = append(rawArgs, arg)
+	}
+	if len(rawArgs) < 1 {
 		return Command{
 			Command: "help",
 			Args:    []string{},
 		}
 	}
-	c.command.Command = os.Args[1]
+	c.command.Command = rawArgs[0]
 	args := []string{}
 	var inString bool
-	for _, arg := range os.Args[2:] {
+	for _, arg := range rawArgs[1:] {
 		s := arg
 		if arg[0] == '"' {
 			inString = true
@@ -76,14 +254,15 @@ func (c *CLI) ReadCommand() Command {
 			skipNext = false
 			continue
 		}
-		if arg[0] == '-' {
+		// A lone "-" is an argument, it stands for a task picked interactively
+		if arg[0] == '-' && arg != "-" {
 			if i == len(args)-1 {
 				fmt.Println("Error: Invalid flag: " + arg)
-				os.Exit(1)
+				os.Exit(ExitValidation)
 			}
 			if args[i+1][0] == '-' {
 				fmt.Println("Error: Invalid flag: " + arg)
-				os.Exit(1)
+				os.Exit(ExitValidation)
 			}
 			c.command.Flags = append(c.command.Flags, Flag{Flag: arg, Value: args[i+1]})
 			skipNext = true
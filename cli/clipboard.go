@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// HandleTaskCopyCommand puts the URL, ID or a markdown link of a task on the clipboard
+func (c *CLI) HandleTaskCopyCommand() {
+	task := c.cachedTaskArg("task copy <task-index> [-format url|id|markdown]")
+	format := "url"
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-format", "--format", "-f":
+			format = strings.ToLower(flag.Value)
+		}
+	}
+
+	var text string
+	switch format {
+	case "url", "u":
+		text = c.taskURL(task)
+	case "id", "i":
+		text = task.ID
+	case "markdown", "md", "m":
+		text = fmt.Sprintf("[%s](%s)", task.Name, c.taskURL(task))
+	default:
+		fmt.Printf("❌ Invalid format %q, use url, id or markdown\n", format)
+		os.Exit(ExitValidation)
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		fmt.Printf("❌ Could not copy to the clipboard: %v\n", err)
+		fmt.Println(text)
+		os.Exit(ExitError)
+	}
+	progressf("📋 Copied %s\n", text)
+}
+
+// copyToClipboard writes text to the system clipboard with the platform's clipboard tool
+func copyToClipboard(text string) error {
+	var candidates [][]string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	default:
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, []string{"wl-copy"})
+		}
+		candidates = append(candidates, []string{"xclip", "-selection", "clipboard"}, []string{"xsel", "--clipboard", "--input"})
+	}
+	for _, args := range candidates {
+		if _, err := exec.LookPath(args[0]); err != nil {
+			continue
+		}
+		monday.Logger().Debug("copying to clipboard", "tool", args[0])
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return errors.New("no clipboard tool found (install wl-copy, xclip or xsel)")
+}
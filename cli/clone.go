@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// HandleTaskCloneCommand copies a task with its column values, on its board or onto another one
+func (c *CLI) HandleTaskCloneCommand() {
+	usage := "task clone <task-index> [-name <name>] [-to-board <board>] [-map <column-id>:<column-id>,...]"
+	task := c.cachedTaskArg(usage)
+
+	opts := monday.CloneOptions{}
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-name", "--name", "-n":
+			opts.Name = flag.Value
+		case "-to-board", "--to-board", "-to":
+			opts.BoardID = c.resolveBoard(flag.Value).ID
+		case "-map", "--map":
+			columnMap, err := parseColumnMap(flag.Value)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				fmt.Println("Usage: monday-cli " + usage)
+				os.Exit(ExitValidation)
+			}
+			opts.ColumnMap = columnMap
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	result, err := client.CloneItem(task.ID, opts)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil && result == nil {
+		exitWithError("Error cloning task", err)
+	}
+	if err != nil {
+		PrintError(fmt.Sprintf("Task copied as item %s", result.ItemID), err)
+	}
+	for _, skipped := range result.SkippedColumns {
+		printf("⚠️  Not copied: %s\n", skipped)
+	}
+
+	if result.BoardID != boardID {
+		fmt.Printf("✅ Task %d copied to board %s as item %s\n", task.LocalId, result.BoardID, result.ItemID)
+		return
+	}
+	created, err := client.GetTaskByID(result.ItemID)
+	if err != nil {
+		fmt.Printf("✅ Task %d copied as item %s\n", task.LocalId, result.ItemID)
+		fmt.Println("💡 Run 'tasks fetch' to add it to the cache")
+		return
+	}
+	localId := c.cacheCreatedTask(boardID, created)
+	c.recordHistory(monday.HistoryCreate, monday.Task{}, *created, "")
+	fmt.Printf("✅ Task %d copied as task %d\n", task.LocalId, localId)
+	PrintTask(*created)
+}
+
+// parseColumnMap parses a list of column ID pairs like "status:status_1,text0:notes"
+func parseColumnMap(value string) (map[string]string, error) {
+	columnMap := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		from, to, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid column mapping %q, expected <column-id>:<column-id>", pair)
+		}
+		columnMap[from] = to
+	}
+	return columnMap, nil
+}
@@ -1,9 +1,15 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"maps"
 	"monday-cli/monday"
 	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +23,8 @@ const (
 	CSTasks  CommandString = "tasks"
 	CSTask   CommandString = "task"
 	CSUser   CommandString = "user"
+	CSAPI    CommandString = "api"
+	CSServe  CommandString = "serve"
 )
 
 func (cs *CommandString) ToString() string {
@@ -25,10 +33,23 @@ func (cs *CommandString) ToString() string {
 
 func (c *CLI) HandleCommand() {
 
-	if err := c.ShowMissingConfig(); err != nil {
+	if c.firstRun && isInteractive() {
+		c.RunOnboarding()
 		return
 	}
 
+	// Commands used to set up the tool must work before it is configured
+	switch c.command.Command {
+	case "help", "h", "config", "cfg", "user", "users", "u", "api", "doctor", "init", "auth":
+	default:
+		if err := c.ShowMissingConfig(); err != nil {
+			os.Exit(ExitConfigMissing)
+		}
+	}
+	if c.command.Command != "config" && c.command.Command != "cfg" {
+		c.applyBoardSelection()
+	}
+
 	switch c.command.Command {
 	case "help", "h":
 		c.ShowHelp()
@@ -38,16 +59,59 @@ func (c *CLI) HandleCommand() {
 		c.HandleTasksCommand()
 	case "task", "t":
 		c.HandleTaskCommand()
-	case "user", "u":
+	case "user", "users", "u":
 		c.HandleUserCommand()
+	case "api":
+		c.HandleAPICommand()
+	case "serve":
+		c.HandleServeCommand()
+	case "board", "b":
+		c.HandleBoardCommand()
+	case "analytics", "an":
+		c.HandleAnalyticsCommand()
+	case "report", "rep":
+		c.HandleReportCommand()
+	case "metrics":
+		c.HandleMetricsCommand()
+	case "import":
+		c.HandleImportCommand()
+	case "history", "hist":
+		c.HandleHistoryCommand()
+	case "sync":
+		c.HandleSyncCommand()
+	case "recur":
+		c.HandleRecurCommand()
+	case "hooks", "hook":
+		c.HandleHooksCommand()
+	case "workspace", "ws":
+		c.HandleWorkspaceCommand()
+	case "docs", "doc":
+		c.HandleDocsCommand()
+	case "status", "st":
+		c.HandleStatusCommand()
+	case "quick", "qa":
+		c.HandleQuickCommand()
+	case "me":
+		c.HandleMeCommand()
+	case "doctor":
+		c.HandleDoctorCommand()
+	case "init":
+		c.RunOnboarding()
+	case "auth":
+		c.HandleAuthCommand()
+	case "epics", "epic", "ep":
+		c.HandleEpicsCommand()
 	default:
 		c.ShowHelp()
 	}
 }
 
 func (c *CLI) ShowMissingConfig() error {
-	if len(c.config.APIKey) == 0 {
+	if !c.config.HasCredentials() {
 		fmt.Println("  config (cfg) set-api-key <api-key>    Sets APIkey used to authenticate at monday")
+		fmt.Println("  config (cfg) set-auth-command <command>  Reads the APIkey from a command, e.g. 'pass show monday'")
+		fmt.Println("  config (cfg) init                     Guided setup of API key, user and board")
+		fmt.Println("  auth login -client-id <id> -client-secret <secret>  Log in with a Monday OAuth app")
 		fmt.Println("  help (h)       Show this help")
 		fmt.Println("")
 		return fmt.Errorf("missing api key")
@@ -68,12 +132,51 @@ func (c *CLI) ShowHelp() {
 	fmt.Println("Usage: <command>")
 	fmt.Println("")
 	fmt.Println("Commands:")
+	fmt.Println("  init           Guided setup of API key, boards, columns and default filters")
+	fmt.Println("  auth           Log in with a Monday OAuth app instead of an API key")
 	fmt.Println("  user (u)       User information and setup")
 	fmt.Println("  tasks (ts)     Show your assigned tasks")
+	fmt.Println("  me work        Your tasks on all boards")
+	fmt.Println("  status (st) [--porcelain]  One line of open tasks per status and the sprint day, for prompts")
 	fmt.Println("  task (t)       Specific task operations")
+	fmt.Println("  quick (qa) \"<name> !high #bug @alice ^Sprint 12 due:friday\"  Create a task from one line")
 	fmt.Println("  config (cfg)   Manage configuration")
+	fmt.Println("  api            API connection diagnostics")
+	fmt.Println("  doctor         Check the API key, boards, columns, sprint board and cache")
+	fmt.Println("  serve          Keep the cache up to date from board webhooks")
+	fmt.Println("  board (b)      Board columns and labels")
+	fmt.Println("  workspace (ws) Browse workspaces and their boards")
+	fmt.Println("  docs           List workdocs and export them as markdown")
+	fmt.Println("  epics (ep)     Epics of the epics board with the progress of their tasks on all boards")
+	fmt.Println("  analytics (an) Reports like sprint capacity")
+	fmt.Println("  report (rep)   Weekly sprint digest as markdown or HTML")
+	fmt.Println("  metrics        Serve board metrics for Prometheus")
+	fmt.Println("  import         Create tasks from a CSV export of Jira, Trello or a spreadsheet")
+	fmt.Println("  sync           Send task changes queued while offline")
+	fmt.Println("  recur          Create tasks on a schedule, e.g. a weekly report")
+	fmt.Println("  hooks          Run commands or POST to URLs when tasks are created, change status or roll over")
+	fmt.Println("  history (hist) [-task <index>] [-limit <n>]  Changes made with this CLI")
 	fmt.Println("  help (h)       Show this help")
 	fmt.Println("")
+	fmt.Println("Global Flags:")
+	fmt.Println("  --dry-run      Print mutations instead of sending them")
+	fmt.Println("  --verbose      Show progress information")
+	fmt.Println("  --debug        Show debug logging (requests, cache access, columns)")
+	fmt.Println("  --quiet, -q    Hide progress messages, only print results and errors")
+	fmt.Println("  --no-color     Print without colors and emoji")
+	fmt.Println("  --trace        Record API requests and responses to ~/.cache/monday-cli/trace.log")
+	fmt.Println("  -board <name>  Use another configured board for this command, tasks also take board:index")
+	fmt.Println("  --all-boards   List or fetch the tasks of all configured boards")
+	fmt.Println("  -timeout <90s> -proxy <url> -ca-bundle <pem-file>  Override the connection settings for this command")
+	fmt.Println("  -page-size <n> Items per request when fetching boards (default 25, max 500)")
+	fmt.Println("  -columns <id,...|all>  Column values to fetch with the tasks, overrides 'config set-columns'")
+	fmt.Println("  -layout <name> Print tasks as default, compact, table or wide (table with due date, sprint and tags)")
+	fmt.Println("  --width <n>    Wrap and cut task lists at n columns instead of the terminal width")
+	fmt.Println("")
+	fmt.Println("Environment:")
+	fmt.Println("  MONDAY_CLI_LOG=debug|info|warn|error   Set the log level")
+	fmt.Println("  NO_COLOR=1                             Same as --no-color")
+	fmt.Println("")
 }
 
 func (c *CLI) HandleConfigCommand() {
@@ -86,18 +189,18 @@ func (c *CLI) HandleConfigCommand() {
 	case "set-api-key", "key":
 		if len(c.command.Args) < 2 {
 			fmt.Println("Usage: monday-cli config set-api-key <api-key>")
-			return
+			os.Exit(ExitValidation)
 		}
 		c.config.SetAPIKey(c.command.Args[1])
 		c.config.Save(monday.GetConfigPath())
 		fmt.Println("API Key set successfully")
 
 		// Automatically fetch user info after setting API key
-		fmt.Println("🔍 Fetching user information...")
-		client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
+		progressf("🔍 Fetching user information...\n")
+		client := c.newClient()
 		user, err := client.GetUserInfo()
 		if err != nil {
-			fmt.Printf("❌ Error getting user info: %v\n", err)
+			PrintError("Error getting user info", err)
 			fmt.Println("You can run 'user info' later to fetch user information")
 			return
 		}
@@ -111,31 +214,251 @@ func (c *CLI) HandleConfigCommand() {
 		// Show user info
 		PrintUserInfo(user)
 		return
+	case "set-auth-command", "auth-cmd":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-auth-command \"<command>\"")
+			fmt.Println("Example: monday-cli config set-auth-command \"pass show monday\"")
+			os.Exit(ExitValidation)
+		}
+		c.config.SetAuthCommand(strings.Join(c.command.Args[1:], " "))
+		c.config.Save(monday.GetConfigPath())
+		fmt.Println("Auth command set successfully")
+		return
+	case "set-auth-static", "auth-static":
+		c.config.SetStaticAuth()
+		c.config.Save(monday.GetConfigPath())
+		fmt.Println("Using the configured API key for authentication")
+		return
 	case "set-board-id", "board":
 		if len(c.command.Args) < 2 {
 			fmt.Println("Usage: monday-cli config set-board-id <board-id>")
-			return
+			os.Exit(ExitValidation)
 		}
 		c.config.SetBoardID(c.command.Args[1])
 		c.config.Save(monday.GetConfigPath())
 		return
 	case "set-sprint-id", "sprint":
 		if len(c.command.Args) < 2 {
-			fmt.Println("Usage: monday-cli config set-sprint-id <sprint-id>")
-			return
+			fmt.Println("Usage: monday-cli config set-sprint-id <sprint-id | auto>")
+			os.Exit(ExitValidation)
 		}
 		c.config.SetSprintID(c.command.Args[1])
+		if c.config.IsSprintAuto() {
+			if c.config.GetSprintBoardID() == "" {
+				fmt.Println("❌ No sprint board configured, it is needed to detect the current sprint")
+				fmt.Println("💡 Run 'config set-sprint-board-id <board-id>' first")
+				os.Exit(ExitConfigMissing)
+			}
+			c.config.SetSprintID(monday.SprintIDAuto)
+		}
 		c.config.Save(monday.GetConfigPath())
+		if c.config.IsSprintAuto() {
+			fmt.Println("✅ The current sprint is now detected from the sprint dates:", c.sprintIDSummary())
+		}
 		return
 	case "set-sprint-board-id", "sprint-board":
 		if len(c.command.Args) < 2 {
 			fmt.Println("Usage: monday-cli config set-sprint-board-id <sprint-board-id>")
-			return
+			os.Exit(ExitValidation)
 		}
 		c.config.SetSprintBoardID(c.command.Args[1])
 		c.config.Save(monday.GetConfigPath())
 		return
+	case "set-epics-board-id", "epics-board":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-epics-board-id <epics-board-id>")
+			fmt.Println("💡 Epics are the items of this board, linked to their tasks with connect boards columns")
+			os.Exit(ExitValidation)
+		}
+		c.config.SetEpicsBoardID(c.command.Args[1])
+		c.config.Save(monday.GetConfigPath())
+		fmt.Println("✅ Epics board set, run 'epics list' to see the epics")
+		return
+	case "set-pr-column", "pr-column":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-pr-column <column-id>")
+			fmt.Println("💡 Run 'board columns' to find the ID of the link column")
+			os.Exit(ExitValidation)
+		}
+		c.config.SetPRColumnID(c.command.Args[1])
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ Pull request links go to column %s\n", c.command.Args[1])
+		return
+	case "add-board", "addb":
+		c.HandleAddBoardCommand()
+		return
+	case "remove-board", "rmb":
+		c.HandleRemoveBoardCommand()
+		return
+	case "boards", "list-boards":
+		c.HandleListBoardsCommand()
+		return
+	case "use-board", "useb":
+		c.HandleUseBoardCommand()
+		return
+	case "set-account-slug", "account-slug":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-account-slug <slug>")
+			fmt.Println("💡 The slug is the subdomain of https://<slug>.monday.com")
+			os.Exit(ExitValidation)
+		}
+		c.config.SetAccountSlug(c.command.Args[1])
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ Task URLs use https://%s.monday.com\n", c.command.Args[1])
+		return
+	case "set-branch-pattern", "branch-pattern":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-branch-pattern <pattern>")
+			fmt.Println("Placeholders: {id} item ID, {local} local index, {slug} task name, {type} task type")
+			fmt.Printf("Default: %s\n", monday.DefaultBranchPattern)
+			os.Exit(ExitValidation)
+		}
+		c.config.SetBranchPattern(c.command.Args[1])
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ Branch pattern set to %s\n", c.command.Args[1])
+		return
+	case "set-check-duplicates", "check-duplicates":
+		if len(c.command.Args) < 2 || (c.command.Args[1] != "on" && c.command.Args[1] != "off") {
+			fmt.Println("Usage: monday-cli config set-check-duplicates <on|off>")
+			os.Exit(ExitValidation)
+		}
+		check := c.command.Args[1] == "on"
+		c.config.SetCheckDuplicates(check)
+		c.config.Save(monday.GetConfigPath())
+		if check {
+			fmt.Println("✅ Similar tasks are searched before creating a task")
+		} else {
+			fmt.Println("✅ Tasks are created without searching for similar ones")
+		}
+		return
+	case "set-timeout", "timeout":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-timeout <seconds>")
+			os.Exit(ExitValidation)
+		}
+		seconds, err := strconv.Atoi(c.command.Args[1])
+		if err != nil || seconds <= 0 {
+			fmt.Printf("❌ Invalid timeout %q, use a number of seconds\n", c.command.Args[1])
+			os.Exit(ExitValidation)
+		}
+		c.config.SetTimeout(seconds)
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ Requests time out after %d seconds\n", seconds)
+		return
+	case "set-proxy", "proxy":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-proxy <url|none>")
+			fmt.Println("💡 Without a proxy setting HTTPS_PROXY and NO_PROXY from the environment are used")
+			os.Exit(ExitValidation)
+		}
+		proxy := c.command.Args[1]
+		if proxy == "none" {
+			proxy = ""
+		} else if _, err := monday.ParseProxyURL(proxy); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+		c.config.SetProxy(proxy)
+		c.config.Save(monday.GetConfigPath())
+		if proxy == "" {
+			fmt.Println("✅ Using the proxy from the environment")
+		} else {
+			fmt.Printf("✅ Requests go through %s\n", proxy)
+		}
+		return
+	case "set-ca-bundle", "ca-bundle":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-ca-bundle <pem-file|none>")
+			os.Exit(ExitValidation)
+		}
+		path := c.command.Args[1]
+		if path == "none" {
+			path = ""
+		} else {
+			if absolute, err := filepath.Abs(path); err == nil {
+				path = absolute
+			}
+			if _, err := monday.LoadCABundle(path); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+		}
+		c.config.SetCABundle(path)
+		c.config.Save(monday.GetConfigPath())
+		if path == "" {
+			fmt.Println("✅ Using the system certificates only")
+		} else {
+			fmt.Printf("✅ Trusting the certificates in %s in addition to the system ones\n", path)
+		}
+		return
+	case "set-status-shortcut", "status-shortcut":
+		c.HandleSetStatusShortcutCommand()
+		return
+	case "set-status-age", "status-age":
+		c.HandleSetStatusAgeCommand()
+		return
+	case "set-slack-webhook", "slack-webhook":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-slack-webhook <url|none>")
+			os.Exit(ExitValidation)
+		}
+		webhook := c.command.Args[1]
+		if webhook == "none" {
+			webhook = ""
+		} else if !strings.HasPrefix(webhook, "https://") {
+			fmt.Printf("❌ Invalid webhook %q, Slack webhooks start with https://hooks.slack.com/\n", webhook)
+			os.Exit(ExitValidation)
+		}
+		c.config.SetSlackWebhook(webhook)
+		c.config.Save(monday.GetConfigPath())
+		if webhook == "" {
+			fmt.Println("✅ Slack webhook removed")
+		} else {
+			fmt.Println("✅ 'report slack' posts to the webhook")
+		}
+		return
+	case "set-slack-template", "slack-template":
+		c.HandleSetSlackTemplateCommand()
+		return
+	case "set-columns", "columns":
+		c.HandleSetColumnsCommand()
+		return
+	case "set-cache", "cache":
+		if len(c.command.Args) < 2 {
+			fmt.Println("Usage: monday-cli config set-cache <backend> [location]")
+			fmt.Printf("Backends: %s\n", strings.Join(monday.StoreBackends(), ", "))
+			os.Exit(ExitValidation)
+		}
+		location := ""
+		if len(c.command.Args) > 2 {
+			location = c.command.Args[2]
+		}
+		if _, err := monday.OpenStore(c.command.Args[1], location); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+		c.config.SetCache(c.command.Args[1], location)
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ Cache stored with the %s backend\n", c.command.Args[1])
+		fmt.Println("💡 Run 'tasks fetch' to fill the new cache")
+		return
+	case "export":
+		c.HandleConfigExportCommand()
+		return
+	case "import":
+		c.HandleConfigImportCommand()
+		return
+	case "init":
+		c.RunOnboarding()
+		return
 	case "show", "s":
+		fmt.Println("Auth Provider:", c.config.GetAuthProviderType())
+		if c.config.GetAuthProviderType() == monday.AuthCommand {
+			fmt.Println("Auth Command:", c.config.AuthCommand)
+		}
+		if c.config.OAuth != nil {
+			fmt.Println("OAuth Client ID:", c.config.OAuth.ClientID)
+		}
 		fmt.Println("API Key:", maskAPIKey(c.config.GetAPIKey()))
 		if c.config.HasUserInfo() {
 			user := c.config.GetUserInfo()
@@ -149,8 +472,51 @@ func (c *CLI) HandleConfigCommand() {
 			fmt.Println("User Info: Not configured (run 'user info' to fetch)")
 		}
 		fmt.Println("Board ID:", c.config.GetBoardID())
-		fmt.Println("Sprint ID:", c.config.GetSprintID())
+		fmt.Println("Sprint ID:", c.sprintIDSummary())
 		fmt.Println("Sprint Board ID:", c.config.GetSprintBoardID())
+		if epicsBoardID := c.config.GetEpicsBoardID(); epicsBoardID != "" {
+			fmt.Println("Epics Board ID:", epicsBoardID)
+		}
+		fmt.Println("Branch Pattern:", c.config.GetBranchPattern())
+		if slug := c.config.GetAccountSlug(); slug != "" {
+			fmt.Println("Account Slug:", slug)
+		}
+		fmt.Printf("Timeout: %ds\n", c.config.Timeout)
+		if c.config.GetCheckDuplicates() {
+			fmt.Println("Check Duplicates: on")
+		}
+		if limits := c.config.GetStatusAgeLimits(); len(limits) > 0 {
+			var ages []string
+			for _, status := range limits.Statuses() {
+				ages = append(ages, fmt.Sprintf("%s %s", status, formatStatusAgeLimit(limits[status])))
+			}
+			fmt.Println("Status Age Limits:", strings.Join(ages, ", "))
+		}
+		if c.config.GetSlackWebhook() != "" {
+			fmt.Println("Slack Webhook: configured")
+		}
+		if len(c.config.SlackTemplates) > 0 {
+			fmt.Println("Slack Templates:", strings.Join(slices.Sorted(maps.Keys(c.config.SlackTemplates)), ", "))
+		}
+		if c.config.Proxy != "" {
+			fmt.Println("Proxy:", c.config.Proxy)
+		}
+		if c.config.CABundle != "" {
+			fmt.Println("CA Bundle:", c.config.CABundle)
+		}
+		var shortcuts []string
+		for _, name := range monday.StatusShortcutNames() {
+			shortcuts = append(shortcuts, fmt.Sprintf("%s=%s", name, c.config.GetStatusShortcut(name)))
+		}
+		fmt.Println("Status Shortcuts:", strings.Join(shortcuts, ", "))
+		if ids := c.config.GetColumnIDs(); len(ids) > 0 {
+			fmt.Println("Columns:", strings.Join(ids, ", "))
+		} else {
+			fmt.Println("Columns: all")
+		}
+		if c.config.CacheBackend != "" {
+			fmt.Println("Cache Backend:", c.config.CacheBackend, c.config.CacheLocation)
+		}
 		return
 	case "add-filter", "addf":
 		c.HandleAddFilterCommand()
@@ -167,6 +533,18 @@ func (c *CLI) HandleConfigCommand() {
 	case "clear-all-filters", "clearallf":
 		c.HandleClearAllFiltersCommand()
 		return
+	case "save-view", "savev":
+		c.HandleSaveViewCommand()
+		return
+	case "list-views", "listv":
+		c.HandleListViewsCommand()
+		return
+	case "use-view", "usev":
+		c.HandleUseViewCommand()
+		return
+	case "delete-view", "delv":
+		c.HandleDeleteViewCommand()
+		return
 	case "filter-to-me", "me":
 		c.HandleFilterToMeCommand()
 		return
@@ -200,11 +578,34 @@ func maskAPIKey(apiKey string) string {
 
 func (c *CLI) HelpConfigCommand() {
 	fmt.Println("Config Commands:")
+	fmt.Println("  config init                        Guided setup, same as 'init'")
 	fmt.Println("  config set-api-key (key) <api-key>")
+	fmt.Println("  config set-auth-command (auth-cmd) <command>  Read the API key from a command")
+	fmt.Println("  config set-auth-static (auth-static)          Use the configured API key")
 	fmt.Println("  config set-board-id (board) <board-id>")
-	fmt.Println("  config set-sprint-id (sprint) <sprint-id>")
+	fmt.Println("  config set-sprint-id (sprint) <sprint-id | auto>  'auto' picks the sprint running today from the sprint dates")
 	fmt.Println("  config set-sprint-board-id (sprint-board) <sprint-board-id>")
+	fmt.Println("  config set-epics-board-id (epics-board) <epics-board-id>  Board of epics for 'epics list' and 'epic show'")
+	fmt.Println("  config set-branch-pattern (branch-pattern) <pattern>  e.g. feature/{id}-{slug}")
+	fmt.Println("  config set-pr-column (pr-column) <column-id>  Link column for 'task link-pr'")
+	fmt.Println("  config add-board (addb) <board-id> [name]  Configure another board, named after it on Monday by default")
+	fmt.Println("  config remove-board (rmb) <name|board-id>  Remove a configured board")
+	fmt.Println("  config boards                      List the configured boards, * marks the default")
+	fmt.Println("  config use-board (useb) <name|board-id>  Make a configured board the default")
+	fmt.Println("  config set-account-slug (account-slug) <slug>  Account subdomain used to build task URLs")
+	fmt.Println("  config set-timeout (timeout) <seconds>  Request timeout, 30 by default")
+	fmt.Println("  config set-check-duplicates (check-duplicates) <on|off>  Look for similar tasks before creating one")
+	fmt.Println("  config set-proxy (proxy) <url|none>  HTTP(S) proxy, HTTPS_PROXY from the environment with none")
+	fmt.Println("  config set-ca-bundle (ca-bundle) <pem-file|none>  Extra CA certificates, e.g. of a corporate proxy")
+	fmt.Println("  config set-status-shortcut (status-shortcut) <start|block|done> <label>  Status of 'task start', 'task block' and 'task done'")
+	fmt.Println("  config set-status-age (status-age) <status|default> [-warn <days>] [-alert <days>]  Days in a status until listings show it in yellow and red")
+	fmt.Println("  config set-slack-webhook (slack-webhook) <url|none>  Incoming webhook of 'report slack'")
+	fmt.Println("  config set-slack-template (slack-template) <name> [-title <template>] [-task <template>]  Message format of 'report slack', per view or default")
+	fmt.Println("  config set-columns (columns) <column-id,...|all>  Only fetch these column values with the tasks")
+	fmt.Println("  config set-cache (cache) <file|memory> [location]  Store backend of the cache, file in ~/.cache/monday-cli by default")
 	fmt.Println("  config show (s)")
+	fmt.Println("  config export [-out <file>] [--no-secrets]  Write the configuration as JSON, without credentials and user with --no-secrets")
+	fmt.Println("  config import <file> [--dry-run]  Merge a shared configuration: settings replaced, boards and views added, filters combined")
 	fmt.Println("")
 	fmt.Println("Filter Commands:")
 	fmt.Println("  config add-filter (addf) <type> <whitelist|blacklist> <value>")
@@ -213,6 +614,13 @@ func (c *CLI) HelpConfigCommand() {
 	fmt.Println("  config list-filters (listf)")
 	fmt.Println("  config clear-all-filters (clearallf)")
 	fmt.Println("")
+	fmt.Println("View Commands:")
+	fmt.Println("  config save-view (savev) <name> [-sort <fields>]  Save the current filters as a named view")
+	fmt.Println("  config list-views (listv)")
+	fmt.Println("  config use-view (usev) <name>      Replace the current filters with a view")
+	fmt.Println("  config delete-view (delv) <name>")
+	fmt.Println("  tasks list -view <name>            List tasks with a view instead of the current filters")
+	fmt.Println("")
 	fmt.Println("User Filter Commands:")
 	fmt.Println("  config filter-to-me (me)           Show only tasks assigned to you")
 	fmt.Println("  config add-me (addme)              Add yourself to user whitelist")
@@ -221,11 +629,14 @@ func (c *CLI) HelpConfigCommand() {
 	fmt.Println("  config add-sprint (add-s)          Add current sprint to whitelist")
 	fmt.Println("  config remove-sprint (rm-s)        Remove current sprint from whitelist")
 	fmt.Println("")
-	fmt.Println("Filter Types: status, priority, type, sprint, user_name, user_email")
+	fmt.Println("Filter Types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
+	fmt.Println("Date filters (updated, due, created) take '>' or '<' and a duration (12h, 3d, 2w) or a date (2024-01-31)")
 	fmt.Println("Examples:")
 	fmt.Println("  config add-filter status whitelist 'in progress'")
 	fmt.Println("  config add-filter priority blacklist 'low'")
 	fmt.Println("  config remove-filter type whitelist 'bug'")
+	fmt.Println("  config add-filter updated whitelist '<7d'    Updated within the last 7 days")
+	fmt.Println("  config add-filter due whitelist '<3d'        Due within 3 days or overdue")
 	fmt.Println("  config filter-to-me")
 }
 
@@ -237,76 +648,31 @@ func (c *CLI) HandleTasksCommand() {
 	subcommand := c.command.Args[0]
 	switch subcommand {
 	case "list", "ls":
+		if c.command.HasSwitch("all-boards") {
+			c.HandleListAllBoardsCommand()
+			return
+		}
 		dataStore := monday.NewDataStore()
 		tasks, timestamp, _ := dataStore.GetCachedTasks(c.config.GetBoardID())
 		fmt.Println("Tasks cached at: " + timestamp.Format(time.RFC3339))
+		c.printPinnedTasks()
 		c.PrintItems(tasks)
 		return
+	case "count", "c":
+		c.HandleTasksCountCommand()
+		return
+	case "create-batch", "cb":
+		c.HandleTasksCreateBatchCommand()
+		return
+	case "bulk", "b":
+		c.HandleTasksBulkCommand()
+		return
 	case "fetch", "f":
-		client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
-
-		boardID := c.config.GetBoardID()
-
-		fmt.Printf("🔍 Fetching tasks in board %s...\n", boardID)
-		fmt.Println("=" + strings.Repeat("=", 50))
-
-		boardService := monday.NewBoardService(client)
-		board, err := boardService.GetBoardByID(boardID)
-		if err != nil {
-			fmt.Printf("❌ Error getting board: %v\n", err)
-			os.Exit(1)
-		}
-
-		fmt.Printf("📋 Board: %s (ID: %s)\n", board.Name, board.ID)
-		fmt.Println("-" + strings.Repeat("-", len(board.Name)+20))
-
-		items, rawItems, err := client.GetBoardItems(boardID)
-		if err != nil {
-			fmt.Printf("❌ Error getting tasks: %v\n", err)
-			os.Exit(1)
-		}
-
-		if len(items) == 0 {
-			fmt.Printf("👤 No tasks in %s\n", board.Name)
+		if c.command.HasSwitch("all-boards") {
+			c.HandleFetchAllBoardsCommand()
 			return
 		}
-
-		// Fetch board users
-		fmt.Printf("👥 Fetching board users...\n")
-		users, err := client.GetBoardUsers(boardID)
-		if err != nil {
-			fmt.Printf("⚠️  Warning: Could not fetch board users: %v\n", err)
-			users = []monday.User{} // Continue without users
-		} else {
-			fmt.Printf("👥 Found %d users on board\n", len(users))
-		}
-
-		// Fetch board sprints from sprint board
-		sprintBoardID := c.config.GetSprintBoardID()
-		var sprints []monday.Sprint
-		if sprintBoardID != "" {
-			fmt.Printf("🏃 Fetching sprints from sprint board...\n")
-			sprints, err = client.GetBoardSprints(sprintBoardID)
-			if err != nil {
-				fmt.Printf("⚠️  Warning: Could not fetch board sprints: %v\n", err)
-				sprints = []monday.Sprint{} // Continue without sprints
-			} else {
-				fmt.Printf("🏃 Found %d sprints on sprint board\n", len(sprints))
-			}
-		} else {
-			fmt.Printf("⚠️  Warning: No sprint board ID configured, skipping sprint fetch\n")
-			sprints = []monday.Sprint{}
-		}
-
-		dataStore := monday.NewDataStore()
-		dataStore.ClearCache(boardID)
-		dataStore.StoreTasksRequest(boardID, items, rawItems)
-		dataStore.StoreBoardUsers(boardID, users)
-		if sprintBoardID != "" {
-			dataStore.StoreBoardSprints(sprintBoardID, sprints)
-		}
-		cacheItems, _, _ := dataStore.GetCachedTasks(boardID)
-		c.PrintItems(cacheItems)
+		c.HandleFetchCommand()
 		return
 	case "users", "u":
 		c.HandleListBoardUsersCommand()
@@ -317,19 +683,210 @@ func (c *CLI) HandleTasksCommand() {
 	case "sprint", "sp":
 		c.HandleSprintCommand()
 		return
+	case "watch", "w":
+		c.HandleWatchCommand()
+		return
+	case "diff", "d":
+		c.HandleTasksDiffCommand()
+		return
+	case "search", "find":
+		c.HandleTasksSearchCommand()
+		return
+	case "blocked", "bl":
+		c.HandleTasksBlockedCommand()
+		return
+	case "board", "kanban", "kb":
+		c.HandleTasksBoardCommand()
+		return
+	case "timeline", "gantt", "tl":
+		c.HandleTasksTimelineCommand()
+		return
 	default:
 		c.HelpTasksCommand()
 		return
 	}
 }
 
+// HandleTasksSearchCommand searches tasks by name and field qualifiers, in the cache or on the server
+func (c *CLI) HandleTasksSearchCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli tasks search <query> [-remote true]")
+		fmt.Println("Example: monday-cli tasks search login status:stuck assignee:me")
+		os.Exit(ExitValidation)
+	}
+
+	remote := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-remote", "--remote", "-r":
+			remote = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	// Keep values the shell unquoted together, e.g. sprint:Sprint 12
+	terms := make([]string, 0, len(c.command.Args)-1)
+	for _, arg := range c.command.Args[1:] {
+		if key, value, found := strings.Cut(arg, ":"); found && strings.Contains(value, " ") && !strings.Contains(arg, "\"") {
+			arg = key + ":\"" + value + "\""
+		}
+		terms = append(terms, arg)
+	}
+	queryText := strings.Join(terms, " ")
+
+	query, err := monday.ParseSearchQuery(queryText)
+	if err != nil {
+		fmt.Printf("❌ Invalid search: %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if query.IsEmpty() {
+		fmt.Println("❌ Empty search query")
+		os.Exit(ExitValidation)
+	}
+	if c.config.HasUserInfo() {
+		query.ReplaceValue("assignee", "me", c.config.GetUserInfo().Name)
+	}
+
+	boardID := c.config.GetBoardID()
+	dataStore := monday.NewDataStore()
+	cached, timestamp, ok := dataStore.GetCachedTasks(boardID)
+
+	var tasks []monday.Task
+	if remote || !ok {
+		progressf("🔍 Searching board %s...\n", boardID)
+		client := c.newClient()
+		found, _, err := client.SearchBoardItems(boardID, strings.Join(query.Words, " "))
+		if err != nil {
+			exitWithError("Error searching tasks", err)
+		}
+		for _, task := range found {
+			if cachedTask, ok := cached[task.ID]; ok {
+				task.LocalId = cachedTask.LocalId
+			}
+			tasks = append(tasks, task)
+		}
+	} else {
+		progressf("Tasks cached at: %s\n", timestamp.Format(time.RFC3339))
+		for _, task := range cached {
+			tasks = append(tasks, task)
+		}
+	}
+
+	matches := monday.SearchTasks(tasks, query)
+	if flagFilters, ok := c.filterFlags(); ok {
+		matches = monday.FilterTasks(matches, flagFilters)
+	}
+	printf("🔎 Found %d tasks matching %q:\n", len(matches), queryText)
+	if len(matches) == 0 {
+		os.Exit(ExitNotFound)
+	}
+	c.printTasks(matches)
+}
+
+// HandleFetchCommand fetches the board tasks, users and sprints and stores them in the cache
+func (c *CLI) HandleFetchCommand() {
+	client := c.newClient()
+
+	boardID := c.config.GetBoardID()
+
+	progressf("🔍 Fetching tasks in board %s...\n", boardID)
+	progressf("=%s\n", strings.Repeat("=", 50))
+
+	boardService := monday.NewBoardService(client)
+	board, err := boardService.GetBoardByID(boardID)
+	if err != nil {
+		exitWithError("Error getting board", err)
+	}
+
+	fmt.Printf("📋 Board: %s (ID: %s)\n", board.Name, board.ID)
+	fmt.Println("-" + strings.Repeat("-", len(board.Name)+20))
+
+	items, rawItems, err := client.GetBoardItems(boardID)
+	if err != nil {
+		exitWithError("Error getting tasks", err)
+	}
+
+	if len(items) == 0 {
+		fmt.Printf("👤 No tasks in %s\n", board.Name)
+		return
+	}
+
+	// Fetch board users
+	progressf("👥 Fetching board users...\n")
+	users, err := client.GetBoardUsers(boardID)
+	if err != nil {
+		fmt.Printf("⚠️  Warning: Could not fetch board users: %v\n", err)
+		users = []monday.User{} // Continue without users
+	} else {
+		progressf("👥 Found %d users on board\n", len(users))
+	}
+
+	// Fetch board sprints from sprint board
+	sprintBoardID := c.config.GetSprintBoardID()
+	var sprints []monday.Sprint
+	if sprintBoardID != "" {
+		progressf("🏃 Fetching sprints from sprint board...\n")
+		sprints, err = client.GetBoardSprints(sprintBoardID)
+		if err != nil {
+			fmt.Printf("⚠️  Warning: Could not fetch board sprints: %v\n", err)
+			sprints = []monday.Sprint{} // Continue without sprints
+		} else {
+			progressf("🏃 Found %d sprints on sprint board\n", len(sprints))
+		}
+	} else {
+		fmt.Printf("⚠️  Warning: No sprint board ID configured, skipping sprint fetch\n")
+		sprints = []monday.Sprint{}
+	}
+
+	dataStore := monday.NewDataStore()
+	// The API has no last updater of items nor the time of status changes, they are taken
+	// from the activity log
+	progressf("📜 Reading who changed the tasks recently...\n")
+	analytics := monday.NewAnalyticsService(client, dataStore)
+	if err := analytics.SetTaskActivity(boardID, items, time.Now().Add(-monday.UpdaterWindow)); err != nil {
+		fmt.Printf("⚠️  Warning: Could not read the activity log: %v\n", err)
+	}
+
+	dataStore.StoreTasksRequest(boardID, items, rawItems)
+	dataStore.StoreBoardUsers(boardID, users)
+	if sprintBoardID != "" {
+		dataStore.StoreBoardSprints(sprintBoardID, sprints)
+	}
+	cacheItems, _, _ := dataStore.GetCachedTasks(boardID)
+	c.PrintItems(cacheItems)
+}
+
 func (c *CLI) HelpTasksCommand() {
 	fmt.Println("Tasks Commands:")
 	fmt.Println("  tasks list (ls)      Show your assigned tasks")
-	fmt.Println("  tasks fetch (f)      Fetch your assigned tasks")
+	fmt.Println("      [-view <name>] [-sort <fields>] [--reverse] [-group-by <field>] [-board <name> | --all-boards] [filter flags]")
+	fmt.Println("      [--summary]      Print only the number of tasks per status, priority and assignee")
+	fmt.Println("  tasks count (c) [filter flags] [--summary]  Print the number of matching tasks")
+	fmt.Println("  tasks fetch (f)      Fetch your assigned tasks [-board <name> | --all-boards]")
+	fmt.Println("  tasks create-batch (cb) <file | -> [-s -p -t -assignee -sprint -due <value>] [-batch <n>]")
+	fmt.Println("                       Create a task per line of a file or stdin, lines are names or JSON objects")
+	fmt.Println("  tasks bulk (b) -filter <expr> [-set-status <s>] [-set-priority <p>] [-set-type <t>] [--confirm]")
+	fmt.Println("                       Change every cached task matching the filter, e.g. -filter \"status:ready for testing\"")
 	fmt.Println("  tasks users (u)      Show board users")
 	fmt.Println("  tasks sprints (s)    Show board sprints")
 	fmt.Println("  tasks sprint (sp)    Sprint-specific commands")
+	fmt.Println("  tasks diff (d)       Show changes between the last two fetches")
+	fmt.Println("  tasks watch (w) [-interval <duration>]  Re-fetch periodically and show changes (default 60s)")
+	fmt.Println("  tasks blocked (bl)   Show tasks whose dependencies are not done yet")
+	fmt.Println("  tasks timeline (gantt, tl) [-sprint <name>]  Gantt chart of the current sprint from timeline columns")
+	fmt.Println("  tasks board (kanban, kb) [-i] [filter flags]  Show tasks in a column per status, -i moves tasks with < and >")
+	fmt.Println("  tasks search (find) <query> [-remote true] [filter flags]  Search cached tasks, or the board with -remote")
+	fmt.Println("")
+	fmt.Println("Search Query:")
+	fmt.Println("  Free text matches task names, fields are matched with status:, priority:, type:, sprint: and assignee:")
+	fmt.Println("  Example: tasks search login status:stuck assignee:me sprint:\"Sprint 12\"")
+	fmt.Println("")
+	fmt.Println(filterFlagsHelp)
+	fmt.Println("")
+	fmt.Println("Sorting:")
+	fmt.Println("  -sort <field>[,field]    Sort by name, updated_at, priority, status, sprint, assignee or type")
+	fmt.Println("  --reverse                Reverse the order")
+	fmt.Println("  -group-by <field>        Group by status, priority, type, sprint or assignee with counts and points per group, or none")
+	fmt.Println("  Tasks are grouped by status when sorting by status first (the default: status,priority,type)")
 }
 
 func (c *CLI) HandleTaskCommand() {
@@ -338,34 +895,109 @@ func (c *CLI) HandleTaskCommand() {
 		return
 	}
 	subcommand := c.command.Args[0]
+	// "-" instead of a task index picks the task with the fuzzy finder
+	if len(c.command.Args) > 1 && c.command.Args[1] == "-" && subcommand != "create" && subcommand != "c" {
+		c.command.Args[1] = strconv.Itoa(c.pickTask(bufio.NewReader(os.Stdin)).LocalId)
+	}
 	switch subcommand {
 	case "show", "s":
 		if len(c.command.Args) < 2 {
-			fmt.Println("Usage: monday-cli task show <task-index>")
-			return
+			fmt.Println("Usage: monday-cli task show <task-index> [--all-columns]")
+			os.Exit(ExitValidation)
 		}
 		localId, err := strconv.Atoi(c.command.Args[1])
 		if err != nil {
 			fmt.Printf("❌ Invalid task local ID: %v\n", err)
-			os.Exit(1)
+			os.Exit(ExitValidation)
 		}
 		dataStore := monday.NewDataStore()
 		task, timestamp, ok := dataStore.GetCachedTaskByLocalId(c.config.GetBoardID(), localId)
 		if !ok {
 			fmt.Printf("❌ Task %d not found\n", localId)
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 		fmt.Println("Task cached at: " + timestamp.Format(time.RFC3339))
 		PrintTask(task)
+		printTaskCreation(task)
+		printTaskUpdater(task)
+		printTaskDescription(task)
+		tasks, _, _ := dataStore.GetCachedTasks(c.config.GetBoardID())
+		printTaskDependencies(task, tasks)
+		if c.command.HasSwitch("all-columns") {
+			c.printTaskColumns(task)
+		}
+		return
+	case "describe", "desc":
+		c.HandleTaskDescribeCommand()
+		return
+	case "pin":
+		c.HandleTaskPinCommand()
+		return
+	case "unpin":
+		c.HandleTaskUnpinCommand()
+		return
+	case "sprint", "sp":
+		c.HandleTaskSprintCommand()
+		return
+	case "rename", "rn":
+		c.HandleTaskRenameCommand()
+		return
+	case "restore":
+		c.HandleTaskRestoreCommand()
+		return
+	case "branch", "br":
+		c.HandleTaskBranchCommand()
+		return
+	case "current", "cur":
+		c.HandleTaskCurrentCommand()
+		return
+	case "commit-msg":
+		c.HandleTaskCommitMsgCommand()
+		return
+	case "link-pr":
+		c.HandleTaskLinkPRCommand()
+		return
+	case "open", "o":
+		c.HandleTaskOpenCommand()
+		return
+	case "copy", "cp":
+		c.HandleTaskCopyCommand()
+		return
+	case "clone":
+		c.HandleTaskCloneCommand()
+		return
+	case "move", "mv":
+		c.HandleTaskMoveCommand()
+		return
+	case "pick", "p":
+		c.HandleTaskPickCommand()
+		return
+	case "assign", "a":
+		c.HandleTaskAssignCommand()
+		return
+	case monday.ShortcutDone, monday.ShortcutStart, monday.ShortcutBlock:
+		c.HandleTaskStatusShortcutCommand(subcommand)
+		return
+	case "open-pr":
+		c.HandleTaskOpenPRCommand()
+		return
+	case "install-git-hook":
+		c.HandleInstallGitHookCommand()
 		return
 	case "create", "c":
+		if c.command.HasSwitch("interactive") {
+			c.HandleTaskCreateWizard()
+			return
+		}
 		if len(c.command.Args) < 2 {
 			fmt.Println("Usage: monday-cli task create <task-name> [flags]")
+			fmt.Println("       monday-cli task create -i  Pick every field from menus")
+			fmt.Println("       add --check-duplicates to look for similar tasks first, see 'config set-check-duplicates'")
 			fmt.Println("Flags:")
 			fmt.Println("  -status, -s <status>     Set task status (done/d, in progress/p, stuck/s, etc.)")
 			fmt.Println("  -priority, -p <priority> Set task priority (critical/c, high/h, medium/m, low/l)")
 			fmt.Println("  -type, -t <type>         Set task type (bug/b, feature/f, test/t, security/s, improvement/i)")
-			return
+			os.Exit(ExitValidation)
 		}
 
 		taskName := c.command.Args[1]
@@ -379,25 +1011,28 @@ func (c *CLI) HandleTaskCommand() {
 				if status == "" {
 					fmt.Printf("❌ Invalid status: %s\n", flag.Value)
 					fmt.Println("Valid status values: done(d), in progress(p), stuck(s), waiting review(r), ready for testing(t), removed(rm)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
 			case "-priority", "-p":
 				priority = getPriorityValue(flag.Value)
 				if priority == "" {
 					fmt.Printf("❌ Invalid priority: %s\n", flag.Value)
 					fmt.Println("Valid priority values: critical(c), high(h), medium(m), low(l)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
 			case "-type", "-t":
 				taskType = getTypeValue(flag.Value)
 				if taskType == "" {
 					fmt.Printf("❌ Invalid type: %s\n", flag.Value)
 					fmt.Println("Valid type values: bug(b), feature(f), test(t), security(s), quality(q)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
 			}
 		}
 
+		client := c.newClient()
+		c.confirmNotDuplicate(bufio.NewReader(os.Stdin), client, c.config.GetBoardID(), taskName)
+
 		fmt.Printf("Creating task: %s\n", taskName)
 		if status != "" {
 			fmt.Printf("  Status: %s\n", status)
@@ -409,32 +1044,52 @@ func (c *CLI) HandleTaskCommand() {
 			fmt.Printf("  Type: %s\n", taskType)
 		}
 
-		client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
-		localId, task, err := client.CreateTask(c.config.GetBoardID(), c.config.GetUserInfo().ID, taskName, status, priority, taskType)
-		if err != nil {
-			fmt.Printf("❌ Error creating task: %v\n", err)
+		task, err := client.CreateTask(c.config.GetBoardID(), c.config.GetUserInfo().ID, taskName, status, priority, taskType)
+		if c.queueIfOffline(err, monday.PendingOperation{
+			Kind:     monday.OperationCreate,
+			TaskName: taskName,
+			Details:  monday.TaskDetails{Status: status, Priority: priority, Type: taskType, AssigneeID: c.config.GetUserInfo().ID},
+		}) {
+			return
+		}
+		if errors.Is(err, monday.ErrDryRun) {
+			c.printPredictedCreate(monday.Task{
+				Name:     taskName,
+				Status:   monday.Status(status),
+				Priority: monday.Priority(priority),
+				Type:     monday.Type(taskType),
+			})
 			return
 		}
+		if err != nil {
+			exitWithError("Error creating task", err)
+		}
+		localId := c.cacheCreatedTask(c.config.GetBoardID(), task)
+		c.recordHistory(monday.HistoryCreate, monday.Task{}, *task, "")
 		fmt.Printf("✅ Task %s created with ID %d\n", task.Name, localId)
 		PrintTask(*task)
 		return
 	case "edit", "e":
+		if c.command.HasSwitch("editor") {
+			c.HandleTaskEditorCommand()
+			return
+		}
 		if len(c.command.Args) < 2 {
 			fmt.Println("Usage: monday-cli task edit <task-index> [flags]")
 			fmt.Println("Flags:")
 			fmt.Println("  -status, -s <status>     Set task status (done/d, in progress/p, stuck/s, etc.)")
 			fmt.Println("  -priority, -p <priority> Set task priority (critical/c, high/h, medium/m, low/l)")
 			fmt.Println("  -type, -t <type>         Set task type (bug/b, feature/f, test/t, security/s, improvement/i)")
-			return
+			os.Exit(ExitValidation)
 		}
 		taskIndex, err := strconv.Atoi(c.command.Args[1])
 		if err != nil {
 			fmt.Printf("❌ Invalid task index: %v\n", err)
-			os.Exit(1)
+			os.Exit(ExitValidation)
 		}
 
 		// Parse flags
-		var status, priority, taskType string
+		var status, priority, taskType, tagChanges string
 		for _, flag := range c.command.Flags {
 			switch flag.Flag {
 			case "-status", "-s":
@@ -442,36 +1097,38 @@ func (c *CLI) HandleTaskCommand() {
 				if status == "" {
 					fmt.Printf("❌ Invalid status: %s\n", flag.Value)
 					fmt.Println("Valid status values: done(d), in progress(p), stuck(s), waiting review(r), ready for testing(t), removed(rm)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
 			case "-priority", "-p":
 				priority = getPriorityValue(flag.Value)
 				if priority == "" {
 					fmt.Printf("❌ Invalid priority: %s\n", flag.Value)
 					fmt.Println("Valid priority values: critical(c), high(h), medium(m), low(l)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
 			case "-type", "-t":
 				taskType = getTypeValue(flag.Value)
 				if taskType == "" {
 					fmt.Printf("❌ Invalid type: %s\n", flag.Value)
 					fmt.Println("Valid type values: bug(b), feature(f), test(t), security(s), quality(q)")
-					os.Exit(1)
+					os.Exit(ExitValidation)
 				}
+			case "-tags", "--tags":
+				tagChanges = flag.Value
 			}
 		}
 
 		// Check if at least one field is being updated
-		if status == "" && priority == "" && taskType == "" {
-			fmt.Println("❌ No fields to update. Please specify at least one flag (-status, -priority, or -type)")
-			return
+		if status == "" && priority == "" && taskType == "" && tagChanges == "" {
+			fmt.Println("❌ No fields to update. Please specify at least one flag (-status, -priority, -type or -tags)")
+			os.Exit(ExitValidation)
 		}
 
 		dataStore := monday.NewDataStore()
 		task, _, ok := dataStore.GetCachedTaskByLocalId(c.config.GetBoardID(), taskIndex)
 		if !ok {
 			fmt.Printf("❌ Task %d not found\n", taskIndex)
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 
 		fmt.Printf("Updating task %d: %s\n", taskIndex, task.Name)
@@ -484,14 +1141,57 @@ func (c *CLI) HandleTaskCommand() {
 		if taskType != "" {
 			fmt.Printf("  Type: %s\n", taskType)
 		}
+		var tags []string
+		if tagChanges != "" {
+			tags, err = monday.ApplyTagChanges(task.Tags, tagChanges)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+			fmt.Printf("  Tags: %s\n", strings.Join(tags, ", "))
+		}
 
-		client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
-		updatedTask, err := client.UpdateTask(c.config.GetBoardID(), c.config.GetUserEmail(), task, status, priority, taskType)
+		client := c.newClient()
+		updatedTask := &task
+		if status != "" || priority != "" || taskType != "" {
+			updatedTask, err = client.UpdateTask(c.config.GetBoardID(), c.config.GetUserEmail(), task, status, priority, taskType)
+		}
+		if err == nil && tagChanges != "" {
+			updatedTask, err = client.UpdateTaskTags(c.config.GetBoardID(), *updatedTask, tags)
+		}
+		if c.queueIfOffline(err, monday.PendingOperation{
+			Kind:          monday.OperationUpdate,
+			TaskID:        task.ID,
+			TaskName:      task.Name,
+			Details:       monday.TaskDetails{Status: status, Priority: priority, Type: taskType},
+			TagChanges:    tagChanges,
+			BaseUpdatedAt: task.UpdatedAt,
+		}) {
+			return
+		}
+		if errors.Is(err, monday.ErrDryRun) {
+			predicted := task
+			if tagChanges != "" {
+				predicted.Tags = tags
+			}
+			if status != "" {
+				predicted.Status = monday.Status(status)
+			}
+			if priority != "" {
+				predicted.Priority = monday.Priority(priority)
+			}
+			if taskType != "" {
+				predicted.Type = monday.Type(taskType)
+			}
+			fmt.Println("📝 Predicted cache change:")
+			PrintTaskChanges(monday.DiffTasks(map[string]monday.Task{task.ID: task}, map[string]monday.Task{task.ID: predicted}), "")
+			return
+		}
 		if err != nil {
-			fmt.Printf("❌ Error updating task: %v\n", err)
-			os.Exit(1)
+			exitWithError("Error updating task", err)
 		}
 		dataStore.UpdateCachedTaskByLocalId(c.config.GetBoardID(), taskIndex, *updatedTask)
+		c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
 		fmt.Printf("✅ Task %d updated successfully\n", taskIndex)
 		PrintTask(*updatedTask)
 		return
@@ -501,6 +1201,33 @@ func (c *CLI) HandleTaskCommand() {
 	}
 }
 
+// cacheCreatedTask adds a task created on Monday to the cache and returns its local ID,
+// or 0 when it could not be cached
+func (c *CLI) cacheCreatedTask(boardID string, task *monday.Task) int {
+	localId, err := monday.NewDataStore().StoreTaskRequest(boardID, *task)
+	if err != nil {
+		fmt.Printf("⚠️  Warning: Could not add the task to the cache: %v\n", err)
+		return 0
+	}
+	task.LocalId = localId
+	return localId
+}
+
+// printPredictedCreate shows how the cache would change if a task was created
+func (c *CLI) printPredictedCreate(task monday.Task) {
+	nextLocalId := 1
+	if localIdMap, err := monday.NewDataStore().GetLocalIdMap(c.config.GetBoardID()); err == nil {
+		for localId := range localIdMap {
+			if localId >= nextLocalId {
+				nextLocalId = localId + 1
+			}
+		}
+	}
+	task.LocalId = nextLocalId
+	fmt.Println("📝 Predicted cache change:")
+	PrintTaskChanges([]monday.TaskChange{{Kind: monday.ChangeAdded, Task: task}}, "")
+}
+
 func getStatusValue(status string) string {
 	switch status {
 	case "done", "d":
@@ -555,16 +1282,41 @@ func getTypeValue(taskType string) string {
 func (c *CLI) HelpTaskCommand() {
 	fmt.Println("Task Commands:")
 	fmt.Println("  task show (s) <task-index> Show a specific task")
+	fmt.Println("  task show (s) <task-index> --all-columns  Also fetch and show every column: formulas, ratings, checkboxes, phones, emails, countries, world clocks")
+	fmt.Println("  task branch (br) <task-index> Create and check out a git branch for a task")
+	fmt.Println("  task current (cur)         Show the task of the current git branch")
+	fmt.Println("  task commit-msg            Print the commit trailer for the task of the current branch")
+	fmt.Println("  task install-git-hook [-force true]  Add the trailer to commits with a prepare-commit-msg hook")
+	fmt.Println("  task link-pr <task-index> <pr-url>  Post the pull request as an update and set the PR link column")
+	fmt.Println("  task open-pr <task-index>  Open the linked pull request in the browser")
+	fmt.Println("  task open (o) <task-index> [--print]  Open the task on monday.com, --print only outputs the URL")
+	fmt.Println("  task assign (a) <task-index> [-user <name|id|me> | -team <team-name>]  Assign a task, without flags it is unassigned")
+	fmt.Println("  task start <task-index> [-comment <text>]  Set the task in progress, optionally posting an update")
+	fmt.Println("  task block <task-index> [reason]  Set the task to stuck and post the reason as an update")
+	fmt.Println("  task done <task-index> [-comment <text>]  Set the task to done, see 'config set-status-shortcut' for other labels")
+	fmt.Println("  task pick (p)              Find a cached task by typing and show, edit, open, comment on or assign it")
+	fmt.Println("  task copy (cp) <task-index> [-format url|id|markdown]  Copy the task URL, item ID or a markdown link")
+	fmt.Println("  task clone <task-index> [-name <name>] [-to-board <board>] [-map <column-id>:<column-id>,...]  Copy a task with its column values")
+	fmt.Println("  task move (mv) <task-index> -to-board <board> [-group <group>] [-map <column-id>:<column-id>,...]  Move a task into a group of another board")
 	fmt.Println("  task create (c) <task-name> [flags] Create a new task")
+	fmt.Println("  task create -i [task-name]  Create a task step by step, picking labels, sprint and assignee from menus")
 	fmt.Println("    Flags:")
 	fmt.Println("      -status, -s <status>     Set task status (done/d, in progress/p, stuck/s, etc.)")
 	fmt.Println("      -priority, -p <priority> Set task priority (critical/c, high/h, medium/m, low/l)")
 	fmt.Println("      -type, -t <type>         Set task type (bug/b, feature/f, test/t, security/s, improvement/i)")
 	fmt.Println("  task edit (e) <task-index> [flags] Edit a specific task")
+	fmt.Println("  task edit (e) <task-index> --editor Edit the fields of a task as YAML in $EDITOR")
 	fmt.Println("    Flags:")
 	fmt.Println("      -status, -s <status>     Set task status (done/d, in progress/p, stuck/s, etc.)")
 	fmt.Println("      -priority, -p <priority> Set task priority (critical/c, high/h, medium/m, low/l)")
 	fmt.Println("      -type, -t <type>         Set task type (bug/b, feature/f, test/t, security/s, improvement/i)")
+	fmt.Println("      -tags <changes>          Add or remove tags, e.g. \"+backend,-urgent\"")
+	fmt.Println("  task describe (desc) <task-index>  Edit the description (long text column) of a task in $EDITOR")
+	fmt.Println("  task pin <task-index>      Keep a task at the top of 'tasks list' whatever the filters")
+	fmt.Println("  task unpin <task-index>    Remove a task from the pinned tasks")
+	fmt.Println("  task sprint (sp) <task-index> <sprint|current|none>  Link a task to a sprint, or unlink it")
+	fmt.Println("  task rename (rn) <task-index> \"<new name>\"  Change the name of a task")
+	fmt.Println("  task restore <item-id>  Bring back an archived or deleted item listed by 'board trash'")
 }
 
 func (c *CLI) HandleUserCommand() {
@@ -575,15 +1327,14 @@ func (c *CLI) HandleUserCommand() {
 	subcommand := c.command.Args[0]
 	switch subcommand {
 	case "info", "i":
-		client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
+		client := c.newClient()
 
-		fmt.Println("🔍 Fetching user information...")
+		progressf("🔍 Fetching user information...\n")
 		fmt.Println("=" + strings.Repeat("=", 50))
 
 		user, err := client.GetUserInfo()
 		if err != nil {
-			fmt.Printf("❌ Error getting user info: %v\n", err)
-			os.Exit(1)
+			exitWithError("Error getting user info", err)
 		}
 
 		// Save user info to config
@@ -594,6 +1345,12 @@ func (c *CLI) HandleUserCommand() {
 
 		PrintUserInfo(user)
 		return
+	case "directory", "dir":
+		c.HandleUserDirectoryCommand()
+		return
+	case "search", "s":
+		c.HandleUserSearchCommand()
+		return
 	default:
 		c.HelpUserCommand()
 		return
@@ -603,15 +1360,148 @@ func (c *CLI) HandleUserCommand() {
 func (c *CLI) HelpUserCommand() {
 	fmt.Println("User Commands:")
 	fmt.Println("  user info (i)   Show current user information")
+	fmt.Println("  user directory (dir) [-refresh true]  Show the cached account user directory")
+	fmt.Println("  user search (s) <name>                Find users by name, email, title or team and show their IDs")
+}
+
+// HandleUserDirectoryCommand lists the account-wide user directory
+func (c *CLI) HandleUserDirectoryCommand() {
+	dataStore := monday.NewDataStore()
+
+	refresh := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-refresh", "--refresh", "-r":
+			refresh = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	directory, err := dataStore.GetUserDirectory()
+	if err != nil {
+		fmt.Printf("⚠️  Warning: %v\n", err)
+	}
+	if refresh || directory.IsStale() {
+		progressf("🔍 Refreshing user directory...\n")
+		client := c.newClient()
+		directory, err = dataStore.RefreshUserDirectory(client)
+		if err != nil {
+			exitWithError("Error refreshing user directory", err)
+		}
+	}
+
+	users := make([]monday.User, 0, len(directory.Users))
+	for _, user := range directory.Users {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return strings.ToLower(users[i].Name) < strings.ToLower(users[j].Name)
+	})
+
+	fmt.Printf("👥 User Directory (cached at: %s)\n", directory.Timestamp.Format(time.RFC3339))
+	fmt.Println("=" + strings.Repeat("=", 50))
+	for i, user := range users {
+		PrintDirectoryUser(i+1, user)
+	}
+	fmt.Printf("📊 Total users: %d\n", len(users))
+}
+
+// HandleUserSearchCommand finds users in the user directory, refreshing it when stale. Users
+// missing from the directory, e.g. new hires, are searched on Monday.
+func (c *CLI) HandleUserSearchCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli user search <name>")
+		os.Exit(ExitValidation)
+	}
+	query := strings.Join(c.command.Args[1:], " ")
+
+	dataStore := monday.NewDataStore()
+	directory, err := dataStore.GetUserDirectory()
+	if err != nil {
+		fmt.Printf("⚠️  Warning: %v\n", err)
+	}
+	if directory.IsStale() {
+		progressf("🔍 Refreshing user directory...\n")
+		if refreshed, err := dataStore.RefreshUserDirectory(c.newClient()); err != nil {
+			fmt.Printf("⚠️  Warning: Could not refresh user directory: %v\n", err)
+		} else {
+			directory = refreshed
+		}
+	}
+
+	matches := directory.Search(query)
+	if len(matches) == 0 {
+		progressf("🔍 Searching users on Monday.com...\n")
+		matches, err = c.newClient().SearchUsers(query)
+		if err != nil {
+			exitWithError("Error searching users", err)
+		}
+	}
+	if len(matches) == 0 {
+		fmt.Printf("❌ No users matching %q\n", query)
+		os.Exit(ExitNotFound)
+	}
+	for i, user := range matches {
+		PrintDirectoryUser(i+1, user)
+	}
+}
+
+// PrintDirectoryUser prints a numbered user with ID, title and teams
+func PrintDirectoryUser(number int, user monday.User) {
+	fmt.Printf("%d. %s (%s)\n", number, user.Name, user.Email)
+	fmt.Printf("   🆔 ID: %s\n", user.ID)
+	if user.Title != "" {
+		fmt.Printf("   💼 Title: %s\n", user.Title)
+	}
+	if len(user.Teams) > 0 {
+		teams := make([]string, len(user.Teams))
+		for i, team := range user.Teams {
+			teams[i] = team.Name
+		}
+		fmt.Printf("   👥 Teams: %s\n", strings.Join(teams, ", "))
+	}
+}
+
+func (c *CLI) HandleAPICommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpAPICommand()
+		return
+	}
+	subcommand := c.command.Args[0]
+	switch subcommand {
+	case "ping", "p":
+		client := c.newClient()
+
+		progressf("📡 Testing connection to Monday.com...\n")
+		fmt.Println("=" + strings.Repeat("=", 50))
+
+		result := client.Ping()
+		PrintPingResult(result)
+		if result.NetworkError != nil {
+			os.Exit(ExitNetwork)
+		}
+		if result.AuthError != nil {
+			os.Exit(exitCodeForError(result.AuthError))
+		}
+		return
+	default:
+		c.HelpAPICommand()
+		return
+	}
+}
+
+func (c *CLI) HelpAPICommand() {
+	fmt.Println("API Commands:")
+	fmt.Println("  api ping (p)    Test the connection and report latency, TLS/proxy details and the authenticated user")
 }
 
 // Filter command handlers
 func (c *CLI) HandleAddFilterCommand() {
 	if len(c.command.Args) < 4 {
 		fmt.Println("Usage: monday-cli config add-filter <type> <whitelist|blacklist> <value>")
-		fmt.Println("Types: status, priority, type, sprint, user_name, user_email")
+		fmt.Println("Types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
 		fmt.Println("Example: monday-cli config add-filter status whitelist 'in progress'")
-		return
+		fmt.Println("Example: monday-cli config add-filter updated blacklist '>30d'")
+		os.Exit(ExitValidation)
 	}
 
 	filterType := monday.FilterType(c.command.Args[1])
@@ -622,6 +1512,8 @@ func (c *CLI) HandleAddFilterCommand() {
 	validTypes := []monday.FilterType{
 		monday.FilterStatus, monday.FilterPriority, monday.FilterTaskType,
 		monday.FilterSprint, monday.FilterUserName, monday.FilterUserEmail,
+		monday.FilterUpdated, monday.FilterDue, monday.FilterTag, monday.FilterTeam,
+		monday.FilterCreator, monday.FilterCreated,
 	}
 	validType := false
 	for _, vt := range validTypes {
@@ -632,21 +1524,21 @@ func (c *CLI) HandleAddFilterCommand() {
 	}
 	if !validType {
 		fmt.Printf("❌ Invalid filter type: %s\n", filterType)
-		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email")
-		return
+		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
+		os.Exit(ExitValidation)
 	}
 
 	// Validate list type
 	if listType != monday.Whitelist && listType != monday.Blacklist {
 		fmt.Printf("❌ Invalid list type: %s\n", listType)
 		fmt.Println("Valid types: whitelist, blacklist")
-		return
+		os.Exit(ExitValidation)
 	}
 
 	err := c.config.AddFilter(filterType, listType, value)
 	if err != nil {
 		fmt.Printf("❌ Error adding filter: %v\n", err)
-		return
+		os.Exit(ExitValidation)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -656,9 +1548,9 @@ func (c *CLI) HandleAddFilterCommand() {
 func (c *CLI) HandleRemoveFilterCommand() {
 	if len(c.command.Args) < 4 {
 		fmt.Println("Usage: monday-cli config remove-filter <type> <whitelist|blacklist> <value>")
-		fmt.Println("Types: status, priority, type, sprint, user_name, user_email")
+		fmt.Println("Types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
 		fmt.Println("Example: monday-cli config remove-filter status whitelist 'in progress'")
-		return
+		os.Exit(ExitValidation)
 	}
 
 	filterType := monday.FilterType(c.command.Args[1])
@@ -669,6 +1561,8 @@ func (c *CLI) HandleRemoveFilterCommand() {
 	validTypes := []monday.FilterType{
 		monday.FilterStatus, monday.FilterPriority, monday.FilterTaskType,
 		monday.FilterSprint, monday.FilterUserName, monday.FilterUserEmail,
+		monday.FilterUpdated, monday.FilterDue, monday.FilterTag, monday.FilterTeam,
+		monday.FilterCreator, monday.FilterCreated,
 	}
 	validType := false
 	for _, vt := range validTypes {
@@ -679,21 +1573,21 @@ func (c *CLI) HandleRemoveFilterCommand() {
 	}
 	if !validType {
 		fmt.Printf("❌ Invalid filter type: %s\n", filterType)
-		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email")
-		return
+		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
+		os.Exit(ExitValidation)
 	}
 
 	// Validate list type
 	if listType != monday.Whitelist && listType != monday.Blacklist {
 		fmt.Printf("❌ Invalid list type: %s\n", listType)
 		fmt.Println("Valid types: whitelist, blacklist")
-		return
+		os.Exit(ExitValidation)
 	}
 
 	err := c.config.RemoveFilter(filterType, listType, value)
 	if err != nil {
 		fmt.Printf("❌ Error removing filter: %v\n", err)
-		return
+		os.Exit(ExitValidation)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -703,9 +1597,9 @@ func (c *CLI) HandleRemoveFilterCommand() {
 func (c *CLI) HandleClearFilterCommand() {
 	if len(c.command.Args) < 3 {
 		fmt.Println("Usage: monday-cli config clear-filter <type> <whitelist|blacklist>")
-		fmt.Println("Types: status, priority, type, sprint, user_name, user_email")
+		fmt.Println("Types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
 		fmt.Println("Example: monday-cli config clear-filter status whitelist")
-		return
+		os.Exit(ExitValidation)
 	}
 
 	filterType := monday.FilterType(c.command.Args[1])
@@ -715,6 +1609,8 @@ func (c *CLI) HandleClearFilterCommand() {
 	validTypes := []monday.FilterType{
 		monday.FilterStatus, monday.FilterPriority, monday.FilterTaskType,
 		monday.FilterSprint, monday.FilterUserName, monday.FilterUserEmail,
+		monday.FilterUpdated, monday.FilterDue, monday.FilterTag, monday.FilterTeam,
+		monday.FilterCreator, monday.FilterCreated,
 	}
 	validType := false
 	for _, vt := range validTypes {
@@ -725,21 +1621,21 @@ func (c *CLI) HandleClearFilterCommand() {
 	}
 	if !validType {
 		fmt.Printf("❌ Invalid filter type: %s\n", filterType)
-		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email")
-		return
+		fmt.Println("Valid types: status, priority, type, sprint, user_name, user_email, updated, due, tag, team, creator, created")
+		os.Exit(ExitValidation)
 	}
 
 	// Validate list type
 	if listType != monday.Whitelist && listType != monday.Blacklist {
 		fmt.Printf("❌ Invalid list type: %s\n", listType)
 		fmt.Println("Valid types: whitelist, blacklist")
-		return
+		os.Exit(ExitValidation)
 	}
 
 	err := c.config.ClearFilter(filterType, listType)
 	if err != nil {
 		fmt.Printf("❌ Error clearing filter: %v\n", err)
-		return
+		os.Exit(ExitValidation)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -753,6 +1649,8 @@ func (c *CLI) HandleListFiltersCommand() {
 	filterTypes := []monday.FilterType{
 		monday.FilterStatus, monday.FilterPriority, monday.FilterTaskType,
 		monday.FilterSprint, monday.FilterUserName, monday.FilterUserEmail,
+		monday.FilterUpdated, monday.FilterDue, monday.FilterTag, monday.FilterTeam,
+		monday.FilterCreator, monday.FilterCreated,
 	}
 
 	for _, filterType := range filterTypes {
@@ -776,6 +1674,111 @@ func (c *CLI) HandleListFiltersCommand() {
 	}
 }
 
+// HandleSaveViewCommand saves the current filters, and optionally a sort order, as a named view
+func (c *CLI) HandleSaveViewCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config save-view <name> [-sort <fields>]")
+		fmt.Println("Example: monday-cli config save-view standup")
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+
+	sortOrder := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-sort", "--sort":
+			sortOrder = flag.Value
+		}
+	}
+
+	_, replaced := c.config.GetView(name)
+	c.config.SaveView(name, sortOrder)
+	c.config.Save(monday.GetConfigPath())
+	if replaced {
+		fmt.Printf("✅ Updated view '%s' with the current filters\n", name)
+	} else {
+		fmt.Printf("✅ Saved the current filters as view '%s'\n", name)
+	}
+	fmt.Printf("💡 Use it with 'tasks list -view %s'\n", name)
+}
+
+// HandleListViewsCommand prints all saved views with their non-empty filters
+func (c *CLI) HandleListViewsCommand() {
+	names := c.config.ViewNames()
+	if len(names) == 0 {
+		fmt.Println("No views saved")
+		fmt.Println("💡 Set up filters and run 'config save-view <name>'")
+		return
+	}
+
+	fmt.Println("👁️  Saved Views:")
+	fmt.Println("=" + strings.Repeat("=", 50))
+	for _, name := range names {
+		view, _ := c.config.GetView(name)
+		fmt.Printf("\n📋 %s\n", name)
+		if view.Sort != "" {
+			fmt.Printf("  ↕️  Sort: %s\n", view.Sort)
+		}
+		lines := describeFilters(view.Filters)
+		if len(lines) == 0 {
+			fmt.Println("  (no filters)")
+		}
+		for _, line := range lines {
+			fmt.Println("  " + line)
+		}
+	}
+}
+
+// describeFilters returns one line per non-empty filter list, e.g. "status whitelist: [done]"
+func describeFilters(filters monday.Filters) []string {
+	config := &monday.Config{Filters: filters}
+	filterTypes := []monday.FilterType{
+		monday.FilterStatus, monday.FilterPriority, monday.FilterTaskType,
+		monday.FilterSprint, monday.FilterUserName, monday.FilterUserEmail,
+		monday.FilterUpdated, monday.FilterDue, monday.FilterTag, monday.FilterTeam,
+		monday.FilterCreator, monday.FilterCreated,
+	}
+	var lines []string
+	for _, filterType := range filterTypes {
+		for _, listType := range []monday.FilterListType{monday.Whitelist, monday.Blacklist} {
+			if values := config.GetFilterValues(filterType, listType); len(values) > 0 {
+				lines = append(lines, fmt.Sprintf("%s %s: %v", filterType, listType, values))
+			}
+		}
+	}
+	return lines
+}
+
+// HandleUseViewCommand replaces the current filters with those of a view
+func (c *CLI) HandleUseViewCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config use-view <name>")
+		os.Exit(ExitValidation)
+	}
+	if err := c.config.UseView(c.command.Args[1]); err != nil {
+		fmt.Printf("❌ Error using view: %v\n", err)
+		fmt.Println("💡 Run 'config list-views' to see saved views")
+		os.Exit(ExitNotFound)
+	}
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Filters replaced with view '%s'\n", c.command.Args[1])
+}
+
+// HandleDeleteViewCommand removes a saved view
+func (c *CLI) HandleDeleteViewCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config delete-view <name>")
+		os.Exit(ExitValidation)
+	}
+	if err := c.config.DeleteView(c.command.Args[1]); err != nil {
+		fmt.Printf("❌ Error deleting view: %v\n", err)
+		fmt.Println("💡 Run 'config list-views' to see saved views")
+		os.Exit(ExitNotFound)
+	}
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Deleted view '%s'\n", c.command.Args[1])
+}
+
 func (c *CLI) HandleClearAllFiltersCommand() {
 	c.config.ClearAllFilters()
 	c.config.Save(monday.GetConfigPath())
@@ -786,7 +1789,7 @@ func (c *CLI) HandleFilterToMeCommand() {
 	err := c.config.FilterToCurrentUser()
 	if err != nil {
 		fmt.Printf("❌ Error filtering to current user: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -797,7 +1800,7 @@ func (c *CLI) HandleAddMeCommand() {
 	err := c.config.AddCurrentUserToWhitelist()
 	if err != nil {
 		fmt.Printf("❌ Error adding current user to whitelist: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -808,7 +1811,7 @@ func (c *CLI) HandleRemoveMeCommand() {
 	err := c.config.RemoveCurrentUserFromWhitelist()
 	if err != nil {
 		fmt.Printf("❌ Error removing current user from whitelist: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -822,7 +1825,7 @@ func (c *CLI) HandleListBoardUsersCommand() {
 	if !ok || len(users) == 0 {
 		fmt.Println("❌ No board users found in cache")
 		fmt.Println("💡 Run 'tasks fetch' first to fetch board users")
-		return
+		os.Exit(ExitNotFound)
 	}
 
 	fmt.Printf("👥 Board Users (cached at: %s)\n", timestamp.Format(time.RFC3339))
@@ -855,7 +1858,7 @@ func (c *CLI) HandleListBoardSprintsCommand() {
 	if sprintBoardID == "" {
 		fmt.Println("❌ No sprint board ID configured")
 		fmt.Println("💡 Run 'config set-sprint-board-id <sprint-board-id>' first")
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	dataStore := monday.NewDataStore()
@@ -864,14 +1867,15 @@ func (c *CLI) HandleListBoardSprintsCommand() {
 	if !ok || len(sprints) == 0 {
 		fmt.Println("❌ No board sprints found in cache")
 		fmt.Println("💡 Run 'tasks fetch' first to fetch board sprints")
-		return
+		os.Exit(ExitNotFound)
 	}
 
 	fmt.Printf("🏃 Sprint Board Sprints (cached at: %s)\n", timestamp.Format(time.RFC3339))
 	fmt.Println("=" + strings.Repeat("=", 50))
 
+	now := time.Now()
 	for i, sprint := range sprints {
-		fmt.Printf("%d. %s\n", i+1, sprint)
+		PrintSprint(i+1, sprint, now)
 	}
 
 	fmt.Printf("📊 Total sprints: %d\n", len(sprints))
@@ -879,7 +1883,7 @@ func (c *CLI) HandleListBoardSprintsCommand() {
 
 // HandleSprintCommand handles sprint-specific commands
 func (c *CLI) HandleSprintCommand() {
-	if len(c.command.Args) < 1 {
+	if len(c.command.Args) < 2 {
 		c.HelpSprintCommand()
 		return
 	}
@@ -892,6 +1896,12 @@ func (c *CLI) HandleSprintCommand() {
 	case "list", "ls":
 		c.HandleSprintListCommand()
 		return
+	case "rollover", "ro":
+		c.HandleSprintRolloverCommand()
+		return
+	case "status", "st":
+		c.HandleSprintStatusCommand()
+		return
 	default:
 		c.HelpSprintCommand()
 		return
@@ -904,24 +1914,24 @@ func (c *CLI) HandleSprintFetchCommand() {
 	if sprintID == "" {
 		fmt.Println("❌ No sprint ID configured")
 		fmt.Println("💡 Run 'config set-sprint-id <sprint-id>' first")
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	boardID := c.config.GetBoardID()
 	if boardID == "" {
 		fmt.Println("❌ No board ID configured")
 		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
-	client := monday.NewClient(c.config.GetAPIKey(), c.config.Timeout)
+	client := c.newClient()
+	sprintID = c.activeSprintID(client)
 
-	fmt.Printf("🔍 Fetching items from sprint %s...\n", sprintID)
+	progressf("🔍 Fetching items from sprint %s...\n", sprintID)
 
 	tasks, items, err := client.GetSprintItems(sprintID)
 	if err != nil {
-		fmt.Printf("❌ Error fetching sprint items: %v\n", err)
-		return
+		exitWithError("Error fetching sprint items", err)
 	}
 
 	if len(tasks) == 0 {
@@ -949,14 +1959,14 @@ func (c *CLI) HandleSprintListCommand() {
 	if sprintID == "" {
 		fmt.Println("❌ No sprint ID configured")
 		fmt.Println("💡 Run 'config set-sprint-id <sprint-id>' first")
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	boardID := c.config.GetBoardID()
 	if boardID == "" {
 		fmt.Println("❌ No board ID configured")
 		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	// Sprint tasks are now stored in the board cache with regular tasks
@@ -966,7 +1976,7 @@ func (c *CLI) HandleSprintListCommand() {
 	if !ok || len(tasksMap) == 0 {
 		fmt.Println("❌ No tasks found in cache")
 		fmt.Println("💡 Run 'tasks fetch' or 'tasks sprint fetch' first to fetch tasks")
-		return
+		os.Exit(ExitNotFound)
 	}
 
 	// Filter tasks by sprint if needed (tasks fetched from sprint will have Sprint field set)
@@ -978,9 +1988,11 @@ func (c *CLI) HelpSprintCommand() {
 	fmt.Println("Sprint Commands:")
 	fmt.Println("  tasks sprint fetch (f)    Fetch items from current sprint")
 	fmt.Println("  tasks sprint list (ls)   List cached sprint items")
+	fmt.Println("  tasks sprint status (st) [-sprint <name>] [-refresh true]  Days left, done and open tasks and points burned")
+	fmt.Println("  tasks sprint rollover (ro) [-from <sprint>] [-to <sprint>]  Move unfinished tasks of the current sprint to the next one")
 	fmt.Println("")
 	fmt.Println("Configuration:")
-	fmt.Println("  config set-sprint-id <id>  Set the current sprint ID")
+	fmt.Println("  config set-sprint-id <id>  Set the current sprint ID, 'auto' picks the sprint running today")
 	fmt.Println("  config show                Show current sprint ID")
 }
 
@@ -989,7 +2001,7 @@ func (c *CLI) HandleFilterToSprintCommand() {
 	err := c.config.FilterToCurrentSprint()
 	if err != nil {
 		fmt.Printf("❌ Error filtering to current sprint: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -1001,7 +2013,7 @@ func (c *CLI) HandleAddSprintCommand() {
 	err := c.config.AddCurrentSprintToWhitelist()
 	if err != nil {
 		fmt.Printf("❌ Error adding current sprint to whitelist: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
@@ -1013,9 +2025,126 @@ func (c *CLI) HandleRemoveSprintCommand() {
 	err := c.config.RemoveCurrentSprintFromWhitelist()
 	if err != nil {
 		fmt.Printf("❌ Error removing current sprint from whitelist: %v\n", err)
-		return
+		os.Exit(ExitConfigMissing)
 	}
 
 	c.config.Save(monday.GetConfigPath())
 	fmt.Println("✅ Removed current sprint from whitelist")
 }
+
+// HandleTasksDiffCommand shows what changed between the last two syncs
+func (c *CLI) HandleTasksDiffCommand() {
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+	dataStore := monday.NewDataStore()
+
+	current, currentTimestamp, ok := dataStore.GetCachedTasks(boardID)
+	if !ok {
+		fmt.Println("❌ No tasks found in cache")
+		fmt.Println("💡 Run 'tasks fetch' first to fetch tasks")
+		os.Exit(ExitNotFound)
+	}
+	previous, previousTimestamp, ok := dataStore.GetPreviousTasks(boardID)
+	if !ok {
+		fmt.Println("❌ No previous sync found to compare against")
+		fmt.Println("💡 Run 'tasks fetch' again to record a second snapshot")
+		os.Exit(ExitNotFound)
+	}
+
+	fmt.Printf("🔀 Changes from %s to %s\n", previousTimestamp.Format(time.RFC3339), currentTimestamp.Format(time.RFC3339))
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	changes := monday.DiffTasks(previous, current)
+	if len(changes) == 0 {
+		fmt.Println("No changes")
+		return
+	}
+	PrintTaskChanges(changes, "")
+
+	added, removed, modified := 0, 0, 0
+	for _, change := range changes {
+		switch change.Kind {
+		case monday.ChangeAdded:
+			added++
+		case monday.ChangeRemoved:
+			removed++
+		case monday.ChangeModified:
+			modified++
+		}
+	}
+	fmt.Println("=" + strings.Repeat("=", 50))
+	fmt.Printf("📊 %d added, %d removed, %d changed\n", added, removed, modified)
+}
+
+// HandleWatchCommand re-fetches the board on an interval and prints only what changed
+func (c *CLI) HandleWatchCommand() {
+	interval := 60 * time.Second
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-interval", "--interval", "-i":
+			duration, err := time.ParseDuration(flag.Value)
+			if err != nil {
+				// Allow plain seconds, e.g. "-interval 30"
+				seconds, convErr := strconv.Atoi(flag.Value)
+				if convErr != nil {
+					fmt.Printf("❌ Invalid interval: %s\n", flag.Value)
+					fmt.Println("Examples: 30s, 5m, 90")
+					os.Exit(ExitValidation)
+				}
+				duration = time.Duration(seconds) * time.Second
+			}
+			if duration <= 0 {
+				fmt.Printf("❌ Interval must be positive: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			interval = duration
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+
+	client := c.newClient()
+	dataStore := monday.NewDataStore()
+
+	fmt.Printf("👀 Watching board %s every %s (Ctrl+C to stop)\n", boardID, interval)
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	for {
+		c.refreshAndPrintChanges(client, dataStore, boardID)
+		time.Sleep(interval)
+	}
+}
+
+// refreshAndPrintChanges fetches the board, stores it in the cache and prints the diff against the previous cache
+func (c *CLI) refreshAndPrintChanges(client *monday.Client, dataStore *monday.DataStore, boardID string) {
+	timestamp := time.Now().Format("15:04:05")
+
+	previous, _, _ := dataStore.GetCachedTasks(boardID)
+	users, _, _ := dataStore.GetCachedBoardUsers(boardID)
+
+	tasks, rawItems, err := client.GetBoardItems(boardID)
+	if err != nil {
+		fmt.Printf("%s ⚠️  Warning: Could not fetch tasks: %v\n", timestamp, err)
+		return
+	}
+
+	dataStore.StoreTasksRequest(boardID, tasks, rawItems)
+	dataStore.StoreBoardUsers(boardID, users)
+	current, _, _ := dataStore.GetCachedTasks(boardID)
+
+	if len(previous) == 0 {
+		fmt.Printf("%s 📋 No cached tasks, stored %d tasks as baseline\n", timestamp, len(current))
+		return
+	}
+
+	PrintTaskChanges(monday.DiffTasks(previous, current), timestamp)
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// HandleConfigExportCommand writes the configuration as JSON to stdout or a file. With
+// --no-secrets the credentials and user are left out so the file can be shared with a team.
+func (c *CLI) HandleConfigExportCommand() {
+	out := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-out", "--out", "-o":
+			out = flag.Value
+		}
+	}
+	withSecrets := !c.command.HasSwitch("no-secrets")
+	data, err := json.MarshalIndent(c.config.Export(withSecrets), "", "  ")
+	if err != nil {
+		exitWithError("Error encoding configuration", err)
+	}
+	if out == "" {
+		fmt.Println(string(data))
+		return
+	}
+	perm := os.FileMode(0644)
+	if withSecrets {
+		perm = 0600 // The API key must not be readable by other users
+	}
+	if err := os.WriteFile(out, append(data, '\n'), perm); err != nil {
+		exitWithError("Error writing configuration", err)
+	}
+	fmt.Printf("✅ Configuration exported to %s\n", out)
+	if withSecrets {
+		fmt.Println("⚠️  The file contains your credentials, use --no-secrets to share it")
+	}
+}
+
+// HandleConfigImportCommand merges a shared configuration into the local one and lists the changes
+func (c *CLI) HandleConfigImportCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config import <file> [--dry-run]")
+		os.Exit(ExitValidation)
+	}
+	shared, err := monday.LoadSharedConfig(c.command.Args[1])
+	if err != nil {
+		exitWithError("Error loading configuration", err)
+	}
+	changes, skipped := c.config.Merge(shared)
+	if len(skipped) > 0 {
+		fmt.Printf("⚠️  Not imported, set these yourself if you trust the file: %s\n", strings.Join(skipped, ", "))
+	}
+	if len(changes) == 0 {
+		fmt.Println("✅ Configuration already up to date")
+		return
+	}
+	for _, change := range changes {
+		fmt.Printf("  %s\n", change)
+	}
+	if c.command.HasSwitch("dry-run") {
+		fmt.Printf("🔍 Dry run: %d changes not saved\n", len(changes))
+		return
+	}
+	if err := c.config.Save(monday.GetConfigPath()); err != nil {
+		exitWithError("Error saving configuration", err)
+	}
+	fmt.Printf("✅ Imported %d changes from %s\n", len(changes), c.command.Args[1])
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"monday-cli/monday"
+)
+
+// printTaskCreation prints when and by whom a task was created, under the task
+func printTaskCreation(task monday.Task) {
+	if task.CreatedAt.IsZero() {
+		return
+	}
+	line := "🆕 Created " + task.CreatedAt.Local().Format("2006-01-02 15:04")
+	if task.CreatorName != "" {
+		line += " by " + task.CreatorName
+	}
+	printf("        %s\n", colorize(line, ColorGray))
+}
+
+// printTaskUpdater prints who changed a task last according to the activity log, under the task
+func printTaskUpdater(task monday.Task) {
+	if task.UpdaterID == "" {
+		return
+	}
+	who := task.UpdaterName
+	if who == "" {
+		who = "user " + task.UpdaterID
+	}
+	line := "✏️  Last changed " + task.UpdatedAt.Local().Format("2006-01-02 15:04") + " by " + who
+	printf("        %s\n", colorize(line, ColorGray))
+}
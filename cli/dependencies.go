@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"time"
+)
+
+// HandleTasksBlockedCommand lists the cached tasks waiting for dependencies that are not done
+func (c *CLI) HandleTasksBlockedCommand() {
+	tasks, timestamp, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID())
+	if !ok {
+		fmt.Println("❌ No cached tasks found")
+		fmt.Println("💡 Run 'tasks fetch' first")
+		os.Exit(ExitNotFound)
+	}
+	fmt.Println("Tasks cached at: " + timestamp.Format(time.RFC3339))
+	blocked := monday.BlockedTasks(tasks)
+	if len(blocked) == 0 {
+		fmt.Println("✅ No blocked tasks")
+		return
+	}
+	for _, entry := range blocked {
+		printf("%s ", getStatusIcon(string(entry.Task.Status)))
+		PrintTask(entry.Task)
+		for _, blocker := range entry.Blockers {
+			printf("        %s %s\n", colorize("⛔ blocked by", ColorRed), describeDependency(blocker))
+		}
+	}
+	printf("🚧 Blocked tasks: %d\n", len(blocked))
+}
+
+// printTaskDependencies prints what a task depends on and which tasks it blocks
+func printTaskDependencies(task monday.Task, tasks map[string]monday.Task) {
+	for _, id := range task.DependsOn {
+		dependency, ok := tasks[id]
+		if !ok {
+			printf("        %s #%s %s\n", colorize("⏳ depends on", ColorYellow), id, colorize("(not cached)", ColorGray))
+			continue
+		}
+		label := colorize("⏳ depends on", ColorYellow)
+		if monday.IsDoneStatus(dependency.Status) {
+			label = colorize("✔ depends on", ColorGreen)
+		}
+		printf("        %s %s\n", label, describeDependency(dependency))
+	}
+	for _, dependent := range monday.Dependents(task.ID, tasks) {
+		printf("        %s %s\n", colorize("🔒 blocks", ColorMagenta), describeDependency(dependent))
+	}
+}
+
+// describeDependency formats a related task as "3 Write docs (In Progress)"
+func describeDependency(task monday.Task) string {
+	status := string(task.Status)
+	if status == "" {
+		status = "None"
+	}
+	return fmt.Sprintf("%d %s (%s)", task.LocalId, task.Name, colorize(status, getStatusColor(status)))
+}
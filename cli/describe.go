@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+const taskDescribeUsage = "task describe <task-index>"
+
+// HandleTaskDescribeCommand opens the description of a task, the text of its long text
+// column, in $VISUAL or $EDITOR and writes it back when it changed. Saving an empty file
+// clears the description.
+func (c *CLI) HandleTaskDescribeCommand() {
+	task := c.cachedTaskArg(taskDescribeUsage)
+	file, err := os.CreateTemp("", fmt.Sprintf("monday-task-%d-*.md", task.LocalId))
+	if err != nil {
+		exitWithError("Cannot create the file to edit", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+	_, err = file.WriteString(task.Description)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		exitWithError("Cannot write the file to edit", err)
+	}
+	if err := runEditor(path); err != nil {
+		exitWithError("Editor failed", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		exitWithError("Cannot read the edited file", err)
+	}
+
+	description := strings.TrimSpace(string(data))
+	if description == strings.TrimSpace(task.Description) {
+		fmt.Println("📭 No changes")
+		return
+	}
+	fmt.Printf("Updating the description of task %d: %s\n", task.LocalId, task.Name)
+	boardID := c.config.GetBoardID()
+	updatedTask, err := c.newClient().UpdateTaskDescription(boardID, task, description)
+	if errors.Is(err, monday.ErrDryRun) {
+		predicted := task
+		predicted.Description = description
+		fmt.Println("📝 Predicted description:")
+		printTaskDescription(predicted)
+		return
+	}
+	if err != nil {
+		exitWithError("Error updating description", err)
+	}
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	fmt.Printf("✅ Description of task %d updated\n", task.LocalId)
+	printTaskDescription(*updatedTask)
+}
+
+// printTaskDescription prints the description of a task under it, wrapped to the terminal
+// width when it is known
+func printTaskDescription(task monday.Task) {
+	if task.Description == "" {
+		return
+	}
+	const indent = "        "
+	printf("%s%s\n", indent, colorize("📝 Description", ColorGray))
+	width, wrap := detectWidth()
+	for _, line := range strings.Split(strings.TrimRight(task.Description, "\n"), "\n") {
+		switch {
+		case line == "":
+			printf("\n")
+			continue
+		case !wrap:
+			printf("%s%s\n", indent, line)
+			continue
+		}
+		for _, wrapped := range wrapText(line, max(width-len(indent), minNameWidth)) {
+			printf("%s%s\n", indent, wrapped)
+		}
+	}
+}
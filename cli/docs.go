@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+)
+
+const docsExportUsage = "Usage: monday-cli docs export <doc-id> [-format md|json] [-out <file>]"
+
+// HandleDocsCommand handles Monday workdocs
+func (c *CLI) HandleDocsCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpDocsCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleDocsListCommand()
+	case "export", "x":
+		c.HandleDocsExportCommand()
+	default:
+		c.HelpDocsCommand()
+	}
+}
+
+func (c *CLI) HelpDocsCommand() {
+	fmt.Println("Docs Commands:")
+	fmt.Println("  docs list (ls) [-workspace <workspace-id>]  List the workdocs you can access, newest first")
+	fmt.Println("  docs export (x) <doc-id> [-format md|json] [-out <file>]")
+	fmt.Println("                                 Print a doc as markdown, or write it to a file. The ID is the")
+	fmt.Println("                                 doc ID of 'docs list' or the number in the doc's URL")
+}
+
+// HandleDocsListCommand lists the docs of all workspaces or of one
+func (c *CLI) HandleDocsListCommand() {
+	workspaceID := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-workspace", "--workspace", "-ws":
+			workspaceID = flag.Value
+		}
+	}
+	progressf("📡 Fetching docs...\n")
+	docs, err := c.newClient().GetDocs(workspaceID)
+	if err != nil {
+		exitWithError("Error fetching docs", err)
+	}
+	if len(docs) == 0 {
+		fmt.Println("No docs found")
+		return
+	}
+	for _, doc := range docs {
+		details := doc.CreatedAt.Local().Format("2006-01-02")
+		if doc.CreatedBy != nil && doc.CreatedBy.Name != "" {
+			details += " by " + doc.CreatedBy.Name
+		}
+		if doc.DocKind != "" && doc.DocKind != "public" {
+			details += ", " + doc.DocKind
+		}
+		printf("%-12s %-40s %s\n", doc.ID, truncate(doc.Name, 40), colorize(details, ColorGray))
+	}
+	progressf("💡 Export a doc as markdown with 'docs export <doc-id>'\n")
+}
+
+// HandleDocsExportCommand prints a doc as markdown or the raw blocks as JSON, to stdout
+// unless -out is given
+func (c *CLI) HandleDocsExportCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println(docsExportUsage)
+		os.Exit(ExitValidation)
+	}
+	docID := c.command.Args[1]
+	format, outPath := "md", ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-format", "--format", "-f":
+			format = flag.Value
+		case "-out", "--out", "-o":
+			outPath = flag.Value
+		}
+	}
+	if format != "md" && format != "markdown" && format != "json" {
+		fmt.Printf("❌ Unknown format %s, use md or json\n", format)
+		os.Exit(ExitValidation)
+	}
+
+	progressf("📡 Fetching doc %s...\n", docID)
+	doc, err := c.newClient().GetDoc(docID)
+	if errors.Is(err, monday.ErrNotFound) {
+		fmt.Printf("❌ Doc %s not found\n", docID)
+		fmt.Println("💡 Run 'docs list' for the IDs of the docs you can access")
+		os.Exit(ExitNotFound)
+	}
+	if err != nil {
+		exitWithError("Error fetching doc", err)
+	}
+
+	var output []byte
+	if format == "json" {
+		if output, err = json.MarshalIndent(doc, "", "  "); err != nil {
+			exitWithError("Failed to encode doc", err)
+		}
+		output = append(output, '\n')
+	} else {
+		output = []byte(doc.Markdown())
+	}
+	if outPath == "" {
+		fmt.Print(string(output))
+		return
+	}
+	if err := os.WriteFile(outPath, output, 0644); err != nil {
+		exitWithError("Failed to write doc", err)
+	}
+	printf("✅ %s written to %s (%d blocks)\n", doc.Name, outPath, len(doc.Blocks))
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+// doctorCheck is the outcome of one doctor check with the fix for a failure or warning
+type doctorCheck struct {
+	name   string
+	ok     bool
+	warn   bool // A problem that only disables some features
+	detail string
+	fix    string
+	exit   int // Exit code of a failed check
+}
+
+// HandleDoctorCommand validates the configuration against the API and the cache and prints
+// how to fix every problem, instead of letting a later command fail deep inside a fetch
+func (c *CLI) HandleDoctorCommand() {
+	printf("🩺 Checking monday-cli setup\n")
+	printLine("=" + strings.Repeat("=", 50))
+
+	var checks []doctorCheck
+	report := func(check doctorCheck) {
+		checks = append(checks, check)
+		printDoctorCheck(check)
+	}
+
+	for _, check := range c.doctorAPIChecks() {
+		report(check)
+	}
+	report(c.doctorCacheCheck())
+
+	failed, warnings, exitCode := 0, 0, ExitOK
+	for _, check := range checks {
+		switch {
+		case check.warn:
+			warnings++
+		case !check.ok:
+			failed++
+			if exitCode == ExitOK {
+				exitCode = check.exit
+			}
+		}
+	}
+	printLine("")
+	if failed == 0 {
+		printf("✅ All checks passed (%d warnings)\n", warnings)
+		return
+	}
+	printf("❌ %d checks failed, %d warnings\n", failed, warnings)
+	os.Exit(exitCode)
+}
+
+// doctorAPIChecks checks the credentials, the boards and their columns, and the sprint board.
+// Checks that need the API are skipped when the credentials do not work.
+func (c *CLI) doctorAPIChecks() []doctorCheck {
+	if !c.config.HasCredentials() {
+		return []doctorCheck{{
+			name: "API key", detail: "no API key or auth command configured",
+			fix: "run 'config set-api-key <api-key>' or 'config init'", exit: ExitConfigMissing,
+		}}
+	}
+	auth, err := c.config.NewAuthProvider()
+	if err == nil {
+		_, err = auth.Token()
+	}
+	if err != nil {
+		return []doctorCheck{{name: "API key", detail: err.Error(), fix: "check the auth command with 'config show', or run 'config set-api-key <api-key>'", exit: ExitConfigMissing}}
+	}
+	client := c.newClient()
+
+	var checks []doctorCheck
+	user, err := client.GetUserInfo()
+	if err != nil {
+		return append(checks, doctorCheck{name: "API key", detail: err.Error(), fix: doctorFix(err, "run 'config set-api-key <api-key>' with a key from your Monday profile"), exit: exitCodeForError(err)})
+	}
+	checks = append(checks, doctorCheck{name: "API key", ok: true, detail: fmt.Sprintf("authenticated as %s (%s)", user.Name, user.Email)})
+	switch {
+	case c.config.UserID == "":
+		checks = append(checks, doctorCheck{name: "User", detail: "user information not saved", fix: "run 'user info'", exit: ExitConfigMissing})
+	case c.config.UserID != user.ID:
+		checks = append(checks, doctorCheck{name: "User", warn: true, detail: fmt.Sprintf("configured user %s is not the owner of the API key", c.config.UserName), fix: "run 'user info' to save the user of the key"})
+	default:
+		checks = append(checks, doctorCheck{name: "User", ok: true, detail: user.Name})
+	}
+
+	boards := c.config.GetBoards()
+	if len(boards) == 0 {
+		checks = append(checks, doctorCheck{name: "Board", detail: "no board configured", fix: "run 'config set-board-id <board-id>', see 'workspace boards'", exit: ExitConfigMissing})
+	}
+	for _, ref := range boards {
+		checks = append(checks, c.doctorBoardChecks(client, ref)...)
+	}
+
+	if sprintBoardID := c.config.GetSprintBoardID(); sprintBoardID == "" {
+		checks = append(checks, doctorCheck{name: "Sprint board", warn: true, detail: "not configured, sprint commands are unavailable", fix: "run 'config set-sprint-board-id <board-id>'"})
+	} else if board, err := client.GetBoard(sprintBoardID); err != nil {
+		checks = append(checks, doctorCheck{name: "Sprint board", detail: fmt.Sprintf("board %s: %v", sprintBoardID, err), fix: doctorFix(err, "check the ID with 'config set-sprint-board-id <board-id>'"), exit: exitCodeForError(err)})
+	} else {
+		checks = append(checks, doctorCheck{name: "Sprint board", ok: true, detail: fmt.Sprintf("%s (%s)", board.Name, board.ID)})
+	}
+	return checks
+}
+
+// doctorBoardChecks checks that a board exists and has the columns the CLI relies on
+func (c *CLI) doctorBoardChecks(client *monday.Client, ref monday.BoardRef) []doctorCheck {
+	name := "Board " + ref.Name
+	board, err := client.GetBoard(ref.ID)
+	if err != nil {
+		return []doctorCheck{{name: name, detail: fmt.Sprintf("board %s: %v", ref.ID, err), fix: doctorFix(err, "check the ID with 'config boards', or remove it with 'config remove-board "+ref.Name+"'"), exit: exitCodeForError(err)}}
+	}
+	checks := []doctorCheck{{name: name, ok: true, detail: fmt.Sprintf("%s (%s)", board.Name, board.ID)}}
+	var missingRequired, missingOptional []string
+	for _, column := range monday.CheckBoardColumns(board) {
+		switch {
+		case column.Column != nil:
+		case column.Required:
+			missingRequired = append(missingRequired, fmt.Sprintf("%s (%s)", column.Name, column.Purpose))
+		default:
+			missingOptional = append(missingOptional, fmt.Sprintf("%s (%s)", column.Name, column.Purpose))
+		}
+	}
+	if len(missingRequired) > 0 {
+		checks = append(checks, doctorCheck{
+			name: "  Columns", detail: "missing " + strings.Join(missingRequired, ", "),
+			fix: "add the columns on Monday with these words in their titles, see 'board columns'", exit: ExitNotFound,
+		})
+	}
+	if len(missingOptional) > 0 {
+		checks = append(checks, doctorCheck{
+			name: "  Columns", warn: true, detail: "no " + strings.Join(missingOptional, ", "),
+			fix: "add the columns on Monday to use these features",
+		})
+	}
+	if len(missingRequired) == 0 && len(missingOptional) == 0 {
+		checks = append(checks, doctorCheck{name: "  Columns", ok: true, detail: "all columns found"})
+	}
+	return checks
+}
+
+// doctorCacheCheck checks that the cache can be read and written by this build
+func (c *CLI) doctorCacheCheck() doctorCheck {
+	store, err := c.config.OpenCacheStore()
+	if err != nil {
+		return doctorCheck{name: "Cache", detail: err.Error(), fix: "run 'config set-cache file' to use the default cache", exit: ExitConfigMissing}
+	}
+	boards, err := store.LoadBoards()
+	if errors.Is(err, monday.ErrCacheTooNew) {
+		return doctorCheck{name: "Cache", detail: err.Error(), fix: "upgrade monday-cli, the cache was written by a newer version", exit: ExitError}
+	}
+	if err != nil {
+		return doctorCheck{name: "Cache", warn: true, detail: err.Error(), fix: "run 'tasks fetch' to rebuild the cache"}
+	}
+	data, _ := json.Marshal(map[string]time.Time{"checked_at": time.Now().UTC()})
+	if err := store.PutMeta("doctor", data); err != nil {
+		return doctorCheck{name: "Cache", detail: err.Error(), fix: "check the permissions of the cache directory, or choose another with 'config set-cache file <dir>'", exit: ExitError}
+	}
+	detail := fmt.Sprintf("writable, %d boards cached", len(boards))
+	if fileStore, ok := store.(*monday.FileStore); ok {
+		detail += " in " + fileStore.Dir()
+	}
+	return doctorCheck{name: "Cache", ok: true, detail: detail}
+}
+
+// doctorFix returns the fix for a failed API call, specific ones for rejected keys and network errors
+func doctorFix(err error, fallback string) string {
+	switch {
+	case errors.Is(err, monday.ErrUnauthorized):
+		return "your API key was rejected, run 'config set-api-key <api-key>'"
+	case monday.IsNetworkError(err):
+		return "check your network connection and the base URL with 'config show'"
+	default:
+		return fallback
+	}
+}
+
+func printDoctorCheck(check doctorCheck) {
+	switch {
+	case check.ok:
+		printf("✅ %-16s %s\n", check.name, check.detail)
+	case check.warn:
+		printf("⚠️  %-16s %s\n", check.name, colorize(check.detail, ColorYellow))
+	default:
+		printf("❌ %-16s %s\n", check.name, colorize(check.detail, ColorRed))
+	}
+	if !check.ok && check.fix != "" {
+		printf("   💡 %s\n", check.fix)
+	}
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// confirmNotDuplicate looks for tasks with a name similar to a new task when 'config
+// set-check-duplicates on' or --check-duplicates is set. The cache and a search on the board
+// are checked, and the user picks whether to create the task anyway, see the similar tasks or
+// cancel. Without a terminal to ask, a warning is printed and the task is created.
+func (c *CLI) confirmNotDuplicate(reader *bufio.Reader, client *monday.Client, boardID, name string) {
+	if !c.config.GetCheckDuplicates() && !c.command.HasSwitch("check-duplicates") {
+		return
+	}
+	cached, _, _ := monday.NewDataStore().GetCachedTasks(boardID)
+	candidates := make([]monday.Task, 0, len(cached))
+	for _, task := range cached {
+		candidates = append(candidates, task)
+	}
+	progressf("🔍 Looking for similar tasks...\n")
+	found, err := client.SearchSimilarItems(boardID, name)
+	if err != nil {
+		// The cache is enough to catch most duplicates, e.g. when offline
+		monday.Logger().Warn("failed to search similar tasks", "board", boardID, "error", err)
+	}
+	for _, task := range found {
+		if cachedTask, ok := cached[task.ID]; ok {
+			task.LocalId = cachedTask.LocalId
+		}
+		candidates = append(candidates, task)
+	}
+	similar := monday.SimilarTasks(candidates, name)
+	if len(similar) == 0 {
+		return
+	}
+
+	fmt.Printf("⚠️  A similar task exists:\n")
+	for _, task := range similar {
+		PrintTask(task)
+	}
+	if !isInteractive() {
+		fmt.Println("💡 Creating the task anyway, no terminal to ask")
+		return
+	}
+	for {
+		switch strings.ToLower(prompt(reader, "Create anyway? [y]es, [s]how the similar tasks, [N]o: ")) {
+		case "y", "yes":
+			return
+		case "s", "show":
+			for _, task := range similar {
+				PrintTask(task)
+				printTaskCreation(task)
+				printTaskDescription(task)
+			}
+		default:
+			fmt.Println("❌ Task not created")
+			os.Exit(ExitError)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// HandleEpicsCommand handles the epics of the epics board, whose items link tasks of any board
+// through connect boards columns
+func (c *CLI) HandleEpicsCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpEpicsCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleEpicsListCommand()
+	case "show", "s":
+		c.HandleEpicShowCommand()
+	default:
+		c.HelpEpicsCommand()
+	}
+}
+
+func (c *CLI) HelpEpicsCommand() {
+	fmt.Println("Epic Commands:")
+	fmt.Println("  epics list (ls)                List the epics with the share of their tasks done")
+	fmt.Println("  epic show (s) <epic-id>        Show an epic with its tasks by board")
+	fmt.Println("")
+	fmt.Println("Epics are the items of the board set with 'config set-epics-board-id', their tasks are the")
+	fmt.Println("items linked in its connect boards columns, on any board. Done tasks count towards the")
+	fmt.Println("progress, by story points when the tasks are estimated, removed tasks do not count.")
+}
+
+// epicsBoardID returns the configured epics board or exits with a hint to configure it
+func (c *CLI) epicsBoardID() string {
+	epicsBoardID := c.config.GetEpicsBoardID()
+	if epicsBoardID == "" {
+		fmt.Println("❌ No epics board configured")
+		fmt.Println("💡 Run 'config set-epics-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+	return epicsBoardID
+}
+
+// HandleEpicsListCommand lists the epics of the epics board with the progress of their tasks
+func (c *CLI) HandleEpicsListCommand() {
+	epicsBoardID := c.epicsBoardID()
+	progressf("🔍 Collecting the epics and their tasks...\n")
+	epics, err := c.newClient().GetEpics(epicsBoardID)
+	if err != nil {
+		exitWithError("Error fetching the epics", err)
+	}
+	if len(epics) == 0 {
+		fmt.Println("📭 No epics on the epics board")
+		return
+	}
+
+	idWidth := 0
+	for _, epic := range epics {
+		idWidth = max(idWidth, len(epic.Task.ID))
+	}
+	for _, epic := range epics {
+		progress := epic.Progress()
+		printf("%s %s %s\n",
+			colorize(fmt.Sprintf("%-*s", idWidth, epic.Task.ID), ColorGray),
+			getStatusIcon(string(epic.Task.Status)),
+			epic.Task.Name,
+		)
+		total := progress.Done + progress.Open
+		if total == 0 {
+			printf("   %s\n", colorize("No linked tasks", ColorGray))
+			continue
+		}
+		printf("   %s\n", epicProgressLine(progress, len(epic.Boards)))
+	}
+	progressf("💡 Run 'epic show <epic-id>' to see the tasks of an epic\n")
+}
+
+// HandleEpicShowCommand shows an epic with its progress and its tasks grouped by board. Tasks
+// of cached boards get their index, of other configured boards board:index.
+func (c *CLI) HandleEpicShowCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli epic show <epic-id>")
+		fmt.Println("💡 Run 'epics list' to see the IDs of the epics")
+		os.Exit(ExitValidation)
+	}
+	epicsBoardID := c.epicsBoardID()
+	progressf("🔍 Collecting the tasks of the epic...\n")
+	epic, err := c.newClient().GetEpic(epicsBoardID, c.command.Args[1])
+	if err != nil {
+		exitWithError("Error fetching the epic", err)
+	}
+
+	heading := fmt.Sprintf("%s %s", getStatusIcon(string(epic.Task.Status)), colorize(epic.Task.Name, ColorCyan))
+	if epic.Task.Status != "" {
+		heading += " " + colorize(string(epic.Task.Status), getStatusColor(string(epic.Task.Status)))
+	}
+	printf("%s\n", heading)
+	progress := epic.Progress()
+	if progress.Done+progress.Open == 0 {
+		fmt.Println("📭 No tasks linked to the epic")
+		return
+	}
+	printf("%s\n", epicProgressLine(progress, len(epic.Boards)))
+	if progress.Points > 0 {
+		printf("🎯 %s of %s pts done, %s to go\n", monday.FormatPoints(progress.DonePoints), monday.FormatPoints(progress.Points), monday.FormatPoints(progress.Points-progress.DonePoints))
+	}
+
+	dataStore := monday.NewDataStore()
+	for _, board := range epic.Boards {
+		ref, configured := c.config.FindBoard(board.Board.ID)
+		cached, _, _ := dataStore.GetCachedTasks(board.Board.ID)
+		tasks := board.Tasks
+		for i, task := range tasks {
+			if cachedTask, ok := cached[task.ID]; ok {
+				tasks[i].LocalId = cachedTask.LocalId
+			}
+			if configured && board.Board.ID != c.config.BoardID {
+				tasks[i].Board = ref.Name
+			}
+		}
+		printf("\n📋 %s (ID: %s)\n", colorize(board.Board.Name, ColorCyan), board.Board.ID)
+		printLine("-" + strings.Repeat("-", len(board.Board.Name)+20))
+		c.printTasks(tasks)
+	}
+}
+
+// epicProgressLine draws the share of the tasks of an epic done with the task and board counts
+func epicProgressLine(progress monday.EpicProgress, boards int) string {
+	done := progress.DoneShare()
+	line := fmt.Sprintf("%s %3d%%", drawBar(done, ColorGreen), int(math.Round(done*100)))
+	counts := fmt.Sprintf("  %d/%d tasks done", progress.Done, progress.Done+progress.Open)
+	if boards > 1 {
+		counts += fmt.Sprintf(" on %d boards", boards)
+	}
+	return line + colorize(counts, ColorGray)
+}
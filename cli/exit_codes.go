@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"errors"
+	"monday-cli/monday"
+	"os"
+)
+
+// Process exit codes, wrapper scripts can rely on these
+const (
+	ExitOK            = 0
+	ExitError         = 1 // Unclassified failure
+	ExitConfigMissing = 2 // Required configuration (API key, user info, board/sprint ID) is missing
+	ExitAuth          = 3 // The API key was rejected
+	ExitNetwork       = 4 // The API could not be reached, or asked us to back off (rate limit, complexity budget)
+	ExitNotFound      = 5 // A board, task, sprint or cache entry does not exist
+	ExitValidation    = 6 // Invalid arguments or flag values
+)
+
+// exitCodeForError maps an error to the exit code contract
+func exitCodeForError(err error) int {
+	switch {
+	case errors.Is(err, monday.ErrUnauthorized):
+		return ExitAuth
+	case errors.Is(err, monday.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, monday.ErrInvalidLabel):
+		return ExitValidation
+	case errors.Is(err, monday.ErrRateLimited), errors.Is(err, monday.ErrComplexityBudget):
+		return ExitNetwork
+	case monday.IsNetworkError(err):
+		return ExitNetwork
+	default:
+		return ExitError
+	}
+}
+
+// exitWithError prints the error with a hint and exits with the matching exit code
+func exitWithError(context string, err error) {
+	endProgress()
+	PrintError(context, err)
+	os.Exit(exitCodeForError(err))
+}
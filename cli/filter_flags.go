@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// filterFlagsHelp documents the one-off filter flags shared by list and search
+const filterFlagsHelp = `Filter Flags:
+  -status, -s <status>        Only tasks with this status (done/d, in progress/p, stuck/s, ...)
+  -priority, -p <priority>    Only tasks with this priority (critical/c, high/h, medium/m, low/l)
+  -type, -t <type>            Only tasks of this type (bug/b, feature/f, test/t, security/s, quality/q)
+  -sprint <sprint>            Only tasks in this sprint
+  -tag <tag>                  Only tasks with this tag
+  -team <team>                Only tasks assigned to this team
+  -assignee, -a <name|me>     Only tasks assigned to this user
+  -updated-since <3d|date>    Only tasks updated within that time or after that day
+  -due-before <3d|date>       Only tasks due within that time or before that day
+  -created-by <name|me>       Only tasks created by this user
+  -created-since <3d|date>    Only tasks created within that time or after that day
+  -touched-by <name|me>       Only tasks this user changed in the 14 days before the last fetch
+  -override true              Ignore the configured filters and only use the flags
+Flags replace the configured whitelist of the same field, other configured filters still apply.`
+
+// filterFlags parses the one-off filter flags, it exits on invalid values.
+// The second return value reports whether any filter flag was given.
+func (c *CLI) filterFlags() (monday.Filters, bool) {
+	var filters monday.Filters
+	given := false
+	for _, flag := range c.command.Flags {
+		value := strings.ToLower(strings.TrimSpace(flag.Value))
+		switch flag.Flag {
+		case "-status", "--status", "-s":
+			if alias := getStatusValue(value); alias != "" {
+				value = strings.ToLower(alias)
+			}
+			filters.StatusWhitelist = append(filters.StatusWhitelist, value)
+		case "-priority", "--priority", "-p":
+			if alias := getPriorityValue(value); alias != "" {
+				value = strings.ToLower(alias)
+			}
+			filters.PriorityWhitelist = append(filters.PriorityWhitelist, value)
+		case "-type", "--type", "-t":
+			if alias := getTypeValue(value); alias != "" {
+				value = strings.ToLower(alias)
+			}
+			filters.TypeWhitelist = append(filters.TypeWhitelist, value)
+		case "-sprint", "--sprint":
+			filters.SprintWhitelist = append(filters.SprintWhitelist, value)
+		case "-tag", "--tag":
+			filters.TagWhitelist = append(filters.TagWhitelist, value)
+		case "-team", "--team":
+			filters.TeamWhitelist = append(filters.TeamWhitelist, value)
+		case "-assignee", "--assignee", "-a":
+			if value == "me" {
+				if !c.config.HasUserInfo() {
+					fmt.Println("❌ No user info configured for -assignee me")
+					fmt.Println("💡 Run 'user info' first")
+					os.Exit(ExitConfigMissing)
+				}
+				value = strings.ToLower(c.config.GetUserInfo().Name)
+			}
+			filters.UserNameWhitelist = append(filters.UserNameWhitelist, value)
+		case "-updated-since", "--updated-since":
+			// A duration means within that time, a date means after that day
+			expr := "<" + value
+			if _, err := monday.ParseRelativeDuration(value); err != nil {
+				expr = ">" + value
+			}
+			filters.UpdatedWhitelist = append(filters.UpdatedWhitelist, validDateFilter(expr))
+		case "-due-before", "--due-before":
+			filters.DueWhitelist = append(filters.DueWhitelist, validDateFilter("<"+value))
+		case "-created-by", "--created-by":
+			if value == "me" {
+				if !c.config.HasUserInfo() {
+					fmt.Println("❌ No user info configured for -created-by me")
+					fmt.Println("💡 Run 'user info' first")
+					os.Exit(ExitConfigMissing)
+				}
+				value = c.config.GetUserInfo().ID
+			}
+			filters.CreatorWhitelist = append(filters.CreatorWhitelist, value)
+		case "-created-since", "--created-since":
+			expr := "<" + value
+			if _, err := monday.ParseRelativeDuration(value); err != nil {
+				expr = ">" + value
+			}
+			filters.CreatedWhitelist = append(filters.CreatedWhitelist, validDateFilter(expr))
+		case "-touched-by", "--touched-by":
+			if value == "me" {
+				if !c.config.HasUserInfo() {
+					fmt.Println("❌ No user info configured for -touched-by me")
+					fmt.Println("💡 Run 'user info' first")
+					os.Exit(ExitConfigMissing)
+				}
+				value = c.config.GetUserInfo().ID
+			}
+			filters.TouchedByWhitelist = append(filters.TouchedByWhitelist, value)
+		default:
+			continue
+		}
+		given = true
+	}
+	return filters, given
+}
+
+// overrideFilters reports whether -override true was given
+func (c *CLI) overrideFilters() bool {
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-override", "--override":
+			return flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+	return false
+}
+
+// listFilters returns the configured filters, or those of the view given with -view,
+// combined with the one-off filter flags
+func (c *CLI) listFilters() monday.Filters {
+	flagFilters, _ := c.filterFlags()
+	if c.overrideFilters() {
+		return flagFilters
+	}
+
+	filters := c.config.GetFilters().Clone()
+	if view, ok := c.selectedView(); ok {
+		filters = view.Filters.Clone()
+	}
+	return combineFilters(filters, flagFilters)
+}
+
+// combineFilters applies one-off filters on top of persisted ones. A whitelist given as a
+// flag replaces the persisted whitelist of that field, date filters are added.
+func combineFilters(filters, flagFilters monday.Filters) monday.Filters {
+	if len(flagFilters.StatusWhitelist) > 0 {
+		filters.StatusWhitelist = flagFilters.StatusWhitelist
+	}
+	if len(flagFilters.PriorityWhitelist) > 0 {
+		filters.PriorityWhitelist = flagFilters.PriorityWhitelist
+	}
+	if len(flagFilters.TypeWhitelist) > 0 {
+		filters.TypeWhitelist = flagFilters.TypeWhitelist
+	}
+	if len(flagFilters.SprintWhitelist) > 0 {
+		filters.SprintWhitelist = flagFilters.SprintWhitelist
+	}
+	if len(flagFilters.TagWhitelist) > 0 {
+		filters.TagWhitelist = flagFilters.TagWhitelist
+	}
+	if len(flagFilters.TeamWhitelist) > 0 {
+		filters.TeamWhitelist = flagFilters.TeamWhitelist
+	}
+	if len(flagFilters.UserNameWhitelist) > 0 {
+		// Names and emails are the same for people columns, so an email whitelist would hide everything
+		filters.UserNameWhitelist = flagFilters.UserNameWhitelist
+		filters.UserEmailWhitelist = nil
+	}
+	filters.UpdatedWhitelist = append(filters.UpdatedWhitelist, flagFilters.UpdatedWhitelist...)
+	if len(flagFilters.CreatorWhitelist) > 0 {
+		filters.CreatorWhitelist = flagFilters.CreatorWhitelist
+	}
+	filters.DueWhitelist = append(filters.DueWhitelist, flagFilters.DueWhitelist...)
+	filters.CreatedWhitelist = append(filters.CreatedWhitelist, flagFilters.CreatedWhitelist...)
+	filters.TouchedByWhitelist = flagFilters.TouchedByWhitelist
+	return filters
+}
+
+// selectedView returns the view given with -view, it exits when the view does not exist
+func (c *CLI) selectedView() (monday.View, bool) {
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-view", "--view", "-v":
+			view, ok := c.config.GetView(flag.Value)
+			if !ok {
+				fmt.Printf("❌ Unknown view: %s\n", flag.Value)
+				fmt.Println("💡 Run 'config list-views' to see saved views")
+				os.Exit(ExitNotFound)
+			}
+			return view, true
+		}
+	}
+	return monday.View{}, false
+}
+
+// sortOrder returns the order given with -sort, or the one saved in the view given
+// with -view, and whether --reverse was given. It exits on unknown sort fields.
+func (c *CLI) sortOrder() (monday.SortOrder, bool) {
+	spec := ""
+	if view, ok := c.selectedView(); ok {
+		spec = view.Sort
+	}
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-sort", "--sort":
+			spec = flag.Value
+		}
+	}
+	order, err := monday.ParseSortOrder(spec)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	return order, c.command.HasSwitch("reverse")
+}
+
+// groupBy returns the field to group listed tasks by, set with -group-by. Without it tasks
+// sorted by status first are grouped by status.
+func (c *CLI) groupBy(order monday.SortOrder) string {
+	field := ""
+	if order.GroupsByStatus() {
+		field = "status"
+	}
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-group-by", "--group-by":
+			var err error
+			if field, err = monday.ParseGroupBy(flag.Value); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+		}
+	}
+	return field
+}
+
+// validDateFilter exits when a date filter expression cannot be parsed
+func validDateFilter(expr string) string {
+	if _, err := monday.ParseDateCondition(expr); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	return expr
+}
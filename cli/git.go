@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// runGit runs a git command in the current directory and returns its trimmed output
+func runGit(args ...string) (string, error) {
+	monday.Logger().Debug("running git", "args", args)
+	out, err := exec.Command("git", args...).CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		if output != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], output)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return output, nil
+}
+
+// gitCurrentBranch returns the checked out branch
+func gitCurrentBranch() (string, error) {
+	branch, err := runGit("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if branch == "HEAD" {
+		return "", fmt.Errorf("not on a branch (detached HEAD)")
+	}
+	return branch, nil
+}
+
+// gitCheckoutBranch checks out a branch, creating it when it does not exist yet
+func gitCheckoutBranch(branch string) (created bool, err error) {
+	if _, err := runGit("rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err == nil {
+		_, err := runGit("checkout", branch)
+		return false, err
+	}
+	_, err = runGit("checkout", "-b", branch)
+	return err == nil, err
+}
+
+// HandleTaskBranchCommand creates and checks out a git branch for a task
+func (c *CLI) HandleTaskBranchCommand() {
+	task := c.cachedTaskArg("task branch <task-index>")
+	boardID := c.config.GetBoardID()
+	dataStore := monday.NewDataStore()
+
+	branch := monday.BranchName(c.config.GetBranchPattern(), task)
+	if _, err := runGit("check-ref-format", "--branch", branch); err != nil {
+		fmt.Printf("❌ Invalid branch name %q: %v\n", branch, err)
+		fmt.Println("💡 Check the pattern set with 'config set-branch-pattern'")
+		os.Exit(ExitValidation)
+	}
+	created, err := gitCheckoutBranch(branch)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitError)
+	}
+	if err := dataStore.StoreTaskBranch(boardID, branch, task.ID); err != nil {
+		monday.Logger().Warn("failed to record branch", "branch", branch, "error", err)
+	}
+
+	if created {
+		fmt.Printf("🌿 Created and switched to branch %s\n", branch)
+	} else {
+		fmt.Printf("🌿 Switched to existing branch %s\n", branch)
+	}
+	PrintTask(task)
+}
+
+// cachedTaskArg returns the cached task whose local index is the second argument,
+// it prints the usage or an error and exits when there is none
+func (c *CLI) cachedTaskArg(usage string) monday.Task {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + usage)
+		os.Exit(ExitValidation)
+	}
+	localId, err := strconv.Atoi(c.command.Args[1])
+	if err != nil {
+		fmt.Printf("❌ Invalid task local ID: %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	task, _, ok := monday.NewDataStore().GetCachedTaskByLocalId(c.config.GetBoardID(), localId)
+	if !ok {
+		fmt.Printf("❌ Task %d not found\n", localId)
+		os.Exit(ExitNotFound)
+	}
+	return task
+}
+
+// HandleTaskCurrentCommand shows the task linked to the current git branch
+func (c *CLI) HandleTaskCurrentCommand() {
+	task, branch := c.currentBranchTask()
+	printf("🌿 %s\n", branch)
+	PrintTask(task)
+}
+
+// currentBranchTask returns the task of the checked out branch, it exits when there is none
+func (c *CLI) currentBranchTask() (monday.Task, string) {
+	branch, err := gitCurrentBranch()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitError)
+	}
+	task, ok := monday.NewDataStore().GetTaskByBranch(c.config.GetBoardID(), branch)
+	if !ok {
+		fmt.Printf("❌ No task found for branch %s\n", branch)
+		fmt.Println("💡 Create branches with 'task branch <task-index>', or run 'tasks fetch' if the task is new")
+		os.Exit(ExitNotFound)
+	}
+	return task, branch
+}
+
+// prepareCommitMsgHook adds the trailer of the branch's task to commit messages.
+// Merges and squashes keep their message, branches without a task are left alone.
+const prepareCommitMsgHook = `#!/bin/sh
+# monday-cli: link commits to the Monday item of the current branch
+case "$2" in merge|squash) exit 0 ;; esac
+trailer=$(%q task commit-msg 2>/dev/null) || exit 0
+git interpret-trailers --in-place --if-exists doNothing --trailer "$trailer" "$1"
+`
+
+// hookMarker identifies hooks written by install-git-hook, so they can be replaced
+const hookMarker = "# monday-cli:"
+
+// HandleTaskCommitMsgCommand prints the commit trailer for the task of the current branch
+func (c *CLI) HandleTaskCommitMsgCommand() {
+	task, _ := c.currentBranchTask()
+	fmt.Println(monday.CommitTrailer(task))
+}
+
+// HandleInstallGitHookCommand writes a prepare-commit-msg hook calling task commit-msg
+func (c *CLI) HandleInstallGitHookCommand() {
+	force := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-force", "--force":
+			force = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	hookPath, err := runGit("rev-parse", "--git-path", "hooks/prepare-commit-msg")
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitError)
+	}
+	if existing, err := os.ReadFile(hookPath); err == nil && !strings.Contains(string(existing), hookMarker) && !force {
+		fmt.Printf("❌ A prepare-commit-msg hook already exists: %s\n", hookPath)
+		fmt.Println("💡 Add 'mon task commit-msg' to it yourself, or replace it with -force true")
+		os.Exit(ExitValidation)
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		executable = "monday-cli"
+	}
+	if err := os.MkdirAll(filepath.Dir(hookPath), 0755); err != nil {
+		fmt.Printf("❌ Failed to create hooks directory: %v\n", err)
+		os.Exit(ExitError)
+	}
+	if err := os.WriteFile(hookPath, []byte(fmt.Sprintf(prepareCommitMsgHook, executable)), 0755); err != nil {
+		fmt.Printf("❌ Failed to write hook: %v\n", err)
+		os.Exit(ExitError)
+	}
+	fmt.Printf("✅ Installed %s\n", hookPath)
+	fmt.Println("💡 Commits on task branches now get a 'monday: #<item-id>' trailer")
+}
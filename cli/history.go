@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+)
+
+// defaultHistoryLimit is the number of entries history shows without -limit
+const defaultHistoryLimit = 20
+
+// recordHistory appends a mutation to the history and runs its hooks, failures only log a
+// warning
+func (c *CLI) recordHistory(action string, before, after monday.Task, detail string) {
+	defer c.runTaskHooks(action, before, after)
+	entry := monday.NewHistoryEntry(action, c.config.GetUserInfo().ID, c.config.GetBoardID(), before, after)
+	entry.Detail = detail
+	if err := monday.AppendHistory(entry); err != nil {
+		monday.Logger().Warn("failed to record history", "error", err)
+	}
+}
+
+// HandleHistoryCommand shows the changes the CLI made for the configured user, newest last
+func (c *CLI) HandleHistoryCommand() {
+	limit := defaultHistoryLimit
+	taskID := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-task", "--task", "-t":
+			taskID = c.historyTaskID(flag.Value)
+		case "-limit", "--limit", "-n":
+			n, err := strconv.Atoi(flag.Value)
+			if err != nil || n < 1 {
+				fmt.Printf("❌ Invalid limit: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			limit = n
+		}
+	}
+
+	entries, err := monday.ReadHistory()
+	if err != nil {
+		exitWithError("Error reading history", err)
+	}
+	userID := c.config.GetUserInfo().ID
+	var shown []monday.HistoryEntry
+	for _, entry := range entries {
+		if entry.UserID != userID || (taskID != "" && entry.TaskID != taskID) {
+			continue
+		}
+		shown = append(shown, entry)
+	}
+	if len(shown) == 0 {
+		fmt.Println("No changes recorded yet")
+		return
+	}
+	if len(shown) > limit {
+		shown = shown[len(shown)-limit:]
+	}
+	for _, entry := range shown {
+		PrintHistoryEntry(entry)
+	}
+}
+
+// historyTaskID resolves the -task value, a cached local index or an item ID
+func (c *CLI) historyTaskID(value string) string {
+	if localId, err := strconv.Atoi(value); err == nil {
+		if task, _, ok := monday.NewDataStore().GetCachedTaskByLocalId(c.config.GetBoardID(), localId); ok {
+			return task.ID
+		}
+	}
+	return value
+}
+
+// historyActionColors colors the action of history entries
+var historyActionColors = map[string]string{
+	monday.HistoryCreate:  ColorGreen,
+	monday.HistoryUpdate:  ColorYellow,
+	monday.HistoryComment: ColorBlue,
+	monday.HistoryLink:    ColorMagenta,
+	monday.HistoryMove:    ColorCyan,
+	monday.HistoryRestore: ColorGreen,
+}
+
+// PrintHistoryEntry prints one history entry with its field changes
+func PrintHistoryEntry(entry monday.HistoryEntry) {
+	printf("%s %s %s (#%s)\n",
+		colorize(entry.Time.Local().Format("2006-01-02 15:04"), ColorGray),
+		colorize(fmt.Sprintf("%-7s", entry.Action), historyActionColors[entry.Action]),
+		entry.TaskName,
+		entry.TaskID,
+	)
+	for _, field := range entry.Changes {
+		printf("        %s: %s -> %s\n", field.Field, colorize(displayValue(field.Old), ColorRed), colorize(displayValue(field.New), ColorGreen))
+	}
+	if entry.Detail != "" {
+		printf("        %s\n", entry.Detail)
+	}
+}
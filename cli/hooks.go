@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+const hookAddUsage = "Usage: monday-cli hooks add <name> -event <event> (-command <command> | -url <url>)"
+
+// HandleHooksCommand handles the hooks run on changes made by the CLI
+func (c *CLI) HandleHooksCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpHooksCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleHooksListCommand()
+	case "add", "a":
+		c.HandleHooksAddCommand()
+	case "remove", "rm":
+		c.HandleHooksRemoveCommand()
+	case "test":
+		c.HandleHooksTestCommand()
+	default:
+		c.HelpHooksCommand()
+	}
+}
+
+func (c *CLI) HelpHooksCommand() {
+	fmt.Println("Hooks Commands:")
+	fmt.Println("  hooks list (ls)                Show the hooks with their event")
+	fmt.Println("  hooks add (a) <name> -event <event> (-command <command> | -url <url>)")
+	fmt.Println("                                 Run a shell command or POST to a URL when the CLI makes a change")
+	fmt.Println("  hooks remove (rm) <name>")
+	fmt.Println("  hooks test <name>              Run a hook with a sample event")
+	fmt.Println("")
+	fmt.Println("Events:")
+	fmt.Println("  task.created         A task was created, also by quick, import, batch, recur and duplicate, not by task restore")
+	fmt.Println("  task.status_changed  The status of a task was changed")
+	fmt.Println("  sprint.rolled_over   Unfinished tasks were moved to the next sprint")
+	fmt.Println("")
+	fmt.Println("The event is passed as JSON on stdin of commands and as the body of the POST. Commands")
+	fmt.Println("also get MONDAY_EVENT, MONDAY_BOARD_ID, MONDAY_TASK_ID, MONDAY_TASK_NAME and MONDAY_TASK_STATUS.")
+}
+
+// HandleHooksListCommand lists the hooks by name
+func (c *CLI) HandleHooksListCommand() {
+	names := c.config.HookNames()
+	if len(names) == 0 {
+		fmt.Println("No hooks")
+		fmt.Println("💡 Add one with 'hooks add <name> -event task.created -command <command>'")
+		return
+	}
+	printf("🪝 %d hook(s):\n", len(names))
+	for _, name := range names {
+		hook := c.config.Hooks[name]
+		printf("  %s %s %s\n", colorize(name, ColorCyan), colorize(string(hook.Event), ColorGray), hook.Target())
+	}
+}
+
+// HandleHooksAddCommand adds a hook, replacing one with the same name
+func (c *CLI) HandleHooksAddCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println(hookAddUsage)
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+	var hook monday.Hook
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-event", "--event", "-e":
+			hook.Event = monday.HookEvent(flag.Value)
+		case "-command", "--command", "-c":
+			hook.Command = flag.Value
+		case "-url", "--url":
+			hook.URL = flag.Value
+		}
+	}
+	if hook.Event == "" {
+		fmt.Println(hookAddUsage)
+		os.Exit(ExitValidation)
+	}
+	if err := hook.Validate(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	c.config.SetHook(name, hook)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Hook %s runs on %s\n", name, hook.Event)
+	fmt.Printf("💡 Try it with 'hooks test %s'\n", name)
+}
+
+// HandleHooksRemoveCommand removes a hook
+func (c *CLI) HandleHooksRemoveCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli hooks remove <name>")
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+	if err := c.config.DeleteHook(name); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitNotFound)
+	}
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Hook %s removed\n", name)
+}
+
+// HandleHooksTestCommand runs a single hook with a sample payload of its event
+func (c *CLI) HandleHooksTestCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli hooks test <name>")
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+	hook, ok := c.config.Hooks[name]
+	if !ok {
+		fmt.Printf("❌ Hook %s not found\n", name)
+		os.Exit(ExitNotFound)
+	}
+	payload := monday.HookPayload{Event: hook.Event, BoardID: c.config.GetBoardID(), UserID: c.config.GetUserInfo().ID}
+	switch hook.Event {
+	case monday.HookSprintRolledOver:
+		payload.FromSprint, payload.ToSprint, payload.TaskIDs = "Sprint 1", "Sprint 2", []string{"1"}
+	default:
+		payload.Task = &monday.Task{ID: "1", Name: "Sample task", Status: "Working on it", UserName: c.config.GetUserInfo().Name}
+		if hook.Event == monday.HookStatusChanged {
+			payload.Changes = []monday.FieldChange{{Field: "status", Old: "", New: "Working on it"}}
+		}
+	}
+	results := monday.NewHookDispatcher(monday.Hooks{name: hook}, c.httpTransport()).Dispatch(context.Background(), payload)
+	if err := results[0].Err; err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitError)
+	}
+	fmt.Printf("✅ Hook %s ran\n", name)
+}
+
+// runHooks runs the hooks configured for the payload's event. Hooks never fail the command,
+// errors are printed as warnings.
+func (c *CLI) runHooks(payload monday.HookPayload) {
+	if !c.config.Hooks.Has(payload.Event) {
+		return
+	}
+	dispatcher := monday.NewHookDispatcher(c.config.Hooks, c.httpTransport())
+	if payload.BoardID == "" {
+		payload.BoardID = c.config.GetBoardID()
+	}
+	payload.UserID = c.config.GetUserInfo().ID
+	for _, result := range dispatcher.Dispatch(context.Background(), payload) {
+		if result.Err != nil {
+			fmt.Printf("⚠️  Hook %s failed: %v\n", result.Name, result.Err)
+		}
+	}
+}
+
+// runTaskHooks runs the hooks of a task change recorded in the history: task.created for
+// created tasks and task.status_changed when the status differs. Restored tasks are new items
+// but no new work, they fire no hooks.
+func (c *CLI) runTaskHooks(action string, before, after monday.Task) {
+	payload := monday.HookPayload{Task: &after}
+	switch action {
+	case monday.HistoryCreate:
+		payload.Event = monday.HookTaskCreated
+	case monday.HistoryUpdate:
+		if strings.EqualFold(string(before.Status), string(after.Status)) {
+			return
+		}
+		payload.Event = monday.HookStatusChanged
+		payload.Changes = []monday.FieldChange{{Field: "status", Old: string(before.Status), New: string(after.Status)}}
+	default:
+		return
+	}
+	c.runHooks(payload)
+}
@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// HandleImportCommand handles importing tasks from other tools
+func (c *CLI) HandleImportCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpImportCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "csv":
+		c.HandleImportCSVCommand()
+	default:
+		c.HelpImportCommand()
+	}
+}
+
+func (c *CLI) HelpImportCommand() {
+	fmt.Println("Import Commands:")
+	fmt.Println("  import csv <file> [-mapping <file.yaml|file.json>] [-batch 10] [--dry-run]")
+	fmt.Println("    Creates tasks on the configured board from a CSV export (Jira, Trello, spreadsheets)")
+	fmt.Println("    -mapping, -m <file>  Maps CSV headers to name, status, priority, type, assignee and due_date")
+	fmt.Println("                         and translates labels, e.g. status_values: with 'To Do: Not Started'")
+	fmt.Println("    -batch, -b <n>       Items created per request (default 10)")
+	fmt.Println("    --dry-run            Preview the tasks without creating them")
+}
+
+// HandleImportCSVCommand creates a task for every CSV record whose name is not on the board yet
+func (c *CLI) HandleImportCSVCommand() {
+	if len(c.command.Args) < 2 {
+		c.HelpImportCommand()
+		os.Exit(ExitValidation)
+	}
+	path := c.command.Args[1]
+	mappingPath := ""
+	batchSize := 10
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-mapping", "--mapping", "-m":
+			mappingPath = flag.Value
+		case "-batch", "--batch", "-b":
+			n, err := strconv.Atoi(flag.Value)
+			if err != nil || n < 1 || n > 50 {
+				fmt.Printf("❌ Invalid batch size: %s (use 1 to 50)\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			batchSize = n
+		}
+	}
+
+	var mapping *monday.ImportMapping
+	if mappingPath != "" {
+		loaded, err := monday.LoadImportMapping(mappingPath)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+		mapping = loaded
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Printf("❌ Cannot open %s: %v\n", path, err)
+		os.Exit(ExitNotFound)
+	}
+	rows, mapping, err := monday.ReadImportCSV(file, mapping)
+	file.Close()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if len(rows) == 0 {
+		fmt.Println("📭 No records in the CSV file")
+		return
+	}
+
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	dataStore := monday.NewDataStore()
+	progressf("📡 Fetching board %s to check labels and duplicates...\n", boardID)
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+	tasks, rawItems, err := client.GetBoardItems(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch tasks", err)
+	}
+	c.storeFetchedTasks(dataStore, boardID, tasks, rawItems)
+	existing, _, _ := dataStore.GetCachedTasks(boardID)
+
+	monday.PlanImport(board, rows, existing, c.importAssigneeResolver(client, dataStore))
+	printf("📥 Import of %s: name from %q%s\n", path, mapping.Name, describeMapping(mapping))
+	printLine("=" + strings.Repeat("=", 50))
+	var items []monday.ItemInput
+	var imported []monday.ImportRow
+	for _, row := range rows {
+		PrintImportRow(row)
+		if row.Skip == "" {
+			items = append(items, monday.ItemInput{Name: row.Name, Values: row.Values})
+			imported = append(imported, row)
+		}
+	}
+	printLine("=" + strings.Repeat("=", 50))
+	skipped := len(rows) - len(items)
+
+	if c.command.HasSwitch("dry-run") {
+		fmt.Printf("🧪 Dry run, %d tasks would be created, %d skipped\n", len(items), skipped)
+		return
+	}
+	if len(items) == 0 {
+		fmt.Printf("📭 Nothing to import, %d records skipped\n", skipped)
+		return
+	}
+
+	progressf("🚀 Creating %d tasks in batches of %d...\n", len(items), batchSize)
+	ids, createErr := client.CreateItems(boardID, items, batchSize)
+	if len(ids) > 0 {
+		if tasks, rawItems, err := client.GetBoardItems(boardID); err == nil {
+			c.storeFetchedTasks(dataStore, boardID, tasks, rawItems)
+		} else {
+			fmt.Printf("⚠️  Warning: Could not refresh the cache: %v\n", err)
+		}
+		cached, _, _ := dataStore.GetCachedTasks(boardID)
+		for _, id := range ids {
+			if task, ok := cached[id]; ok {
+				c.recordHistory(monday.HistoryCreate, monday.Task{}, task, "")
+			}
+		}
+	}
+	if createErr != nil {
+		fmt.Printf("❌ Created %d of %d tasks before an error, the first one not created is record %d\n",
+			len(ids), len(items), imported[len(ids)].Line)
+		exitWithError("Import failed", createErr)
+	}
+	fmt.Printf("✅ Created %d tasks, %d skipped\n", len(ids), skipped)
+}
+
+// storeFetchedTasks stores freshly fetched tasks, keeping the cached board users
+func (c *CLI) storeFetchedTasks(dataStore *monday.DataStore, boardID string, tasks []monday.Task, rawItems []monday.Item) {
+	users, _, _ := dataStore.GetCachedBoardUsers(boardID)
+	dataStore.StoreTasksRequest(boardID, tasks, rawItems)
+	dataStore.StoreBoardUsers(boardID, users)
+}
+
+// importAssigneeResolver finds assignees by name or email in the user directory. Only a single
+// match is used, other names leave the task unassigned with a warning printed once.
+func (c *CLI) importAssigneeResolver(client *monday.Client, dataStore *monday.DataStore) func(string) (string, bool) {
+	directory, err := dataStore.GetUserDirectory()
+	if err != nil || directory.IsStale() {
+		if refreshed, err := dataStore.RefreshUserDirectory(client); err == nil {
+			directory = refreshed
+		} else {
+			fmt.Printf("⚠️  Warning: Could not refresh user directory: %v\n", err)
+		}
+	}
+	if directory == nil {
+		directory = &monday.UserDirectory{}
+	}
+	resolved := make(map[string]string)
+	return func(name string) (string, bool) {
+		if id, ok := resolved[name]; ok {
+			return id, id != ""
+		}
+		matches := directory.Search(name)
+		if len(matches) == 1 {
+			resolved[name] = matches[0].ID
+			return matches[0].ID, true
+		}
+		if len(matches) == 0 {
+			fmt.Printf("⚠️  No user matching %q, leaving the tasks unassigned\n", name)
+		} else {
+			fmt.Printf("⚠️  %d users match %q, leaving the tasks unassigned\n", len(matches), name)
+		}
+		resolved[name] = ""
+		return "", false
+	}
+}
+
+// describeMapping lists the mapped optional columns, e.g. ", status from "Status""
+func describeMapping(mapping *monday.ImportMapping) string {
+	var parts []string
+	for _, field := range []struct{ name, header string }{
+		{"status", mapping.Status},
+		{"priority", mapping.Priority},
+		{"type", mapping.Type},
+		{"assignee", mapping.Assignee},
+		{"due date", mapping.DueDate},
+	} {
+		if field.header != "" {
+			parts = append(parts, fmt.Sprintf("%s from %q", field.name, field.header))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(parts, ", ")
+}
+
+// PrintImportRow prints a CSV record with the task it creates or why it is skipped
+func PrintImportRow(row monday.ImportRow) {
+	if row.Skip != "" {
+		printf("⏭️  %4d %s %s\n", row.Line, displayValue(row.Name), colorize("("+row.Skip+")", ColorYellow))
+		return
+	}
+	var details []string
+	for _, label := range []string{row.Details.Status, row.Details.Priority, row.Details.Type} {
+		if label != "" {
+			details = append(details, label)
+		}
+	}
+	if row.Details.AssigneeID != "" {
+		details = append(details, "👤 "+row.Assignee)
+	}
+	if !row.Details.DueDate.IsZero() {
+		details = append(details, "📅 "+row.Details.DueDate.Format("2006-01-02"))
+	}
+	printf("➕ %4d %s %s\n", row.Line, row.Name, colorize(strings.Join(details, ", "), ColorGray))
+}
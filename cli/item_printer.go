@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"monday-cli/monday"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // ANSI color codes
@@ -22,6 +24,9 @@ const (
 
 // Color helper functions
 func colorize(text, color string) string {
+	if plainOutput {
+		return text
+	}
 	return color + text + ColorReset
 }
 
@@ -61,34 +66,90 @@ func (c *CLI) PrintItems(tasks map[string]monday.Task) {
 		tasksList = append(tasksList, task)
 	}
 
-	filteredTasks := monday.FilterTasks(tasksList, c.config.GetFilters())
+	filteredTasks := monday.FilterTasks(tasksList, c.listFilters())
+
+	printf("👤 Found %d tasks to matching filters:\n\n", len(filteredTasks))
 
-	fmt.Printf("👤 Found %d tasks to matching filters:\n\n", len(filteredTasks))
+	c.printTasks(filteredTasks)
+}
+
+// printTasks prints tasks in the order given with -sort and --reverse, grouped with -group-by,
+// or only their counts with --summary
+func (c *CLI) printTasks(tasks []monday.Task) {
+	if c.command.HasSwitch("summary") {
+		printSummary(tasks)
+		return
+	}
+	order, reverse := c.sortOrder()
+	groupBy := c.groupBy(order)
+	PrintTasks(monday.SortTasks(tasks, order.GroupBy(groupBy), reverse), groupBy)
+}
 
-	sortedTasks := monday.OrderTasks(filteredTasks)
+// PrintTasks prints tasks in the given order followed by the active count. Tasks sorted by
+// the groupBy field get a heading per value with the number of tasks and points, without a
+// field each task shows its status icon.
+func PrintTasks(tasks []monday.Task, groupBy string) {
+	countByGroup := make(map[string]int)
+	pointsByGroup := make(map[string]float64)
+	for _, task := range tasks {
+		key := monday.GroupKey(task, groupBy)
+		countByGroup[key]++
+		pointsByGroup[key] += task.Estimate
+	}
 
-	currentStatus := ""
+	if len(tasks) > 0 {
+		printTableHeader()
+	}
+	currentGroup := ""
 	activeCount := 0
-	for i := 0; i < len(sortedTasks); i++ {
-		task := sortedTasks[i]
-		if string(task.Status) != currentStatus {
-			currentStatus = string(task.Status)
-			statusIcon := getStatusIcon(currentStatus)
-			statusColor := getStatusColor(currentStatus)
-			if currentStatus == "" {
-				fmt.Printf("\n%s %s\n", statusIcon, colorize("None", ColorWhite))
-			} else {
-				fmt.Printf("\n%s %s\n", statusIcon, colorize(currentStatus, statusColor))
+	for i, task := range tasks {
+		if key := monday.GroupKey(task, groupBy); groupBy != "" && (i == 0 || key != currentGroup) {
+			currentGroup = key
+			subtotal := fmt.Sprintf("%d tasks", countByGroup[key])
+			if countByGroup[key] == 1 {
+				subtotal = "1 task"
+			}
+			if pointsByGroup[key] > 0 {
+				subtotal += fmt.Sprintf(", %s pts", monday.FormatPoints(pointsByGroup[key]))
 			}
+			printf("\n%s %s\n", groupHeading(groupBy, key), colorize("("+subtotal+")", ColorGray))
+		}
+		lead := ""
+		if groupBy != "status" {
+			lead = getStatusIcon(string(task.Status)) + " "
 		}
 		if isActiveStatus(string(task.Status)) {
 			activeCount++
 		}
-		PrintTask(task)
+		printTaskLine(lead, task)
 	}
 
-	fmt.Println("=" + strings.Repeat("=", 50))
-	fmt.Printf("📊 Active tasks: %d\n", activeCount)
+	printLine("=" + strings.Repeat("=", 50))
+	printf("📊 Active tasks: %d\n", activeCount)
+	if total := monday.TotalEstimate(tasks); total > 0 {
+		printf("🎯 Points: %s\n", monday.FormatPoints(total))
+	}
+}
+
+// groupHeading returns the icon and colored value of a group of tasks
+func groupHeading(groupBy, key string) string {
+	label := key
+	if label == "" {
+		label = "None"
+	}
+	switch groupBy {
+	case "status":
+		return getStatusIcon(key) + " " + colorize(label, getStatusColor(key))
+	case "priority":
+		return getPriorityIcon(key) + " " + colorize(label, getPriorityColor(key))
+	case "type":
+		return getTypeIcon(key) + " " + colorize(label, getTypeColor(key))
+	case "sprint":
+		return "🏃 " + colorize(label, ColorCyan)
+	case "assignee":
+		return "👤 " + colorize(label, ColorBlue)
+	}
+	return label
 }
 
 // Define which statuses are considered 'active'
@@ -98,19 +159,116 @@ func isActiveStatus(status string) bool {
 	return !(strings.Contains(status, "done") || strings.Contains(status, "completed") || strings.Contains(status, "removed"))
 }
 
+// PrintTask prints a task in the layout selected with -layout
 func PrintTask(task monday.Task) {
+	printTaskLine("", task)
+}
+
+// minNameWidth is the narrowest a wrapped task name gets, however long the line's prefix
+const minNameWidth = 20
+
+// printTaskLine prints a task after lead, e.g. the status icon of an ungrouped list. On a
+// terminal or with --width long names wrap below the name column and the assignees are cut
+// to fit, printed to a pipe the task stays on one line.
+func printTaskLine(lead string, task monday.Task) {
+	switch taskLayout {
+	case layoutCompact:
+		printCompactTask(lead, task)
+		return
+	case layoutTable, layoutWide:
+		printTableRow(task) // The status column replaces the lead
+		return
+	}
+
 	// Extract status, priority, and type
 	priorityColor := getPriorityColor(string(task.Priority))
 	taskTypeIcon := getTypeIcon(string(task.Type))
 
-	fmt.Printf("%s. %s [%s] %s, (%s, %s)\n",
-		padLocalId(task.LocalId),
+	prefix := fmt.Sprintf("%s%s. %s [%s] ",
+		lead,
+		formatLocalId(task),
 		taskTypeIcon,
 		colorize(padPriority(string(task.Priority)), priorityColor),
-		task.Name,
-		task.UserName,
-		task.UserEmail,
 	)
+	assignees := fmt.Sprintf("(%s, %s)", task.UserName, task.UserEmail)
+	width, detected := detectWidth()
+	if !detected {
+		printf("%s%s%s, %s%s%s%s\n", prefix, task.Name, formatTags(task.Tags), assignees, formatTeams(task.Teams), formatMirrors(task.Mirrors), formatStatusAge(task))
+		return
+	}
+
+	indent := displayWidth(prefix)
+	nameWidth := max(width-indent, minNameWidth)
+	lines := wrapText(task.Name, nameWidth)
+	details := formatTags(task.Tags) + ", " + assignees + formatTeams(task.Teams) + formatMirrors(task.Mirrors) + formatStatusAge(task)
+	if last := len(lines) - 1; displayWidth(lines[last])+displayWidth(details) <= nameWidth {
+		lines[last] += details
+	} else {
+		// The details go on a line of their own with the assignees cut to the space left
+		tags := strings.TrimPrefix(formatTags(task.Tags), " ")
+		if tags != "" {
+			tags += " "
+		}
+		teams := formatTeams(task.Teams) + formatMirrors(task.Mirrors) + formatStatusAge(task)
+		lines = append(lines, tags+fitAssignees(task, nameWidth-displayWidth(tags+teams))+teams)
+	}
+	printf("%s%s\n", prefix, strings.Join(lines, "\n"+strings.Repeat(" ", indent)))
+}
+
+// fitAssignees formats the assignees of a task as "(names, emails)" when they fit in width,
+// else as "(names)" with the names that fit and the number of others
+func fitAssignees(task monday.Task, width int) string {
+	if full := fmt.Sprintf("(%s, %s)", task.UserName, task.UserEmail); displayWidth(full) <= width {
+		return full
+	}
+	return "(" + fitList(task.UserName, width-2) + ")"
+}
+
+// fitList joins the items of a comma separated list that fit in width, counting the others
+// as +N. The first item is cut when even it does not fit.
+func fitList(list string, width int) string {
+	items := strings.Split(list, ", ")
+	for n := len(items); n > 0; n-- {
+		more := ""
+		if n < len(items) {
+			more = fmt.Sprintf(" +%d", len(items)-n)
+		}
+		joined := strings.Join(items[:n], ", ")
+		if displayWidth(joined+more) <= width {
+			return joined + more
+		}
+		if n == 1 {
+			return truncate(joined, max(width-len(more), 1)) + more
+		}
+	}
+	return list
+}
+
+// formatTeams formats assigned teams as " 👥 Platform, QA", or nothing without teams
+func formatTeams(teams []string) string {
+	if len(teams) == 0 {
+		return ""
+	}
+	return " " + colorize("👥 "+strings.Join(teams, ", "), ColorCyan)
+}
+
+// formatMirrors formats the values of mirror columns as " Epic status: Done", or nothing
+// without them
+func formatMirrors(mirrors []monday.Mirror) string {
+	var formatted strings.Builder
+	for _, mirror := range mirrors {
+		formatted.WriteString(" " + colorize(mirror.Column+": "+mirror.Text, ColorGray))
+	}
+	return formatted.String()
+}
+
+// formatTags formats tags as " #backend #urgent", or nothing without tags
+func formatTags(tags []string) string {
+	var formatted strings.Builder
+	for _, tag := range tags {
+		formatted.WriteString(" " + colorize("#"+tag, ColorBlue))
+	}
+	return formatted.String()
 }
 
 // Icon helper functions
@@ -170,35 +328,136 @@ func getTypeIcon(taskType string) string {
 	}
 }
 
+// PrintTaskChanges prints a concise colored diff of task changes.
+// If prefix is not empty it is printed in front of every change, e.g. a timestamp.
+func PrintTaskChanges(changes []monday.TaskChange, prefix string) {
+	if prefix != "" {
+		prefix = colorize(prefix, ColorGray) + " "
+	}
+	for _, change := range changes {
+		switch change.Kind {
+		case monday.ChangeAdded:
+			printf("%s%s %s. %s %s\n", prefix, colorize("+", ColorGreen), padLocalId(change.Task.LocalId), getTypeIcon(string(change.Task.Type)), colorize(change.Task.Name, ColorGreen))
+		case monday.ChangeRemoved:
+			printf("%s%s %s. %s %s\n", prefix, colorize("-", ColorRed), padLocalId(change.Task.LocalId), getTypeIcon(string(change.Task.Type)), colorize(change.Task.Name, ColorRed))
+		case monday.ChangeModified:
+			printf("%s%s %s. %s %s\n", prefix, colorize("~", ColorYellow), padLocalId(change.Task.LocalId), getTypeIcon(string(change.Task.Type)), change.Task.Name)
+			for _, field := range change.Fields {
+				printf("%s        %s: %s -> %s\n", prefix, field.Field, colorize(displayValue(field.Old), ColorRed), colorize(displayValue(field.New), ColorGreen))
+			}
+		}
+	}
+}
+
+func displayValue(value string) string {
+	if value == "" {
+		return "None"
+	}
+	return value
+}
+
+var sprintStateColorMap = map[monday.SprintState]string{
+	monday.SprintStateActive:    ColorGreen,
+	monday.SprintStatePlanned:   ColorBlue,
+	monday.SprintStateCompleted: ColorGray,
+}
+
+// PrintSprint prints a sprint with its dates and state
+func PrintSprint(index int, sprint monday.Sprint, now time.Time) {
+	dates := ""
+	if sprint.HasDates() {
+		dates = fmt.Sprintf(" (%s - %s)", sprint.StartDate.Format("2006-01-02"), sprint.EndDate.Format("2006-01-02"))
+	}
+	state := ""
+	if s := sprint.StateAt(now); s != monday.SprintStateNone {
+		state = " " + colorize("["+string(s)+"]", sprintStateColorMap[s])
+	}
+	printf("%d. %s%s%s\n", index, sprint.Name, dates, state)
+}
+
+// PrintError prints an error followed by an actionable hint for known API error classes
+func PrintError(context string, err error) {
+	printf("❌ %s: %v\n", context, err)
+	switch {
+	case errors.Is(err, monday.ErrUnauthorized):
+		printLine("💡 Your API key was rejected, run 'config set-api-key <api-key>'")
+	case errors.Is(err, monday.ErrRateLimited):
+		printLine("💡 Monday.com rate limit reached, wait a minute and try again")
+	case errors.Is(err, monday.ErrComplexityBudget):
+		printLine("💡 The query complexity budget is exhausted, wait a minute and try again")
+	case errors.Is(err, monday.ErrNotFound):
+		printLine("💡 Run 'doctor' to check the configured boards, columns and sprint board")
+	case errors.Is(err, monday.ErrInvalidLabel):
+		printLine("💡 Run 'board columns' to see the labels of each column")
+	case errors.Is(err, monday.ErrConflict):
+		printLine("💡 Run 'tasks fetch' and check the task, or replace the changes with 'sync push -force true'")
+	}
+}
+
 func PrintUserInfo(user *monday.User) {
-	fmt.Printf("👤 User Information\n")
-	fmt.Println("-" + strings.Repeat("-", 50))
-	fmt.Printf("🆔 ID: %s\n", user.ID)
-	fmt.Printf("👤 Name: %s\n", user.Name)
-	fmt.Printf("📧 Email: %s\n", user.Email)
+	printf("👤 User Information\n")
+	printLine("-" + strings.Repeat("-", 50))
+	printf("🆔 ID: %s\n", user.ID)
+	printf("👤 Name: %s\n", user.Name)
+	printf("📧 Email: %s\n", user.Email)
 	if user.Title != "" {
-		fmt.Printf("💼 Title: %s\n", user.Title)
+		printf("💼 Title: %s\n", user.Title)
 	}
 	if user.PhotoURL != "" {
-		fmt.Printf("🖼️  Photo: %s\n", user.PhotoURL)
+		printf("🖼️  Photo: %s\n", user.PhotoURL)
 	}
 	status := "❌ Disabled"
 	if user.Enabled {
 		status = "✅ Enabled"
 	}
-	fmt.Printf("🔐 Status: %s\n", status)
-	fmt.Println("=" + strings.Repeat("=", 50))
+	printf("🔐 Status: %s\n", status)
+	printLine("=" + strings.Repeat("=", 50))
+}
+
+// PrintPingResult prints the outcome of an API connection test
+func PrintPingResult(result *monday.PingResult) {
+	printf("🌐 Endpoint: %s\n", result.URL)
+	if result.Proxy != "" {
+		printf("🔀 Proxy: %s\n", result.Proxy)
+	} else {
+		printLine("🔀 Proxy: none")
+	}
+
+	if result.NetworkError != nil {
+		printf("❌ Network: %s\n", colorize(result.NetworkError.Error(), ColorRed))
+		printLine("💡 Check your internet connection, proxy settings and firewall")
+		return
+	}
+
+	printf("⏱️  Latency: %s (dns %s, connect %s, tls %s)\n",
+		result.Latency.Round(time.Millisecond),
+		result.DNSTime.Round(time.Millisecond),
+		result.ConnectTime.Round(time.Millisecond),
+		result.TLSTime.Round(time.Millisecond),
+	)
+	printf("📶 HTTP status: %d\n", result.StatusCode)
+	if result.TLSVersion != "" {
+		printf("🔒 TLS: %s, %s (server %s)\n", result.TLSVersion, result.CipherSuite, result.ServerName)
+	}
+
+	if result.AuthError != nil {
+		printf("❌ Auth: %s\n", colorize(result.AuthError.Error(), ColorRed))
+		printLine("💡 The API is reachable but the API key was rejected, run 'config set-api-key <api-key>'")
+		return
+	}
+
+	printf("✅ Authenticated as %s (%s, ID: %s)\n", result.User.Name, result.User.Email, result.User.ID)
 }
 
 func PrintCommand(cmd Command) {
-	fmt.Println("Command: " + cmd.Command)
-	fmt.Println("Args:")
+	printLine("Command: " + cmd.Command)
+	printLine("Args:")
 	for _, arg := range cmd.Args {
-		fmt.Println("    Arg: " + arg)
+		printLine("    Arg: " + arg)
 	}
-	fmt.Println("Flags:")
+	printLine("Flags:")
 	for _, flag := range cmd.Flags {
-		fmt.Println("    Flag: " + flag.Flag + " Value: " + flag.Value)
+		printLine("    Flag: " + flag.Flag + " Value: " + flag.Value)
 	}
 }
 
@@ -241,6 +500,17 @@ func padPriority(priority string) string {
 	return strings.Repeat(" ", leftPad+1) + priority + strings.Repeat(" ", rightPad+1)
 }
 
+// formatLocalId pads the local index, tasks listed with their board show board:index
+func formatLocalId(task monday.Task) string {
+	if task.LocalId == 0 {
+		return "   -" // Not in the cache, e.g. tasks of other boards in 'me work'
+	}
+	if task.Board == "" {
+		return padLocalId(task.LocalId)
+	}
+	return colorize(fmt.Sprintf("%s:%d", task.Board, task.LocalId), ColorMagenta)
+}
+
 func padLocalId(localId int) string {
 	s := strconv.Itoa(localId)
 	for len(s) < 4 {
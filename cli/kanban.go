@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// kanbanMinWidth and kanbanMaxWidth bound the width of a status column
+	kanbanMinWidth = 18
+	kanbanMaxWidth = 40
+	// kanbanGap is the space between status columns
+	kanbanGap = 2
+)
+
+// kanbanColumn is a status with its tasks, ordered like tasks list
+type kanbanColumn struct {
+	status string
+	tasks  []monday.Task
+}
+
+// HandleTasksBoardCommand shows the cached tasks as a kanban board with a column per status.
+// With -i the selected task can be moved between statuses.
+func (c *CLI) HandleTasksBoardCommand() {
+	tasks, timestamp, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID())
+	if !ok {
+		fmt.Println("❌ No cached tasks found")
+		fmt.Println("💡 Run 'tasks fetch' first")
+		os.Exit(ExitNotFound)
+	}
+	tasksList := make([]monday.Task, 0, len(tasks))
+	for _, task := range tasks {
+		tasksList = append(tasksList, task)
+	}
+	order, reverse := c.sortOrder()
+	tasksList = monday.SortTasks(monday.FilterTasks(tasksList, c.listFilters()), order, reverse)
+
+	if c.command.HasSwitch("interactive") {
+		if restore, ok := enableRawMode(); ok {
+			defer restore()
+			c.interactiveKanban(bufio.NewReader(os.Stdin), tasksList, restore)
+			return
+		}
+		fmt.Println("⚠️  Not a terminal, showing the board without moving tasks")
+	}
+
+	fmt.Println("Tasks cached at: " + timestamp.Format(time.RFC3339))
+	for _, line := range renderKanban(kanbanColumns(tasksList, nil), terminalWidth(), -1, -1) {
+		printLine(line)
+	}
+}
+
+// kanbanColumns groups tasks by status in the order they come in. Labels add empty columns
+// for statuses without tasks, so tasks can be moved there.
+func kanbanColumns(tasks []monday.Task, labels []string) []kanbanColumn {
+	var columns []kanbanColumn
+	index := make(map[string]int)
+	add := func(status string) int {
+		key := strings.ToLower(status)
+		if i, ok := index[key]; ok {
+			return i
+		}
+		index[key] = len(columns)
+		columns = append(columns, kanbanColumn{status: status})
+		return len(columns) - 1
+	}
+	for _, label := range labels {
+		add(label)
+	}
+	for _, task := range tasks {
+		status := string(task.Status)
+		if status == "" {
+			status = "None"
+		}
+		i := add(status)
+		columns[i].tasks = append(columns[i].tasks, task)
+	}
+	return columns
+}
+
+// renderKanban lays the columns out side by side, wrapping to further rows of columns when the
+// terminal is too narrow. The card at selectedColumn/selectedRow is highlighted, -1 for none.
+func renderKanban(columns []kanbanColumn, width, selectedColumn, selectedRow int) []string {
+	if len(columns) == 0 {
+		return []string{"No tasks"}
+	}
+	perRow := max(1, (width+kanbanGap)/(kanbanMinWidth+kanbanGap))
+	perRow = min(perRow, len(columns))
+	columnWidth := min(kanbanMaxWidth, (width+kanbanGap)/perRow-kanbanGap)
+
+	var lines []string
+	for start := 0; start < len(columns); start += perRow {
+		end := min(start+perRow, len(columns))
+		if start > 0 {
+			lines = append(lines, "")
+		}
+		height := 0
+		var header, rule strings.Builder
+		for i := start; i < end; i++ {
+			column := columns[i]
+			height = max(height, len(column.tasks))
+			title := truncate(fmt.Sprintf("%s (%d)", column.status, len(column.tasks)), columnWidth)
+			header.WriteString(colorize(pad(title, columnWidth), getStatusColor(column.status)))
+			rule.WriteString(strings.Repeat("─", columnWidth))
+			if i < end-1 {
+				header.WriteString(strings.Repeat(" ", kanbanGap))
+				rule.WriteString(strings.Repeat(" ", kanbanGap))
+			}
+		}
+		lines = append(lines, strings.TrimRight(header.String(), " "), strings.TrimRight(rule.String(), " "))
+
+		for row := 0; row < height; row++ {
+			var line strings.Builder
+			for i := start; i < end; i++ {
+				cell := strings.Repeat(" ", columnWidth)
+				if row < len(columns[i].tasks) {
+					cell = kanbanCard(columns[i].tasks[row], columnWidth, i == selectedColumn && row == selectedRow)
+				}
+				line.WriteString(cell)
+				if i < end-1 {
+					line.WriteString(strings.Repeat(" ", kanbanGap))
+				}
+			}
+			lines = append(lines, strings.TrimRight(line.String(), " "))
+		}
+	}
+	return lines
+}
+
+// kanbanCard formats a task as "12 Fix login" in the color of its priority
+func kanbanCard(task monday.Task, width int, selected bool) string {
+	marker := " "
+	if selected {
+		marker = ">"
+	}
+	text := pad(truncate(fmt.Sprintf("%s%d %s", marker, task.LocalId, task.Name), width), width)
+	if selected {
+		return colorize(text, "\033[7m")
+	}
+	return colorize(text, getPriorityColor(string(task.Priority)))
+}
+
+// pad fills text with spaces to width columns
+func pad(text string, width int) string {
+	if n := displayWidth(text); n < width {
+		return text + strings.Repeat(" ", width-n)
+	}
+	return text
+}
+
+// interactiveKanban redraws the board after every key. Arrows or h/j/k/l select a task,
+// < and > (or H and L) move it to the previous or next status, q leaves.
+func (c *CLI) interactiveKanban(reader *bufio.Reader, tasks []monday.Task, restore func()) {
+	client := c.newClient()
+	boardID := c.config.GetBoardID()
+	var labels []string
+	if board, err := client.GetBoard(boardID); err == nil {
+		if statusColumn, _, _ := monday.FindLabelColumns(board); statusColumn != nil {
+			if settings, err := monday.ParseColumnSettings(*statusColumn); err == nil {
+				labels = settings.LabelNames()
+			}
+		}
+	}
+
+	columns := kanbanColumns(tasks, labels)
+	selectedColumn, selectedRow := 0, 0
+	message := "←→↑↓ select   < > move task   q quit"
+	for {
+		selectedRow = min(selectedRow, max(0, len(columns[selectedColumn].tasks)-1))
+		fmt.Print("\033[H\033[2J")
+		for _, line := range renderKanban(columns, terminalWidth(), selectedColumn, selectedRow) {
+			fmt.Print(line + "\r\n")
+		}
+		fmt.Print("\r\n" + colorize(message, ColorGray) + "\r\n")
+
+		key, err := reader.ReadByte()
+		if err != nil || key == 3 || key == 4 || key == 'q' {
+			return
+		}
+		move := 0
+		switch key {
+		case 'h':
+			selectedColumn = max(0, selectedColumn-1)
+		case 'l':
+			selectedColumn = min(len(columns)-1, selectedColumn+1)
+		case 'k':
+			selectedRow = max(0, selectedRow-1)
+		case 'j':
+			selectedRow++
+		case '<', 'H':
+			move = -1
+		case '>', 'L':
+			move = 1
+		case 27: // Escape sequence, ESC [ A..D are the arrow keys, a lone ESC leaves
+			if next, _ := reader.ReadByte(); next != '[' {
+				return
+			}
+			switch arrow, _ := reader.ReadByte(); arrow {
+			case 'A':
+				selectedRow = max(0, selectedRow-1)
+			case 'B':
+				selectedRow++
+			case 'C':
+				selectedColumn = min(len(columns)-1, selectedColumn+1)
+			case 'D':
+				selectedColumn = max(0, selectedColumn-1)
+			}
+		}
+
+		target := selectedColumn + move
+		if move == 0 || target < 0 || target >= len(columns) || len(columns[selectedColumn].tasks) == 0 {
+			continue
+		}
+		task := columns[selectedColumn].tasks[selectedRow]
+		status := columns[target].status
+		updatedTask, err := client.UpdateTask(boardID, c.config.GetUserEmail(), task, status, "", "")
+		if errors.Is(err, monday.ErrDryRun) {
+			message = "Dry run, nothing was moved"
+			continue
+		}
+		if err != nil {
+			message = fmt.Sprintf("❌ Error moving task %d: %v", task.LocalId, err)
+			continue
+		}
+		updatedTask.LocalId = task.LocalId
+		monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+		c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+
+		from := &columns[selectedColumn]
+		from.tasks = append(from.tasks[:selectedRow:selectedRow], from.tasks[selectedRow+1:]...)
+		columns[target].tasks = append(columns[target].tasks, *updatedTask)
+		selectedColumn, selectedRow = target, len(columns[target].tasks)-1
+		message = fmt.Sprintf("✅ Moved %d to %s", task.LocalId, status)
+	}
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+// Task layouts selected with -layout
+const (
+	layoutDefault = "default" // Type, priority, name, tags and assignee on one line
+	layoutCompact = "compact" // Index, type and name cut to the terminal width
+	layoutTable   = "table"   // Aligned columns with a header
+	layoutWide    = "wide"    // The table with due date, sprint, tags and mirror columns
+)
+
+// taskLayout is the layout PrintTask prints tasks in
+var taskLayout = layoutDefault
+
+// parseLayout returns the layout given with -layout, the default layout without one
+func (c *CLI) parseLayout() string {
+	layout := layoutDefault
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-layout", "--layout":
+			layout = strings.ToLower(flag.Value)
+		}
+	}
+	switch layout {
+	case layoutDefault, layoutCompact, layoutTable, layoutWide:
+		return layout
+	}
+	fmt.Printf("❌ Invalid layout: %s\n", layout)
+	fmt.Println("Valid layouts: default, compact, table, wide")
+	os.Exit(ExitValidation)
+	return ""
+}
+
+// tableColumn is a fixed width column of the table and wide layouts, so rows line up
+// without knowing the other tasks of a list
+type tableColumn struct {
+	title string
+	width int
+	value func(monday.Task) string
+	color func(monday.Task) string // Nil for uncolored values
+}
+
+var (
+	idColumn = tableColumn{"ID", 4, func(t monday.Task) string {
+		if t.LocalId == 0 {
+			return "-"
+		}
+		if t.Board != "" {
+			return fmt.Sprintf("%s:%d", t.Board, t.LocalId)
+		}
+		return fmt.Sprint(t.LocalId)
+	}, nil}
+	statusColumn = tableColumn{"Status", 16, func(t monday.Task) string { return string(t.Status) },
+		func(t monday.Task) string { return getStatusColor(string(t.Status)) }}
+	priorityColumn = tableColumn{"Priority", 8, func(t monday.Task) string { return string(t.Priority) },
+		func(t monday.Task) string { return getPriorityColor(string(t.Priority)) }}
+	typeColumn = tableColumn{"Type", 9, func(t monday.Task) string { return string(t.Type) },
+		func(t monday.Task) string { return getTypeColor(string(t.Type)) }}
+	assigneeColumn = tableColumn{"Assignee", 16, func(t monday.Task) string { return t.UserName }, nil}
+	dueColumn      = tableColumn{"Due", 10, func(t monday.Task) string {
+		if t.DueDate.IsZero() {
+			return ""
+		}
+		return t.DueDate.Format("2006-01-02")
+	}, func(t monday.Task) string {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		if !t.DueDate.IsZero() && t.DueDate.Before(today) && !monday.IsDoneStatus(t.Status) {
+			return ColorRed // Overdue
+		}
+		return ""
+	}}
+	sprintColumn = tableColumn{"Sprint", 14, func(t monday.Task) string { return t.Sprint },
+		func(monday.Task) string { return ColorCyan }}
+)
+
+// tableColumns returns the columns of the table or wide layout, the name follows them
+func tableColumns(layout string) []tableColumn {
+	if layout == layoutWide {
+		return []tableColumn{idColumn, statusColumn, priorityColumn, typeColumn, dueColumn, sprintColumn, assigneeColumn}
+	}
+	return []tableColumn{idColumn, statusColumn, priorityColumn, typeColumn, assigneeColumn}
+}
+
+// tableNameWidth is the width left for the name after the columns, never less than minNameWidth
+func tableNameWidth(columns []tableColumn) int {
+	used := 0
+	for _, column := range columns {
+		used += column.width + 2
+	}
+	return max(terminalWidth()-used, minNameWidth)
+}
+
+// printTableHeader prints the column titles of the table and wide layouts
+func printTableHeader() {
+	if taskLayout != layoutTable && taskLayout != layoutWide {
+		return
+	}
+	var header strings.Builder
+	for _, column := range tableColumns(taskLayout) {
+		header.WriteString(pad(column.title, column.width) + "  ")
+	}
+	header.WriteString("Name")
+	printLine(colorize(header.String(), ColorGray))
+}
+
+// printTableRow prints a task as a row of the table or wide layout. Values are cut to their
+// column before they are colored, so escape codes are never cut.
+func printTableRow(task monday.Task) {
+	columns := tableColumns(taskLayout)
+	var row strings.Builder
+	for _, column := range columns {
+		cell := column.value(task)
+		if column.title != idColumn.title {
+			cell = truncate(cell, column.width) // Indexes are never cut, board:index may be longer
+		}
+		cell = pad(cell, column.width)
+		if column.color != nil {
+			if color := column.color(task); color != "" && strings.TrimSpace(cell) != "" {
+				cell = colorize(cell, color)
+			}
+		}
+		row.WriteString(cell + "  ")
+	}
+	name := task.Name
+	if taskLayout == layoutWide && len(task.Tags) > 0 {
+		name += " #" + strings.Join(task.Tags, " #")
+	}
+	if taskLayout == layoutWide {
+		for _, mirror := range task.Mirrors {
+			name += " " + mirror.Column + ": " + mirror.Text
+		}
+		if age, _ := statusAge(task); age != "" {
+			name += " " + age
+		}
+	}
+	row.WriteString(truncate(name, tableNameWidth(columns)))
+	printLine(row.String())
+}
+
+// printCompactTask prints the index, type icon and name of a task after lead on one line,
+// with the name cut to the terminal width and colored by priority
+func printCompactTask(lead string, task monday.Task) {
+	prefix := lead + padLocalId(task.LocalId)
+	if task.Board != "" {
+		prefix = fmt.Sprintf("%s%s:%d", lead, task.Board, task.LocalId)
+	}
+	prefix += " " + getTypeIcon(string(task.Type)) + " "
+	name := truncate(task.Name, max(terminalWidth()-displayWidth(prefix), minNameWidth))
+	if task.Priority != "" {
+		name = colorize(name, getPriorityColor(string(task.Priority)))
+	}
+	printf("%s%s\n", prefix, name)
+}
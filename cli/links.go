@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// HandleTaskLinkPRCommand posts a pull request URL as an update and stores it in the PR link column
+func (c *CLI) HandleTaskLinkPRCommand() {
+	task := c.cachedTaskArg("task link-pr <task-index> <pr-url>")
+	if len(c.command.Args) < 3 {
+		fmt.Println("Usage: monday-cli task link-pr <task-index> <pr-url>")
+		os.Exit(ExitValidation)
+	}
+	link, err := monday.PullRequestLink(c.command.Args[2])
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+
+	client := c.newClient()
+	columnID := c.prColumnID(client)
+
+	_, err = client.CreateUpdate(task.ID, fmt.Sprintf("🔗 Pull request: %s", link.URL))
+	if err == nil {
+		err = client.SetLinkColumn(c.config.GetBoardID(), task.ID, columnID, link)
+	}
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error linking pull request", err)
+	}
+	c.recordHistory(monday.HistoryLink, task, task, link.URL)
+	fmt.Printf("✅ Linked %s to task %d\n", link.Text, task.LocalId)
+	PrintTask(task)
+}
+
+// HandleTaskOpenPRCommand opens the pull request linked to a task in the browser
+func (c *CLI) HandleTaskOpenPRCommand() {
+	task := c.cachedTaskArg("task open-pr <task-index>")
+	client := c.newClient()
+	link, err := client.GetLinkColumn(task.ID, c.prColumnID(client))
+	if errors.Is(err, monday.ErrNotFound) {
+		fmt.Printf("❌ No pull request linked to task %d\n", task.LocalId)
+		fmt.Println("💡 Link one with 'task link-pr <task-index> <pr-url>'")
+		os.Exit(ExitNotFound)
+	}
+	if err != nil {
+		exitWithError("Error reading pull request link", err)
+	}
+	progressf("🌐 Opening %s\n", link.URL)
+	if err := openURL(link.URL); err != nil {
+		fmt.Printf("❌ Could not open the browser: %v\n", err)
+		fmt.Println(link.URL)
+		os.Exit(ExitError)
+	}
+}
+
+// HandleTaskOpenCommand opens a task on monday.com in the browser, --print only outputs the URL
+func (c *CLI) HandleTaskOpenCommand() {
+	task := c.cachedTaskArg("task open <task-index> [--print]")
+	itemURL := c.taskURL(task)
+	if c.command.HasSwitch("print") {
+		fmt.Println(itemURL)
+		return
+	}
+	progressf("🌐 Opening %s\n", itemURL)
+	if err := openURL(itemURL); err != nil {
+		fmt.Printf("❌ Could not open the browser: %v\n", err)
+		fmt.Println(itemURL)
+		os.Exit(ExitError)
+	}
+}
+
+// taskURL returns the web URL of a task. It is built from the configured account slug,
+// otherwise fetched once with the API and the slug is remembered for the next time.
+func (c *CLI) taskURL(task monday.Task) string {
+	if slug := c.config.GetAccountSlug(); slug != "" {
+		return monday.ItemURL(slug, c.config.GetBoardID(), task.ID)
+	}
+	itemURL, err := c.newClient().GetItemURL(task.ID)
+	if err != nil {
+		exitWithError("Error fetching task URL", err)
+	}
+	if slug := monday.AccountSlugFromURL(itemURL); slug != "" {
+		c.config.SetAccountSlug(slug)
+		if err := c.config.Save(monday.GetConfigPath()); err != nil {
+			monday.Logger().Warn("failed to save account slug", "error", err)
+		}
+	}
+	return itemURL
+}
+
+// prColumnID returns the configured PR link column, or detects it by title on the board
+func (c *CLI) prColumnID(client *monday.Client) string {
+	if columnID := c.config.GetPRColumnID(); columnID != "" {
+		return columnID
+	}
+	board, err := client.GetBoard(c.config.GetBoardID())
+	if err != nil {
+		exitWithError("Error fetching board", err)
+	}
+	columnID, ok := monday.FindLinkColumn(board, "pr", "prs", "pull request")
+	if !ok {
+		fmt.Println("❌ No pull request link column found on the board")
+		fmt.Println("💡 Run 'config set-pr-column <column-id>', see 'board columns' for link columns")
+		os.Exit(ExitConfigMissing)
+	}
+	return columnID
+}
+
+// openURL opens a URL with the desktop's default handler
+func openURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
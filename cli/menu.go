@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// menuHeight is the number of options shown at once, longer menus scroll
+const menuHeight = 10
+
+// selectOption shows a menu and returns the index of the chosen option. In a terminal the
+// arrow keys (or j/k) move and enter selects, elsewhere the number of an option is read.
+func selectOption(reader *bufio.Reader, question string, options []string, selected int) int {
+	fmt.Println(question)
+	if restore, ok := enableRawMode(); ok {
+		defer restore()
+		return arrowMenu(reader, options, selected, restore)
+	}
+	return numberedMenu(reader, options, selected)
+}
+
+// arrowMenu runs the menu in raw mode, restore is called before exiting on ctrl-c
+func arrowMenu(reader *bufio.Reader, options []string, selected int, restore func()) int {
+	height := min(menuHeight, len(options))
+	top := 0
+	for {
+		top = scrollWindow(top, selected, height)
+		for i := top; i < top+height; i++ {
+			if i == selected {
+				fmt.Printf("\r\033[2K   %s\r\n", colorize("❯ "+options[i], ColorCyan))
+			} else {
+				fmt.Printf("\r\033[2K     %s\r\n", options[i])
+			}
+		}
+
+		key, err := reader.ReadByte()
+		if err != nil || key == 3 || key == 4 {
+			restore()
+			fmt.Println("❌ Aborted")
+			os.Exit(ExitError)
+		}
+		switch key {
+		case '\r', '\n':
+			// Leave only the chosen option on screen
+			fmt.Printf("\033[%dA\033[J   %s\r\n", height, colorize("✔ "+options[selected], ColorGreen))
+			return selected
+		case 'k':
+			selected = (selected + len(options) - 1) % len(options)
+		case 'j':
+			selected = (selected + 1) % len(options)
+		case 27: // Escape sequence, ESC [ A is up and ESC [ B is down
+			if next, _ := reader.ReadByte(); next == '[' {
+				switch arrow, _ := reader.ReadByte(); arrow {
+				case 'A':
+					selected = (selected + len(options) - 1) % len(options)
+				case 'B':
+					selected = (selected + 1) % len(options)
+				}
+			}
+		default:
+			if key >= '1' && key <= '9' && int(key-'1') < len(options) {
+				selected = int(key - '1')
+			}
+		}
+		fmt.Printf("\033[%dA", height)
+	}
+}
+
+// scrollWindow returns the first visible row, moved just enough to keep the selection visible
+func scrollWindow(top, selected, height int) int {
+	if selected < top {
+		return selected
+	}
+	if selected >= top+height {
+		return selected - height + 1
+	}
+	return top
+}
+
+// numberedMenu lists the options and reads a number or an option name, empty keeps the selection
+func numberedMenu(reader *bufio.Reader, options []string, selected int) int {
+	for i, option := range options {
+		fmt.Printf("   %d) %s\n", i+1, option)
+	}
+	for {
+		answer := prompt(reader, fmt.Sprintf("   Choice [%d]: ", selected+1))
+		if answer == "" {
+			return selected
+		}
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(options) {
+			return number - 1
+		}
+		for i, option := range options {
+			if strings.EqualFold(option, answer) {
+				return i
+			}
+		}
+		fmt.Printf("   ❌ Enter a number from 1 to %d\n", len(options))
+	}
+}
+
+// selectOptions shows a checklist with every option checked and returns whether each option is
+// checked when confirmed. In a terminal space toggles an option, a toggles all and enter
+// confirms, elsewhere the numbers of the options to keep are read.
+func selectOptions(reader *bufio.Reader, question string, options []string) []bool {
+	fmt.Println(question)
+	checked := make([]bool, len(options))
+	for i := range checked {
+		checked[i] = true
+	}
+	if restore, ok := enableRawMode(); ok {
+		defer restore()
+		return checklistMenu(reader, options, checked, restore)
+	}
+	return numberedChecklist(reader, options, checked)
+}
+
+// checklistMenu runs the checklist in raw mode, restore is called before exiting on ctrl-c
+func checklistMenu(reader *bufio.Reader, options []string, checked []bool, restore func()) []bool {
+	height := min(menuHeight, len(options))
+	top, selected := 0, 0
+	fmt.Printf("   %s\r\n", colorize("space toggles, a toggles all, enter confirms", ColorGray))
+	for {
+		top = scrollWindow(top, selected, height)
+		for i := top; i < top+height; i++ {
+			box := "[ ]"
+			if checked[i] {
+				box = "[x]"
+			}
+			if i == selected {
+				fmt.Printf("\r\033[2K   %s\r\n", colorize("❯ "+box+" "+options[i], ColorCyan))
+			} else {
+				fmt.Printf("\r\033[2K     %s %s\r\n", box, options[i])
+			}
+		}
+
+		key, err := reader.ReadByte()
+		if err != nil || key == 3 || key == 4 {
+			restore()
+			fmt.Println("❌ Aborted")
+			os.Exit(ExitError)
+		}
+		switch key {
+		case '\r', '\n':
+			count := 0
+			for _, c := range checked {
+				if c {
+					count++
+				}
+			}
+			fmt.Printf("\033[%dA\033[J   %s\r\n", height+1, colorize(fmt.Sprintf("✔ %d of %d selected", count, len(options)), ColorGreen))
+			return checked
+		case ' ':
+			checked[selected] = !checked[selected]
+		case 'a':
+			all := !checked[0]
+			for i := range checked {
+				checked[i] = all
+			}
+		case 'k':
+			selected = (selected + len(options) - 1) % len(options)
+		case 'j':
+			selected = (selected + 1) % len(options)
+		case 27: // Escape sequence, ESC [ A is up and ESC [ B is down
+			if next, _ := reader.ReadByte(); next == '[' {
+				switch arrow, _ := reader.ReadByte(); arrow {
+				case 'A':
+					selected = (selected + len(options) - 1) % len(options)
+				case 'B':
+					selected = (selected + 1) % len(options)
+				}
+			}
+		}
+		fmt.Printf("\033[%dA", height)
+	}
+}
+
+// numberedChecklist lists the options and reads the numbers of those to keep, like 1,3-5, all or
+// none. An empty answer keeps all options checked.
+func numberedChecklist(reader *bufio.Reader, options []string, checked []bool) []bool {
+	for i, option := range options {
+		fmt.Printf("   %d) %s\n", i+1, option)
+	}
+	for {
+		answer := strings.ToLower(prompt(reader, "   Numbers like 1,3-5, all or none [all]: "))
+		switch answer {
+		case "", "all":
+			return checked
+		case "none":
+			return make([]bool, len(options))
+		}
+		if numbers, ok := parseNumberList(answer, len(options)); ok {
+			picked := make([]bool, len(options))
+			for _, number := range numbers {
+				picked[number-1] = true
+			}
+			return picked
+		}
+		fmt.Printf("   ❌ Enter numbers from 1 to %d\n", len(options))
+	}
+}
+
+// parseNumberList parses numbers and ranges like "1,3-5" between 1 and limit
+func parseNumberList(text string, limit int) ([]int, bool) {
+	var numbers []int
+	for _, part := range strings.Split(text, ",") {
+		part = strings.TrimSpace(part)
+		from, to, isRange := strings.Cut(part, "-")
+		if !isRange {
+			to = from
+		}
+		first, err1 := strconv.Atoi(strings.TrimSpace(from))
+		last, err2 := strconv.Atoi(strings.TrimSpace(to))
+		if err1 != nil || err2 != nil || first < 1 || last > limit || first > last {
+			return nil, false
+		}
+		for n := first; n <= last; n++ {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers, true
+}
+
+// enableRawMode switches the terminal to raw mode with stty, so single key presses can be read
+func enableRawMode() (restore func(), ok bool) {
+	if runtime.GOOS == "windows" || !isInteractive() {
+		return nil, false
+	}
+	state, err := stty("-g")
+	if err != nil {
+		return nil, false
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, false
+	}
+	fmt.Print("\033[?25l") // Hide the cursor while the menu is shown
+	restored := false
+	return func() {
+		if !restored {
+			restored = true
+			fmt.Print("\033[?25h")
+			stty(state)
+		}
+	}, true
+}
+
+// stty runs stty on the terminal attached to stdin
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"monday-cli/monday"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// metricsExporter serves the latest board metrics, refreshed on a timer
+type metricsExporter struct {
+	mu      sync.Mutex
+	body    []byte
+	healthy bool
+}
+
+// HandleMetricsCommand handles the metrics exporter
+func (c *CLI) HandleMetricsCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpMetricsCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "serve":
+		c.HandleMetricsServeCommand()
+	default:
+		c.HelpMetricsCommand()
+	}
+}
+
+func (c *CLI) HelpMetricsCommand() {
+	fmt.Println("Metrics Commands:")
+	fmt.Println("  metrics serve [-port 9090] [-interval 5m] [-stale-days 14]")
+	fmt.Println("    Serves board metrics for Prometheus on /metrics, refreshing the configured boards on a timer")
+	fmt.Println("    -port, -p <port>          Port to listen on (default 9090)")
+	fmt.Println("    -interval, -i <duration>  Time between refreshes from Monday.com (default 5m)")
+	fmt.Println("    -stale-days <days>        Days without update before an active task counts as stale (default 14)")
+}
+
+// HandleMetricsServeCommand fetches the configured boards on a timer and serves their metrics
+func (c *CLI) HandleMetricsServeCommand() {
+	port := "9090"
+	interval := 5 * time.Minute
+	staleDays := 14
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-port", "--port", "-p":
+			port = flag.Value
+		case "-interval", "--interval", "-i":
+			duration, err := time.ParseDuration(flag.Value)
+			if err != nil || duration < time.Minute {
+				fmt.Printf("❌ Invalid interval: %s (use e.g. 5m, at least 1m)\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			interval = duration
+		case "-stale-days", "--stale-days":
+			days, err := strconv.Atoi(flag.Value)
+			if err != nil || days < 1 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			staleDays = days
+		}
+	}
+
+	boards := c.config.GetBoards()
+	if len(boards) == 0 {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+
+	exporter := &metricsExporter{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", exporter.handleMetrics)
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		fmt.Printf("❌ Error listening on port %s: %v\n", port, err)
+		os.Exit(ExitError)
+	}
+	httpServer := &http.Server{Handler: mux}
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("❌ Server error: %v\n", err)
+			os.Exit(ExitError)
+		}
+	}()
+	fmt.Printf("📈 Serving metrics of %d boards on :%s/metrics, refreshing every %s (Ctrl+C to stop)\n", len(boards), port, interval)
+
+	client := c.newClient()
+	dataStore := monday.NewDataStore()
+	refresh := func() {
+		exporter.update(c.collectMetrics(client, dataStore, boards, staleDays))
+	}
+	refresh()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	for {
+		select {
+		case <-ticker.C:
+			refresh()
+		case <-signals:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			httpServer.Shutdown(ctx)
+			cancel()
+			fmt.Println("\n✅ Server stopped")
+			return
+		}
+	}
+}
+
+// collectMetrics fetches every board into the cache and computes its metrics. A board that cannot
+// be fetched keeps reporting its cached state, so a short API outage does not leave gaps in graphs.
+func (c *CLI) collectMetrics(client *monday.Client, dataStore *monday.DataStore, boards []monday.BoardRef, staleDays int) []monday.BoardMetrics {
+	timestamp := time.Now().Format("15:04:05")
+	sprintName := c.currentSprintName()
+	analytics := monday.NewAnalyticsService(client, dataStore)
+
+	var collected []monday.BoardMetrics
+	for _, board := range boards {
+		tasks, rawItems, err := client.GetBoardItems(board.ID)
+		if err != nil {
+			fmt.Printf("%s ⚠️  Warning: Could not fetch board %s: %v\n", timestamp, board.Name, err)
+		} else {
+			users, _, _ := dataStore.GetCachedBoardUsers(board.ID)
+			dataStore.StoreTasksRequest(board.ID, tasks, rawItems)
+			dataStore.StoreBoardUsers(board.ID, users)
+		}
+
+		metrics, err := analytics.BoardMetrics(board.ID, sprintName, staleDays, time.Now())
+		if err != nil {
+			fmt.Printf("%s ⚠️  Warning: No metrics for board %s: %v\n", timestamp, board.Name, err)
+			continue
+		}
+		metrics.BoardName = board.Name
+		collected = append(collected, *metrics)
+	}
+	fmt.Printf("%s 🔄 Refreshed %d of %d boards\n", timestamp, len(collected), len(boards))
+	return collected
+}
+
+// update renders the metrics once, so scrapes only copy the latest snapshot
+func (e *metricsExporter) update(boards []monday.BoardMetrics) {
+	var body bytes.Buffer
+	if err := monday.WriteMetrics(&body, boards); err != nil {
+		fmt.Printf("⚠️  Warning: Could not render metrics: %v\n", err)
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.body = body.Bytes()
+	e.healthy = len(boards) > 0
+}
+
+// handleMetrics answers Prometheus scrapes with the latest snapshot
+func (e *metricsExporter) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	body, healthy := e.body, e.healthy
+	e.mu.Unlock()
+	if !healthy {
+		http.Error(w, "no board metrics collected yet", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(body)
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+)
+
+// HandleTaskMoveCommand moves a task into a group of another board, e.g. to triage items from
+// an intake board, and moves it between the caches of both boards
+func (c *CLI) HandleTaskMoveCommand() {
+	usage := "task move <task-index> -to-board <board> [-group <group>] [-map <column-id>:<column-id>,...]"
+	task := c.cachedTaskArg(usage)
+
+	var target monday.BoardRef
+	var groupName string
+	var columnMap map[string]string
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-to-board", "--to-board", "-to":
+			target = c.resolveBoard(flag.Value)
+		case "-group", "--group", "-g":
+			groupName = flag.Value
+		case "-map", "--map":
+			var err error
+			if columnMap, err = parseColumnMap(flag.Value); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				fmt.Println("Usage: monday-cli " + usage)
+				os.Exit(ExitValidation)
+			}
+		}
+	}
+	if target.ID == "" {
+		fmt.Println("Usage: monday-cli " + usage)
+		fmt.Println("💡 Select the board the task is on with -board <board> or <board>:<task-index>")
+		os.Exit(ExitValidation)
+	}
+	boardID := c.config.GetBoardID()
+	if target.ID == boardID {
+		fmt.Printf("❌ Task %d is already on board %s\n", task.LocalId, target.Name)
+		os.Exit(ExitValidation)
+	}
+
+	client := c.newClient()
+	groups, err := client.GetBoardGroups(target.ID)
+	if err != nil {
+		exitWithError("Error fetching groups of the target board", err)
+	}
+	if len(groups) == 0 {
+		fmt.Printf("❌ Board %s has no groups\n", target.Name)
+		os.Exit(ExitNotFound)
+	}
+	group := groups[0] // The top group, like items created without a group
+	if groupName != "" {
+		var ok bool
+		if group, ok = monday.FindGroup(groups, groupName); !ok {
+			fmt.Printf("❌ Group %q not found on board %s\n", groupName, target.Name)
+			fmt.Println("Groups:")
+			for _, g := range groups {
+				fmt.Printf("  %-20s %s\n", g.ID, g.Title)
+			}
+			os.Exit(ExitNotFound)
+		}
+	}
+
+	err = client.MoveItemToBoard(task.ID, target.ID, group.ID, columnMap)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error moving task", err)
+	}
+
+	monday.NewDataStore().RemoveCachedTask(boardID, task.ID)
+	c.recordHistory(monday.HistoryMove, task, task, fmt.Sprintf("to board %s, group %s", target.Name, group.Title))
+	moved, err := client.GetTaskByID(task.ID)
+	if err != nil {
+		fmt.Printf("✅ Task %d moved to %s / %s\n", task.LocalId, target.Name, group.Title)
+		fmt.Printf("💡 Run 'tasks fetch -board %s' to add it to the cache of the board\n", target.Name)
+		return
+	}
+	localId := c.cacheCreatedTask(target.ID, moved)
+	fmt.Printf("✅ Task %d moved to %s / %s as task %s:%d\n", task.LocalId, target.Name, group.Title, target.Name, localId)
+	PrintTask(*moved)
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"strings"
+)
+
+// HandleMeCommand handles commands about the authenticated user across boards
+func (c *CLI) HandleMeCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpMeCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "work", "w":
+		c.HandleMyWorkCommand()
+	default:
+		c.HelpMeCommand()
+	}
+}
+
+func (c *CLI) HelpMeCommand() {
+	fmt.Println("Me Commands:")
+	fmt.Println("  me work (w) [filter flags] [-sort <fields>]  List the tasks assigned to you on all boards, grouped by board and status")
+}
+
+// HandleMyWorkCommand lists the tasks assigned to the authenticated user on every board, independent
+// of the configured boards. Tasks of cached boards get their index, of configured boards board:index.
+func (c *CLI) HandleMyWorkCommand() {
+	progressf("🔍 Collecting your tasks on all boards...\n")
+	assigned, err := c.newClient().GetAssignedTasks()
+	if err != nil {
+		exitWithError("Error fetching your tasks", err)
+	}
+	filters, _ := c.filterFlags()
+
+	dataStore := monday.NewDataStore()
+	total, boards := 0, 0
+	for _, board := range assigned {
+		tasks := monday.FilterTasks(board.Tasks, filters)
+		if len(tasks) == 0 {
+			continue
+		}
+		ref, configured := c.config.FindBoard(board.Board.ID)
+		cached, _, _ := dataStore.GetCachedTasks(board.Board.ID)
+		for i, task := range tasks {
+			if cachedTask, ok := cached[task.ID]; ok {
+				tasks[i].LocalId = cachedTask.LocalId
+			}
+			if configured && board.Board.ID != c.config.BoardID {
+				tasks[i].Board = ref.Name
+			}
+		}
+
+		printf("\n📋 %s (ID: %s)\n", colorize(board.Board.Name, ColorCyan), board.Board.ID)
+		printLine("-" + strings.Repeat("-", len(board.Board.Name)+20))
+		c.printTasks(tasks)
+		total += len(tasks)
+		boards++
+	}
+	if total == 0 {
+		fmt.Println("🎉 No tasks assigned to you")
+		return
+	}
+	printf("\n👤 %d tasks assigned to you on %d boards\n", total, boards)
+}
@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"slices"
+	"strings"
+)
+
+// isInteractive reports whether stdin is a terminal, so prompts can be answered
+func isInteractive() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	// The null device is a character device too, but nobody can answer there
+	if null, err := os.Stat(os.DevNull); err == nil && os.SameFile(info, null) {
+		return false
+	}
+	return true
+}
+
+// prompt prints a question and reads a trimmed answer from stdin
+func prompt(reader *bufio.Reader, question string) string {
+	fmt.Print(question)
+	answer, err := reader.ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println("")
+		fmt.Println("❌ Aborted")
+		os.Exit(ExitConfigMissing)
+	}
+	return strings.TrimSpace(answer)
+}
+
+// confirm asks a yes/no question, an empty answer picks the default
+func confirm(reader *bufio.Reader, question string, defaultYes bool) bool {
+	choices := " [y/N]: "
+	if defaultYes {
+		choices = " [Y/n]: "
+	}
+	switch strings.ToLower(prompt(reader, question+choices)) {
+	case "":
+		return defaultYes
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// RunOnboarding walks a new user through the API key, board, sprint board, columns and default
+// filters. The configuration is written once all steps are answered, then the tasks are fetched.
+func (c *CLI) RunOnboarding() {
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Println("👋 Welcome to Monday CLI!")
+	fmt.Println("=" + strings.Repeat("=", 50))
+	fmt.Println("Let's get you set up, this takes about a minute. Nothing is saved until the last step.")
+	fmt.Println("")
+
+	// Step 1: API key and user info
+	fmt.Println("1️⃣  API key")
+	fmt.Println("   Create a personal API token in Monday.com under your avatar > Developers > My access tokens,")
+	fmt.Println("   or open https://<your-team>.monday.com/apps/manage/tokens")
+	var user *monday.User
+	for user == nil {
+		apiKey := prompt(reader, "   Paste your API key: ")
+		if apiKey == "" {
+			continue
+		}
+		c.config.SetAPIKey(apiKey)
+		c.config.SetStaticAuth()
+
+		fmt.Println("   🔍 Checking API key...")
+		var err error
+		user, err = c.newClient().GetUserInfo()
+		if err != nil {
+			PrintError("API key did not work", err)
+			user = nil
+			continue
+		}
+	}
+	c.config.SetUserInfo(user)
+	fmt.Printf("   ✅ Authenticated as %s (%s)\n", user.Name, user.Email)
+	fmt.Println("")
+
+	client := c.newClient()
+	boards, err := monday.NewBoardService(client).ListBoards()
+	if err != nil {
+		PrintError("Could not list your boards", err)
+	}
+
+	// Step 2: board
+	fmt.Println("2️⃣  Board")
+	board := c.onboardingPickBoard(reader, client, boards, "   Which board holds your tasks?", false)
+	c.config.SetBoardID(board.ID)
+	c.config.AddBoard(monday.BoardRef{ID: board.ID, Name: monday.Slugify(board.Name)})
+	fmt.Printf("   ✅ Using board %s\n", board.Name)
+	fmt.Println("")
+
+	// Step 3: optional sprint board
+	fmt.Println("3️⃣  Sprint board (optional)")
+	fmt.Println("   If your team plans in sprints, pick the sprint board.")
+	if sprintBoard := c.onboardingPickBoard(reader, client, boards, "   Which board holds your sprints?", true); sprintBoard != nil {
+		c.config.SetSprintBoardID(sprintBoard.ID)
+		fmt.Printf("   ✅ Using sprint board %s\n", sprintBoard.Name)
+	}
+	fmt.Println("")
+
+	// Step 4: columns
+	fmt.Println("4️⃣  Columns")
+	c.onboardingColumns(reader, board)
+	fmt.Println("")
+
+	// Step 5: default filters
+	fmt.Println("5️⃣  Default filters")
+	c.onboardingFilters(reader, board)
+	fmt.Println("")
+
+	if err := c.config.Save(monday.GetConfigPath()); err != nil {
+		exitWithError("Error saving configuration", err)
+	}
+	fmt.Printf("💾 Configuration written to %s\n", monday.GetConfigPath())
+	fmt.Println("")
+
+	// Step 6: first fetch
+	fmt.Println("6️⃣  Fetching your tasks")
+	c.firstRun = false
+	c.HandleFetchCommand()
+
+	fmt.Println("")
+	fmt.Println("🎉 All set! Useful next steps:")
+	fmt.Println("  tasks list                 Show cached tasks")
+	fmt.Println("  task create <name> -t b    Create a bug")
+	fmt.Println("  doctor                     Check the setup")
+	fmt.Println("  help                       Show all commands")
+}
+
+// onboardingPickBoard lets the user pick one of the boards or enter a board ID, and checks the
+// board exists. Optional questions can be skipped, which returns nil.
+func (c *CLI) onboardingPickBoard(reader *bufio.Reader, client *monday.Client, boards []monday.Board, question string, optional bool) *monday.Board {
+	var options []string
+	if optional {
+		options = append(options, "Skip")
+	}
+	for _, board := range boards {
+		options = append(options, fmt.Sprintf("%s (%s)", board.Name, board.ID))
+	}
+	options = append(options, "Enter a board ID")
+
+	for {
+		choice := len(options) - 1 // Without a board list only an ID can be entered
+		if len(boards) > 0 {
+			choice = selectOption(reader, question, options, 0)
+		} else if optional && !confirm(reader, question, false) {
+			return nil
+		}
+		if optional && choice == 0 {
+			return nil
+		}
+		boardID := ""
+		if choice == len(options)-1 {
+			fmt.Println("   The board ID is the number in the board URL: https://<your-team>.monday.com/boards/1234567890")
+			boardID = prompt(reader, "   Board ID: ")
+		} else if optional {
+			boardID = boards[choice-1].ID
+		} else {
+			boardID = boards[choice].ID
+		}
+		if boardID == "" {
+			continue
+		}
+		board, err := monday.NewBoardService(client).GetBoardByID(boardID)
+		if err != nil {
+			PrintError("Could not open board", err)
+			continue
+		}
+		return board
+	}
+}
+
+// onboardingColumns shows which columns were found on the board and asks for the pull request link column
+func (c *CLI) onboardingColumns(reader *bufio.Reader, board *monday.Board) {
+	for _, check := range monday.CheckBoardColumns(board) {
+		switch {
+		case check.Column != nil:
+			fmt.Printf("   ✅ %-10s %s\n", check.Name, check.Column.Title)
+		case check.Required:
+			fmt.Printf("   ❌ %-10s missing, needed to %s\n", check.Name, check.Purpose)
+		default:
+			fmt.Printf("   ⚠️  %-10s missing, needed to %s\n", check.Name, check.Purpose)
+		}
+	}
+
+	var links []monday.Column
+	for _, column := range board.Columns {
+		if column.Type == "link" {
+			links = append(links, column)
+		}
+	}
+	if len(links) == 0 {
+		return
+	}
+	options := []string{"Skip"}
+	for _, column := range links {
+		options = append(options, fmt.Sprintf("%s (%s)", column.Title, column.ID))
+	}
+	if choice := selectOption(reader, "   Which link column should 'task link-pr' fill?", options, 0); choice > 0 {
+		c.config.SetPRColumnID(links[choice-1].ID)
+		fmt.Printf("   ✅ Pull request links go to %s\n", links[choice-1].Title)
+	}
+}
+
+// onboardingFilters sets the filters applied to task lists by default
+func (c *CLI) onboardingFilters(reader *bufio.Reader, board *monday.Board) {
+	if confirm(reader, "   Only show tasks assigned to you?", true) {
+		c.config.FilterToCurrentUser()
+	}
+
+	status, _, _ := monday.FindLabelColumns(board)
+	if status == nil {
+		return
+	}
+	settings, _ := monday.ParseColumnSettings(*status)
+	if len(settings.Labels) > 0 {
+		fmt.Printf("   Statuses: %s\n", strings.Join(settings.LabelNames(), ", "))
+	}
+	for {
+		answer := prompt(reader, "   Hide tasks with these statuses (comma separated, empty for none): ")
+		if answer == "" {
+			return
+		}
+		var hidden []string
+		valid := true
+		for _, label := range strings.Split(answer, ",") {
+			name, err := monday.ValidateLabel(*status, strings.TrimSpace(label))
+			if err != nil {
+				fmt.Printf("   ❌ %v\n", err)
+				valid = false
+				break
+			}
+			hidden = append(hidden, name)
+		}
+		if !valid {
+			continue
+		}
+		for _, name := range hidden {
+			if !slices.Contains(c.config.GetFilterValues(monday.FilterStatus, monday.Blacklist), strings.ToLower(name)) {
+				c.config.AddFilter(monday.FilterStatus, monday.Blacklist, name)
+			}
+		}
+		fmt.Printf("   ✅ Hiding %s\n", strings.Join(hidden, ", "))
+		return
+	}
+}
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// NoColorEnvVar disables colors and emoji when set to any non-empty value, see https://no-color.org
+const NoColorEnvVar = "NO_COLOR"
+
+var (
+	// quietOutput suppresses progress messages, results and errors are still printed
+	quietOutput bool
+	// plainOutput strips ANSI colors and emoji from printed items
+	plainOutput bool
+	// widthOverride is the output width given with --width, 0 to detect it
+	widthOverride int
+)
+
+// configureOutput applies --quiet, --no-color, NO_COLOR, -layout and --width
+func (c *CLI) configureOutput() {
+	quietOutput = c.command.HasSwitch("quiet")
+	plainOutput = c.command.HasSwitch("no-color") || os.Getenv(NoColorEnvVar) != ""
+	taskLayout = c.parseLayout()
+	widthOverride = c.parseWidth()
+}
+
+// minOutputWidth is the narrowest width --width accepts
+const minOutputWidth = 40
+
+// parseWidth returns the width given with --width, 0 without one
+func (c *CLI) parseWidth() int {
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-width", "--width":
+			width, err := strconv.Atoi(flag.Value)
+			if err != nil || width < minOutputWidth {
+				fmt.Printf("❌ Invalid width: %s\n", flag.Value)
+				fmt.Printf("The width is a number of columns, at least %d\n", minOutputWidth)
+				os.Exit(ExitValidation)
+			}
+			return width
+		}
+	}
+	return 0
+}
+
+// progressf prints a progress message unless --quiet was given
+func progressf(format string, a ...interface{}) {
+	if quietOutput {
+		return
+	}
+	printf(format, a...)
+}
+
+// printf prints like fmt.Printf, stripping emoji when plain output is enabled
+func printf(format string, a ...interface{}) {
+	s := fmt.Sprintf(format, a...)
+	if plainOutput {
+		s = stripEmoji(s)
+	}
+	fmt.Print(s)
+}
+
+// isTerminalOutput reports whether stdout is a terminal, so lines can be overwritten
+func isTerminalOutput() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// defaultTerminalWidth is used when the width of the terminal cannot be determined
+const defaultTerminalWidth = 120
+
+// terminalWidth returns the width given with --width, else the number of columns of the
+// terminal from $COLUMNS or stty
+func terminalWidth() int {
+	width, _ := detectWidth()
+	return width
+}
+
+// detectWidth returns the output width and whether it was given or detected, rather than
+// the default used when printing to a pipe
+func detectWidth() (int, bool) {
+	if widthOverride > 0 {
+		return widthOverride, true
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns, true
+	}
+	if isInteractive() {
+		if size, err := stty("size"); err == nil {
+			if _, cols, found := strings.Cut(size, " "); found {
+				if columns, err := strconv.Atoi(cols); err == nil && columns > 0 {
+					return columns, true
+				}
+			}
+		}
+	}
+	return defaultTerminalWidth, false
+}
+
+// displayWidth returns the number of columns text takes on the terminal. Escape codes take
+// none, emoji and wide characters take two, and emoji are not counted with plain output.
+func displayWidth(text string) int {
+	if plainOutput {
+		text = stripEmoji(text)
+	}
+	width := 0
+	inEscape := false
+	for _, r := range text {
+		switch {
+		case inEscape:
+			// Colors end with m, other sequences with another letter
+			inEscape = !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+		case r == '\x1b':
+			inEscape = true
+		default:
+			width += runeWidth(r)
+		}
+	}
+	return width
+}
+
+// runeWidth returns the number of columns of a rune
+func runeWidth(r rune) int {
+	switch {
+	case r == 0x200D, r == 0x20E3, r >= 0xFE00 && r <= 0xFE0F, r >= 0x0300 && r <= 0x036F:
+		// Joiners, variation selectors and combining marks
+		return 0
+	case isEmoji(r):
+		return 2
+	case r >= 0x1100 && r <= 0x115F, r >= 0x2E80 && r <= 0xA4CF, r >= 0xAC00 && r <= 0xD7A3,
+		r >= 0xF900 && r <= 0xFAFF, r >= 0xFF00 && r <= 0xFF60, r >= 0xFFE0 && r <= 0xFFE6:
+		// CJK, Hangul and full width forms
+		return 2
+	}
+	return 1
+}
+
+// wrapText breaks plain text into lines of at most width columns at spaces, words longer than
+// a line are split. Text is wrapped before it is colored, so escape codes are never split.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for displayWidth(word) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			head, n := "", 0
+			for _, r := range word {
+				if n+runeWidth(r) > width {
+					break
+				}
+				head += string(r)
+				n += runeWidth(r)
+			}
+			if head == "" {
+				break // A character wider than the line
+			}
+			lines = append(lines, head)
+			word = strings.TrimPrefix(word, head)
+		}
+		switch {
+		case word == "":
+		case line == "":
+			line = word
+		case displayWidth(line)+1+displayWidth(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// truncate shortens plain text to at most width columns, marking the cut with an ellipsis
+func truncate(text string, width int) string {
+	if displayWidth(text) <= width {
+		return text
+	}
+	room := width - 1 // Leaves a column for the ellipsis
+	if width <= 1 {
+		room = width
+	}
+	var cut strings.Builder
+	used := 0
+	for _, r := range text {
+		if used+runeWidth(r) > room {
+			break
+		}
+		cut.WriteRune(r)
+		used += runeWidth(r)
+	}
+	if width <= 1 {
+		return cut.String()
+	}
+	return cut.String() + "…"
+}
+
+// printLine prints like fmt.Println, stripping emoji when plain output is enabled
+func printLine(a ...interface{}) {
+	printf("%s", fmt.Sprintln(a...))
+}
+
+// stripEmoji removes emoji together with the spaces that follow them
+func stripEmoji(s string) string {
+	var b strings.Builder
+	skipSpaces := false
+	for _, r := range s {
+		if isEmoji(r) {
+			skipSpaces = true
+			continue
+		}
+		if skipSpaces && r == ' ' {
+			continue
+		}
+		skipSpaces = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isEmoji reports whether r is a pictograph or one of the modifiers used to build emoji
+func isEmoji(r rune) bool {
+	switch {
+	case r == 0x200D, r == 0x20E3, r >= 0xFE00 && r <= 0xFE0F:
+		// Zero width joiner, keycap and variation selectors
+		return true
+	case r >= 0x1F000 && r <= 0x1FAFF, r >= 0x2600 && r <= 0x27BF, r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	case r >= 0x2300 && r <= 0x23FF:
+		// Miscellaneous technical, e.g. ⏱ and ⏳
+		return true
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"unicode/utf8"
+)
+
+// HandleTaskPickCommand lets the user find a cached task and choose what to do with it
+func (c *CLI) HandleTaskPickCommand() {
+	reader := bufio.NewReader(os.Stdin)
+	task := c.pickTask(reader)
+	PrintTask(task)
+
+	actions := []string{"show", "edit", "open", "comment", "assign", "cancel"}
+	localId := strconv.Itoa(task.LocalId)
+	switch actions[selectOption(reader, "Action:", actions, 0)] {
+	case "show":
+		c.command.Args = []string{"show", localId}
+		c.HandleTaskCommand()
+	case "edit":
+		c.editTaskLabels(reader, task)
+	case "open":
+		c.command.Args = []string{"open", localId}
+		c.HandleTaskOpenCommand()
+	case "comment":
+		c.commentOnTask(reader, task)
+	case "assign":
+		c.assignTask(reader, task)
+	}
+}
+
+// pickTask shows the fuzzy finder over the cached tasks and returns the chosen one
+func (c *CLI) pickTask(reader *bufio.Reader) monday.Task {
+	cached, _, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID())
+	if !ok || len(cached) == 0 {
+		fmt.Println("❌ No cached tasks found")
+		fmt.Println("💡 Run 'tasks fetch' first")
+		os.Exit(ExitNotFound)
+	}
+	tasks := make([]monday.Task, 0, len(cached))
+	for _, task := range cached {
+		tasks = append(tasks, task)
+	}
+	monday.SortTasks(tasks, monday.DefaultSortOrder, false)
+
+	if restore, ok := enableRawMode(); ok {
+		defer restore()
+		return fuzzyPicker(reader, tasks, restore)
+	}
+
+	// Without a terminal, search first and then pick a number
+	for {
+		matches := monday.FuzzyFindTasks(tasks, prompt(reader, "Search: "))
+		if len(matches) == 0 {
+			fmt.Println("❌ No matching tasks")
+			continue
+		}
+		matches = matches[:min(len(matches), 20)]
+		options := make([]string, len(matches))
+		for i, task := range matches {
+			options[i] = pickerLine(task)
+		}
+		return matches[numberedMenu(reader, options, 0)]
+	}
+}
+
+// fuzzyPicker filters the tasks while the query is typed, arrows or ctrl-n/ctrl-p move
+// through the matches and enter picks one
+func fuzzyPicker(reader *bufio.Reader, tasks []monday.Task, restore func()) monday.Task {
+	height := min(menuHeight, len(tasks))
+	var query []byte
+	selected, top := 0, 0
+	for {
+		matches := monday.FuzzyFindTasks(tasks, string(query))
+		selected = max(0, min(selected, len(matches)-1))
+		top = scrollWindow(top, selected, height)
+
+		fmt.Printf("\r\033[2K%s %s %s\r\n", colorize(">", ColorCyan), query, colorize(fmt.Sprintf("(%d/%d)", len(matches), len(tasks)), ColorGray))
+		for i := top; i < top+height; i++ {
+			switch {
+			case i >= len(matches):
+				fmt.Print("\r\033[2K\r\n")
+			case i == selected:
+				fmt.Printf("\r\033[2K%s\r\n", colorize("❯ "+pickerLine(matches[i]), ColorCyan))
+			default:
+				fmt.Printf("\r\033[2K  %s\r\n", pickerLine(matches[i]))
+			}
+		}
+
+		key, err := reader.ReadByte()
+		if err != nil || key == 3 || key == 4 {
+			restore()
+			fmt.Println("❌ Aborted")
+			os.Exit(ExitError)
+		}
+		switch key {
+		case '\r', '\n':
+			if len(matches) > 0 {
+				fmt.Printf("\033[%dA\033[J", height+1)
+				return matches[selected]
+			}
+		case 127, 8: // Backspace
+			if len(query) > 0 {
+				_, size := utf8.DecodeLastRune(query)
+				query = query[:len(query)-size]
+			}
+		case 21: // ctrl-u clears the query
+			query = query[:0]
+		case 16: // ctrl-p
+			selected = max(0, selected-1)
+		case 14: // ctrl-n
+			selected++
+		case 27: // Escape sequence, ESC [ A is up and ESC [ B is down
+			if next, _ := reader.ReadByte(); next == '[' {
+				switch arrow, _ := reader.ReadByte(); arrow {
+				case 'A':
+					selected = max(0, selected-1)
+				case 'B':
+					selected++
+				}
+			}
+		default:
+			if key >= 32 {
+				query = append(query, key)
+				selected = 0
+			}
+		}
+		fmt.Printf("\033[%dA", height+1)
+	}
+}
+
+// pickerLine formats a task as one line of the picker
+func pickerLine(task monday.Task) string {
+	return fmt.Sprintf("%s. %s %s %s%s", padLocalId(task.LocalId), getStatusIcon(string(task.Status)),
+		getTypeIcon(string(task.Type)), task.Name, formatTags(task.Tags))
+}
+
+// editTaskLabels changes the status, priority and type of a task with menus
+func (c *CLI) editTaskLabels(reader *bufio.Reader, task monday.Task) {
+	client := c.newClient()
+	boardID := c.config.GetBoardID()
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Error fetching board", err)
+	}
+	statusColumn, priorityColumn, typeColumn := monday.FindLabelColumns(board)
+	status := selectLabel(reader, "Status:", statusColumn, "(unchanged)")
+	priority := selectLabel(reader, "Priority:", priorityColumn, "(unchanged)")
+	taskType := selectLabel(reader, "Type:", typeColumn, "(unchanged)")
+	if status == "" && priority == "" && taskType == "" {
+		fmt.Println("Nothing to change")
+		return
+	}
+
+	updatedTask, err := client.UpdateTask(boardID, c.config.GetUserEmail(), task, status, priority, taskType)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error updating task", err)
+	}
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	fmt.Printf("✅ Task %d updated successfully\n", task.LocalId)
+	PrintTask(*updatedTask)
+}
+
+// commentOnTask posts an update on a task
+func (c *CLI) commentOnTask(reader *bufio.Reader, task monday.Task) {
+	body := prompt(reader, "Comment: ")
+	if body == "" {
+		fmt.Println("Nothing to post")
+		return
+	}
+	_, err := c.newClient().CreateUpdate(task.ID, body)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error posting comment", err)
+	}
+	c.recordHistory(monday.HistoryComment, task, task, body)
+	fmt.Printf("✅ Commented on task %d\n", task.LocalId)
+}
+
+// assignTask changes the owner of a task to a cached board user
+func (c *CLI) assignTask(reader *bufio.Reader, task monday.Task) {
+	user := c.selectAssignee(reader)
+	updatedTask, err := c.newClient().AssignTask(c.config.GetBoardID(), task, user.ID)
+	if errors.Is(err, monday.ErrDryRun) {
+		return
+	}
+	if err != nil {
+		exitWithError("Error assigning task", err)
+	}
+	c.saveAssignedTask(task, updatedTask)
+	if user.ID == "" {
+		fmt.Printf("✅ Task %d unassigned\n", task.LocalId)
+	} else {
+		fmt.Printf("✅ Task %d assigned to %s\n", task.LocalId, user.Name)
+	}
+	PrintTask(*updatedTask)
+}
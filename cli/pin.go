@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+)
+
+// HandleTaskPinCommand pins a task so it is listed at the top of 'tasks list' whatever the
+// filters. Pins are kept in the local cache only.
+func (c *CLI) HandleTaskPinCommand() {
+	task := c.cachedTaskArg("task pin <task-index>")
+	pinned, err := monday.NewDataStore().PinTask(c.config.GetBoardID(), task.ID)
+	if err != nil {
+		exitWithError("Error pinning task", err)
+	}
+	if !pinned {
+		fmt.Printf("📌 Task %d is already pinned\n", task.LocalId)
+		return
+	}
+	fmt.Printf("📌 Pinned task %d: %s\n", task.LocalId, task.Name)
+}
+
+// HandleTaskUnpinCommand removes a task from the pinned tasks
+func (c *CLI) HandleTaskUnpinCommand() {
+	task := c.cachedTaskArg("task unpin <task-index>")
+	unpinned, err := monday.NewDataStore().UnpinTask(c.config.GetBoardID(), task.ID)
+	if err != nil {
+		exitWithError("Error unpinning task", err)
+	}
+	if !unpinned {
+		fmt.Printf("Task %d is not pinned\n", task.LocalId)
+		return
+	}
+	fmt.Printf("✅ Unpinned task %d: %s\n", task.LocalId, task.Name)
+}
+
+// printPinnedTasks prints the pinned tasks of the board above the task list, nothing when
+// no task is pinned
+func (c *CLI) printPinnedTasks() {
+	pinned := monday.NewDataStore().GetPinnedTasks(c.config.GetBoardID())
+	if len(pinned) == 0 || c.command.HasSwitch("summary") {
+		return
+	}
+	printf("%s\n", colorize("📌 Pinned", ColorYellow))
+	for _, task := range pinned {
+		printTaskLine(getStatusIcon(string(task.Status))+" ", task)
+	}
+	printf("\n")
+}
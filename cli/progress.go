@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"strings"
+	"time"
+)
+
+// progressBarWidth is the number of cells of the bar
+const progressBarWidth = 24
+
+// progressBar shows a long operation on a line that is redrawn in place: a bar with the done and
+// total count when the total is known, the page and an estimate of the time left. It prints
+// nothing with --quiet or when stdout is not a terminal, so pipes and logs stay clean.
+type progressBar struct {
+	label   string
+	unit    string
+	total   int // 0 when unknown
+	start   time.Time
+	first   int // Count of the first update, the rate is measured from there
+	updated bool
+	enabled bool
+}
+
+// newProgressBar starts a progress bar, e.g. newProgressBar("📥 Fetching tasks", "items", 0)
+func newProgressBar(label, unit string, total int) *progressBar {
+	return &progressBar{
+		label:   label,
+		unit:    unit,
+		total:   total,
+		start:   time.Now(),
+		enabled: !quietOutput && isTerminalOutput(),
+	}
+}
+
+// update redraws the bar with the done count and a detail such as "page 2 of ~5"
+func (p *progressBar) update(done int, detail string) {
+	if !p.enabled {
+		return
+	}
+	if !p.updated {
+		// Callers often start the bar after the first page, which must not count into the rate
+		p.start, p.first, p.updated = time.Now(), done, true
+	}
+	parts := []string{fmt.Sprintf("%d %s", done, p.unit)}
+	if p.total > 0 {
+		parts[0] = fmt.Sprintf("%d/%d %s", done, p.total, p.unit)
+	}
+	if detail != "" {
+		parts = append(parts, detail)
+	}
+	if eta := p.eta(done).Round(time.Second); eta > 0 {
+		parts = append(parts, fmt.Sprintf("%s left", eta))
+	}
+	line := p.label
+	if bar := p.bar(done); bar != "" {
+		line += " " + bar
+	}
+	printf("\r\033[2K%s %s", line, strings.Join(parts, " · "))
+}
+
+// finish ends the line of the bar, later output starts on a new line
+func (p *progressBar) finish() {
+	if p.enabled {
+		fmt.Println()
+	}
+}
+
+// bar draws the filled share of the total, or nothing when the total is unknown
+func (p *progressBar) bar(done int) string {
+	if p.total <= 0 {
+		return ""
+	}
+	return drawBar(float64(done)/float64(p.total), ColorGreen)
+}
+
+// drawBar draws a bar of progressBarWidth cells filled by share, from 0 to 1
+func drawBar(share float64, color string) string {
+	filled := max(min(int(share*progressBarWidth), progressBarWidth), 0)
+	var bar string
+	if filled > 0 {
+		bar = colorize(strings.Repeat("█", filled), color)
+	}
+	if filled < progressBarWidth {
+		bar += colorize(strings.Repeat("░", progressBarWidth-filled), ColorGray)
+	}
+	return bar
+}
+
+// eta estimates the time left from the rate so far, 0 when it cannot be estimated yet
+func (p *progressBar) eta(done int) time.Duration {
+	if p.total <= 0 || done <= p.first || done >= p.total {
+		return 0
+	}
+	elapsed := time.Since(p.start)
+	return time.Duration(float64(elapsed) / float64(done-p.first) * float64(p.total-done))
+}
+
+// activeProgress is the bar of the paginated fetch or batch currently reporting to the client
+var activeProgress *progressBar
+
+// endProgress ends the line of an interrupted progress bar, e.g. before printing an error
+func endProgress() {
+	if activeProgress != nil {
+		activeProgress.finish()
+		activeProgress = nil
+	}
+}
+
+// isBatch reports whether progress comes from creating or changing items in batches rather
+// than a fetch
+func isBatch(progress monday.PageProgress) bool {
+	return strings.HasPrefix(progress.Operation, "Create") || strings.HasPrefix(progress.Operation, "Change")
+}
+
+// printPageProgress is the progress callback of the API clients. Operations of a single page
+// print nothing, longer ones get a progress bar for their pages.
+func printPageProgress(progress monday.PageProgress) {
+	if progress.Page == 1 {
+		activeProgress = nil
+		if progress.Done {
+			return
+		}
+		label, unit := "📥 Fetching", "items"
+		switch {
+		case strings.HasPrefix(progress.Operation, "Create"):
+			label, unit = "🚀 Creating", "tasks"
+		case strings.HasPrefix(progress.Operation, "Change"):
+			label, unit = "✏️  Updating", "tasks"
+		}
+		activeProgress = newProgressBar(label, unit, progress.Total)
+	}
+	if activeProgress == nil {
+		return
+	}
+	// Fetches only know the item count of the board, so their number of pages is an estimate
+	detail := fmt.Sprintf("page %d", progress.Page)
+	if progress.Total > 0 && progress.PageSize > 0 {
+		pages := max((progress.Total+progress.PageSize-1)/progress.PageSize, progress.Page)
+		detail = fmt.Sprintf("page %d of ~%d", progress.Page, pages)
+		if isBatch(progress) {
+			detail = fmt.Sprintf("batch %d of %d", progress.Page, pages)
+		}
+	}
+	activeProgress.update(progress.Items, detail)
+	if progress.Done {
+		endProgress()
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+const quickUsage = `quick "<name> [!priority] [#type] [@assignee] [^sprint] [due:date]"`
+
+// HandleQuickCommand creates a task from one line with inline tokens for the priority, type,
+// assignee, sprint and due date. Like 'task create' the task is assigned to you unless it names
+// someone else or @none.
+func (c *CLI) HandleQuickCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpQuickCommand()
+		os.Exit(ExitValidation)
+	}
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+	sprintNames := make([]string, 0, len(sprints))
+	for _, sprint := range sprints {
+		sprintNames = append(sprintNames, sprint.Name)
+	}
+
+	quick, err := monday.ParseQuickTask(strings.Join(c.command.Args, " "), sprintNames, time.Now())
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		fmt.Println("Usage: monday-cli " + quickUsage)
+		os.Exit(ExitValidation)
+	}
+
+	details := monday.TaskDetails{
+		Priority: labelAlias(quick.Priority, getPriorityValue),
+		Type:     labelAlias(quick.Type, getTypeValue),
+		DueDate:  quick.DueDate,
+	}
+	task := monday.Task{
+		Name:     quick.Name,
+		Priority: monday.Priority(details.Priority),
+		Type:     monday.Type(details.Type),
+		DueDate:  quick.DueDate,
+	}
+	if quick.Sprint != "" {
+		sprint := findSprint(sprints, quick.Sprint)
+		details.SprintID = sprint.ID
+		task.Sprint = sprint.Name
+	}
+
+	client := c.newClient()
+	switch strings.ToLower(quick.Assignee) {
+	case "none":
+	case "":
+		details.AssigneeID = c.config.GetUserInfo().ID
+		task.UserName = c.config.GetUserInfo().Name
+	default:
+		assignee := c.resolveUser(client, quick.Assignee)
+		details.AssigneeID = assignee.ID
+		task.UserName = assignee.Name
+	}
+
+	boardID := c.config.GetBoardID()
+	c.confirmNotDuplicate(bufio.NewReader(os.Stdin), client, boardID, quick.Name)
+	created, err := client.CreateTaskWithDetails(boardID, quick.Name, details)
+	if c.queueIfOffline(err, monday.PendingOperation{Kind: monday.OperationCreate, TaskName: quick.Name, Details: details}) {
+		return
+	}
+	if errors.Is(err, monday.ErrDryRun) {
+		c.printPredictedCreate(task)
+		return
+	}
+	if err != nil {
+		exitWithError("Error creating task", err)
+	}
+	localId := c.cacheCreatedTask(boardID, created)
+	c.recordHistory(monday.HistoryCreate, monday.Task{}, *created, "")
+	fmt.Printf("✅ Task %s created with ID %d\n", created.Name, localId)
+	PrintTask(*created)
+}
+
+// labelAlias maps a short label like h or b to the label of the alias table, other labels
+// are kept as written for the board to validate
+func labelAlias(label string, alias func(string) string) string {
+	if value := alias(strings.ToLower(label)); value != "" {
+		return value
+	}
+	return label
+}
+
+// findSprint finds a cached sprint by name ignoring case, or by its number like 12 for
+// "Sprint 12". It exits listing the sprints when none matches.
+func findSprint(sprints []monday.Sprint, name string) monday.Sprint {
+	for _, sprint := range sprints {
+		if strings.EqualFold(sprint.Name, name) {
+			return sprint
+		}
+	}
+	for _, sprint := range sprints {
+		if strings.HasSuffix(strings.ToLower(sprint.Name), " "+strings.ToLower(name)) {
+			return sprint
+		}
+	}
+	fmt.Printf("❌ Sprint %q not found\n", name)
+	if len(sprints) == 0 {
+		fmt.Println("💡 Run 'tasks fetch' with a sprint board configured to cache the sprints")
+	} else {
+		fmt.Println("Sprints:")
+		for _, sprint := range sprints {
+			fmt.Printf("  %s\n", sprint.Name)
+		}
+	}
+	os.Exit(ExitNotFound)
+	return monday.Sprint{}
+}
+
+// HelpQuickCommand explains the tokens of quick
+func (c *CLI) HelpQuickCommand() {
+	fmt.Println("Usage: monday-cli " + quickUsage)
+	fmt.Println("")
+	fmt.Println("Tokens:")
+	fmt.Println("  !high, !h           Priority")
+	fmt.Println("  #bug, #b            Type")
+	fmt.Println("  @alice, @me, @none  Assignee, you when not given")
+	fmt.Println("  ^Sprint 12, ^12     Sprint, by name or number")
+	fmt.Println("  due:friday          Due date: YYYY-MM-DD, today, tomorrow, a weekday, 3d or 2w")
+	fmt.Println("  \\#123               A word starting with a token character kept in the name")
+	fmt.Println("")
+	fmt.Println("Example: monday-cli quick \"Fix login crash !high #bug @alice ^Sprint 12 due:friday\"")
+}
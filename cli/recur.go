@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"time"
+)
+
+// HandleRecurCommand handles recurring tasks, created by recur run from cron or a systemd timer
+func (c *CLI) HandleRecurCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpRecurCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleRecurListCommand()
+	case "add", "a":
+		c.HandleRecurAddCommand()
+	case "remove", "rm":
+		c.HandleRecurRemoveCommand()
+	case "run":
+		c.HandleRecurRunCommand()
+	default:
+		c.HelpRecurCommand()
+	}
+}
+
+func (c *CLI) HelpRecurCommand() {
+	fmt.Println("Recur Commands:")
+	fmt.Println("  recur list (ls)                Show recurring tasks with their next and last run")
+	fmt.Println("  recur add (a) <name> -schedule <cron> -task <task-name> [flags]")
+	fmt.Println("                                 Create a task on a schedule, e.g. -schedule \"0 9 * * 1\" for Mondays at 9:00")
+	fmt.Println("  recur remove (rm) <name>       Stop creating a recurring task")
+	fmt.Println("  recur run [-name <name>]       Create the tasks that are due, run it from cron or a systemd timer")
+	fmt.Println("")
+	fmt.Println("Add Flags:")
+	fmt.Println("  -schedule <cron>         Minute hour day month weekday, or @hourly, @daily, @weekdays, @weekly, @monthly")
+	fmt.Println("  -task <task-name>        Name of the task, {date} and {week} are replaced with the date of the run")
+	fmt.Println("  -status, -s <status>     Status of the task (done/d, in progress/p, stuck/s, etc.)")
+	fmt.Println("  -priority, -p <priority> Priority of the task (critical/c, high/h, medium/m, low/l)")
+	fmt.Println("  -type, -t <type>         Type of the task (bug/b, feature/f, test/t, security/s, quality/q)")
+	fmt.Println("  -due <days>              Due date this many days after the run")
+	fmt.Println("  -assignee <user|none>    Assign the task to someone else than you, or nobody")
+}
+
+// HandleRecurListCommand lists the recurring tasks with their next occurrence and last run
+func (c *CLI) HandleRecurListCommand() {
+	names := c.config.RecurringTaskNames()
+	if len(names) == 0 {
+		fmt.Println("No recurring tasks")
+		fmt.Println("💡 Add one with 'recur add <name> -schedule <cron> -task <task-name>'")
+		return
+	}
+	runs, err := monday.NewDataStore().GetRecurRuns()
+	if err != nil {
+		monday.Logger().Warn("failed to load recurring task runs", "error", err)
+	}
+
+	printf("🔁 %d recurring task(s):\n", len(names))
+	for _, name := range names {
+		recurring := c.config.Recurring[name]
+		printf("  %s %s %s\n", colorize(name, ColorCyan), colorize(recurring.Schedule, ColorGray), recurring.Template.Name)
+		if schedule, err := monday.ParseSchedule(recurring.Schedule); err == nil {
+			if next := schedule.Next(time.Now()); !next.IsZero() {
+				printf("      next: %s\n", next.Format("Mon 2006-01-02 15:04"))
+			}
+		}
+		if run, ok := runs[name]; ok {
+			printf("      last: %s (task %s)\n", run.Occurrence.Local().Format("Mon 2006-01-02 15:04"), run.TaskID)
+		}
+		if recurring.BoardID != c.config.GetBoardID() {
+			printf("      board: %s\n", recurring.BoardID)
+		}
+	}
+}
+
+// HandleRecurAddCommand adds a recurring task for the current board, replacing one with the
+// same name
+func (c *CLI) HandleRecurAddCommand() {
+	usage := "Usage: monday-cli recur add <name> -schedule <cron> -task <task-name> [-s <status>] [-p <priority>] [-t <type>] [-due <days>] [-assignee <user|none>]"
+	if len(c.command.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+
+	recurring := monday.RecurringTask{
+		BoardID: c.config.GetBoardID(),
+		Since:   time.Now(),
+	}
+	template := &recurring.Template
+	assignee := "me"
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-schedule", "--schedule":
+			recurring.Schedule = flag.Value
+		case "-task", "--task":
+			template.Name = flag.Value
+		case "-status", "-s":
+			if template.Status = getStatusValue(flag.Value); template.Status == "" {
+				fmt.Printf("❌ Invalid status: %s\n", flag.Value)
+				fmt.Println("Valid status values: done(d), in progress(p), stuck(s), waiting review(r), ready for testing(t), removed(rm)")
+				os.Exit(ExitValidation)
+			}
+		case "-priority", "-p":
+			if template.Priority = getPriorityValue(flag.Value); template.Priority == "" {
+				fmt.Printf("❌ Invalid priority: %s\n", flag.Value)
+				fmt.Println("Valid priority values: critical(c), high(h), medium(m), low(l)")
+				os.Exit(ExitValidation)
+			}
+		case "-type", "-t":
+			if template.Type = getTypeValue(flag.Value); template.Type == "" {
+				fmt.Printf("❌ Invalid type: %s\n", flag.Value)
+				fmt.Println("Valid type values: bug(b), feature(f), test(t), security(s), quality(q)")
+				os.Exit(ExitValidation)
+			}
+		case "-due", "--due":
+			days, err := strconv.Atoi(flag.Value)
+			if err != nil || days < 0 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			template.DueDays = days
+		case "-assignee", "--assignee":
+			assignee = flag.Value
+		}
+	}
+	if recurring.Schedule == "" || template.Name == "" {
+		fmt.Println(usage)
+		os.Exit(ExitValidation)
+	}
+	schedule, err := monday.ParseSchedule(recurring.Schedule)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	next := schedule.Next(recurring.Since)
+	if next.IsZero() {
+		fmt.Printf("❌ Schedule %q is never due\n", recurring.Schedule)
+		os.Exit(ExitValidation)
+	}
+	switch assignee {
+	case "none":
+	case "me":
+		template.AssigneeID = c.config.GetUserInfo().ID
+	default:
+		template.AssigneeID = c.resolveUser(c.newClient(), assignee).ID
+	}
+
+	c.config.SetRecurringTask(name, recurring)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Recurring task %s added\n", name)
+	fmt.Printf("   First task: %s on %s\n", template.TaskName(next), next.Format("Mon 2006-01-02 15:04"))
+	fmt.Println("💡 Schedule 'monday-cli recur run' with cron or a systemd timer to create the tasks")
+}
+
+// HandleRecurRemoveCommand removes a recurring task and its last run
+func (c *CLI) HandleRecurRemoveCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli recur remove <name>")
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+	if err := c.config.DeleteRecurringTask(name); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitNotFound)
+	}
+	c.config.Save(monday.GetConfigPath())
+	if err := monday.NewDataStore().RemoveRecurRun(name); err != nil {
+		monday.Logger().Warn("failed to remove recurring task run", "error", err)
+	}
+	fmt.Printf("✅ Recurring task %s removed\n", name)
+}
+
+// HandleRecurRunCommand creates a task for every recurring task that is due. A task is due once
+// per occurrence of its schedule, the occurrence is recorded right after the task is created
+// so running it again does not create it twice.
+func (c *CLI) HandleRecurRunCommand() {
+	names := c.config.RecurringTaskNames()
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-name", "--name":
+			if _, ok := c.config.Recurring[flag.Value]; !ok {
+				fmt.Printf("❌ Recurring task %s not found\n", flag.Value)
+				os.Exit(ExitNotFound)
+			}
+			names = []string{flag.Value}
+		}
+	}
+
+	dataStore := monday.NewDataStore()
+	// Held until the runs are recorded, a cron run overlapping a slow one waits instead of
+	// creating the same tasks
+	unlock, err := dataStore.LockRecurRuns()
+	if err != nil {
+		exitWithError("Error locking recurring task runs", err)
+	}
+	defer unlock()
+	runs, err := dataStore.GetRecurRuns()
+	if err != nil {
+		// Without the last runs every task would look due and be created again
+		exitWithError("Error loading recurring task runs", err)
+	}
+
+	now := time.Now()
+	var client *monday.Client
+	created, failed := 0, 0
+	for _, name := range names {
+		recurring := c.config.Recurring[name]
+		schedule, err := monday.ParseSchedule(recurring.Schedule)
+		if err != nil {
+			failed++
+			printf("❌ %s: %v\n", name, err)
+			continue
+		}
+		last := recurring.Since
+		if run, ok := runs[name]; ok && run.Occurrence.After(last) {
+			last = run.Occurrence
+		}
+		occurrence, missed := schedule.Due(last, now)
+		if occurrence.IsZero() {
+			continue
+		}
+
+		taskName := recurring.Template.TaskName(occurrence)
+		if client == nil {
+			client = c.newClient()
+		}
+		task, err := client.CreateTaskWithDetails(recurring.BoardID, taskName, recurring.Template.Details(occurrence))
+		if errors.Is(err, monday.ErrDryRun) {
+			printf("📝 %s: would create %s\n", name, taskName)
+			continue
+		}
+		if err != nil {
+			// Not queued while offline, the next run creates it once the API is reachable
+			failed++
+			PrintError(fmt.Sprintf("Recurring task %s not created", name), err)
+			continue
+		}
+		if err := dataStore.StoreRecurRun(name, monday.RecurRun{Occurrence: occurrence, TaskID: task.ID, RanAt: now}); err != nil {
+			exitWithError(fmt.Sprintf("Task %s was created but its run not recorded, it will be created again", task.ID), err)
+		}
+		created++
+
+		localId := 0
+		if recurring.BoardID == c.config.GetBoardID() {
+			localId = c.cacheCreatedTask(recurring.BoardID, task)
+			c.recordHistory(monday.HistoryCreate, monday.Task{}, *task, "")
+		}
+		printf("✅ %s: created %s", name, task.Name)
+		if localId > 0 {
+			printf(" with ID %d", localId)
+		}
+		printf("\n")
+		if missed > 0 {
+			progressf("   %d earlier run(s) were missed and skipped\n", missed)
+		}
+	}
+
+	if created == 0 && failed == 0 {
+		progressf("✅ Nothing due\n")
+	}
+	if failed > 0 {
+		os.Exit(ExitError)
+	}
+}
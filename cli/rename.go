@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+const taskRenameUsage = `task rename <task-index> "<new name>"`
+
+// HandleTaskRenameCommand changes the name of a task, the words after the index form the name
+func (c *CLI) HandleTaskRenameCommand() {
+	task := c.cachedTaskArg(taskRenameUsage)
+	name := strings.TrimSpace(strings.Join(c.command.Args[2:], " "))
+	if name == "" {
+		fmt.Println("Usage: monday-cli " + taskRenameUsage)
+		os.Exit(ExitValidation)
+	}
+	if name == task.Name {
+		fmt.Printf("📭 Task %d is already named %q\n", task.LocalId, name)
+		return
+	}
+
+	boardID := c.config.GetBoardID()
+	fmt.Printf("Renaming task %d\n", task.LocalId)
+	fmt.Printf("  name: %s -> %s\n", colorize(task.Name, ColorRed), colorize(name, ColorGreen))
+	updatedTask, err := c.newClient().RenameTask(boardID, task, name)
+	if errors.Is(err, monday.ErrDryRun) {
+		predicted := task
+		predicted.Name = name
+		fmt.Println("📝 Predicted cache change:")
+		PrintTaskChanges(monday.DiffTasks(map[string]monday.Task{task.ID: task}, map[string]monday.Task{task.ID: predicted}), "")
+		return
+	}
+	if err != nil {
+		exitWithError("Error renaming task", err)
+	}
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	fmt.Printf("✅ Task %d renamed\n", task.LocalId)
+	PrintTask(*updatedTask)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"time"
+)
+
+// HandleReportCommand handles reports rendered from the cached tasks
+func (c *CLI) HandleReportCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpReportCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "weekly", "w":
+		c.HandleWeeklyReportCommand()
+	case "slack":
+		c.HandleSlackReportCommand()
+	default:
+		c.HelpReportCommand()
+	}
+}
+
+func (c *CLI) HelpReportCommand() {
+	fmt.Println("Report Commands:")
+	fmt.Println("  report weekly (w) [-sprint <name>] [-out <file.md|file.html>]  Weekly digest of the current sprint")
+	fmt.Println("  " + slackReportUsage + "  Post the filtered tasks to a Slack incoming webhook")
+}
+
+// HandleWeeklyReportCommand renders the weekly sprint digest as markdown or HTML.
+// Without -out the markdown is printed to stdout so it can be piped or pasted.
+func (c *CLI) HandleWeeklyReportCommand() {
+	outPath := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-out", "--out", "-o":
+			outPath = flag.Value
+		}
+	}
+	format, err := monday.ReportFormatFromPath(outPath)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+
+	sprintName := c.reportSprintName()
+	analytics := monday.NewAnalyticsService(nil, monday.NewDataStore())
+	digest, err := analytics.WeeklyDigest(c.config.GetBoardID(), sprintName, time.Now())
+	if err != nil {
+		c.exitWithoutSprintTasks(err, sprintName)
+	}
+	digest.Board = c.boardName(c.config.GetBoardID())
+
+	var report bytes.Buffer
+	if err := monday.RenderReport(&report, "weekly", format, digest); err != nil {
+		exitWithError("Failed to render report", err)
+	}
+	if outPath == "" {
+		fmt.Print(report.String())
+		return
+	}
+	if err := os.WriteFile(outPath, report.Bytes(), 0644); err != nil {
+		exitWithError("Failed to write report", err)
+	}
+	printf("✅ Weekly digest for %s written to %s\n", digest.Sprint, outPath)
+	printf("📊 %d completed, %d carried over, %d new bugs\n", len(digest.Completed), len(digest.CarriedOver), len(digest.NewBugs))
+}
+
+// boardName returns the configured name of a board, or an empty string when it has none
+func (c *CLI) boardName(boardID string) string {
+	for _, board := range c.config.GetBoards() {
+		if board.ID == boardID && board.Name != boardID {
+			return board.Name
+		}
+	}
+	return ""
+}
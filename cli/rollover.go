@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const rolloverUsage = "tasks sprint rollover [-from <sprint>] [-to <sprint>] [-batch <n>]"
+
+// HandleSprintRolloverCommand moves the unfinished tasks of the current sprint to the next one.
+// The tasks are listed as a checklist to leave some behind, the chosen ones are linked to the
+// next sprint a batch per request.
+func (c *CLI) HandleSprintRolloverCommand() {
+	var from, to string
+	batchSize := 10
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-from", "--from":
+			from = flag.Value
+		case "-to", "--to":
+			to = flag.Value
+		case "-batch", "--batch", "-b":
+			n, err := strconv.Atoi(flag.Value)
+			if err != nil || n < 1 || n > 50 {
+				fmt.Printf("❌ Invalid batch size: %s (use 1 to 50)\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			batchSize = n
+		}
+	}
+
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+	current, ok := c.cachedCurrentSprint()
+	if from != "" {
+		current, ok = findSprint(sprints, from), true
+	}
+	if !ok {
+		fmt.Println("❌ No current sprint")
+		fmt.Println("💡 Use -from <sprint>, or run 'tasks fetch' with a sprint board configured")
+		os.Exit(ExitNotFound)
+	}
+	next, ok := monday.NextSprint(sprints, current, time.Now())
+	if to != "" {
+		next, ok = findSprint(sprints, to), true
+	}
+	if !ok {
+		fmt.Printf("❌ No sprint after %s\n", current.Name)
+		fmt.Println("💡 Use -to <sprint> to pick the sprint to move the tasks to")
+		os.Exit(ExitNotFound)
+	}
+	if next.ID == current.ID {
+		fmt.Printf("❌ The tasks are in %s already\n", current.Name)
+		os.Exit(ExitValidation)
+	}
+
+	boardID := c.config.GetBoardID()
+	dataStore := monday.NewDataStore()
+	cached, _, _ := dataStore.GetCachedTasks(boardID)
+	tasks := make([]monday.Task, 0, len(cached))
+	for _, task := range cached {
+		tasks = append(tasks, task)
+	}
+	order, reverse := c.sortOrder()
+	unfinished := monday.SortTasks(monday.UnfinishedTasks(tasks, current.Name), order, reverse)
+	if len(unfinished) == 0 {
+		fmt.Printf("🎉 No unfinished tasks in %s\n", current.Name)
+		return
+	}
+
+	options := make([]string, 0, len(unfinished))
+	for _, task := range unfinished {
+		status := string(task.Status)
+		if status == "" {
+			status = "None"
+		}
+		options = append(options, fmt.Sprintf("%d. %s %s (%s)", task.LocalId, getTypeIcon(string(task.Type)), truncate(task.Name, 60), status))
+	}
+	checked := selectOptions(bufio.NewReader(os.Stdin), fmt.Sprintf("🔁 %d unfinished tasks in %s, choose the ones to move to %s:", len(unfinished), current.Name, next.Name), options)
+	var selected []monday.Task
+	var ids []string
+	for i, task := range unfinished {
+		if checked[i] {
+			selected = append(selected, task)
+			ids = append(ids, task.ID)
+		}
+	}
+	if len(selected) == 0 {
+		fmt.Println("No tasks moved")
+		return
+	}
+
+	movedIDs, err := c.newClient().MoveTasksToSprint(boardID, ids, next.ID, batchSize)
+	if errors.Is(err, monday.ErrDryRun) {
+		before, after := make(map[string]monday.Task), make(map[string]monday.Task)
+		for _, task := range selected {
+			before[task.ID] = task
+			task.Sprint = next.Name
+			after[task.ID] = task
+		}
+		fmt.Println("📝 Predicted cache change:")
+		PrintTaskChanges(monday.DiffTasks(before, after), "")
+		return
+	}
+
+	var moved, movedTaskIDs []string
+	points := 0.0
+	for _, task := range selected[:len(movedIDs)] {
+		updated := task
+		updated.Sprint = next.Name
+		dataStore.UpdateCachedTask(boardID, task.ID, updated)
+		c.recordHistory(monday.HistoryUpdate, task, updated, "")
+		moved = append(moved, strconv.Itoa(task.LocalId))
+		movedTaskIDs = append(movedTaskIDs, task.ID)
+		points += task.Estimate
+	}
+	if len(movedTaskIDs) > 0 {
+		c.runHooks(monday.HookPayload{Event: monday.HookSprintRolledOver, BoardID: boardID, FromSprint: current.Name, ToSprint: next.Name, TaskIDs: movedTaskIDs})
+	}
+	if err != nil {
+		if len(moved) > 0 {
+			fmt.Printf("Moved before the error: %s\n", strings.Join(moved, ", "))
+		}
+		exitWithError(fmt.Sprintf("Moved %d of %d tasks", len(moved), len(selected)), err)
+	}
+	summary := fmt.Sprintf("✅ Moved %d tasks from %s to %s: %s", len(moved), current.Name, next.Name, strings.Join(moved, ", "))
+	if points > 0 {
+		summary += fmt.Sprintf(" (%s pts)", monday.FormatPoints(points))
+	}
+	fmt.Println(summary)
+	if left := len(unfinished) - len(selected); left > 0 {
+		fmt.Printf("%d unfinished tasks stay in %s\n", left, current.Name)
+	}
+}
@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"monday-cli/monday"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// maxWebhookBody limits the size of a webhook request, events are a few hundred bytes
+const maxWebhookBody = 64 << 10
+
+// webhookServer keeps the local cache up to date from Monday.com webhook events
+type webhookServer struct {
+	client    *monday.Client
+	dataStore *monday.DataStore
+	boardID   string
+	token     string // Last path segment of the webhook URL, requests without it are rejected
+	mu        sync.Mutex
+}
+
+// HandleServeCommand runs an HTTP server receiving board webhooks and updating the cache
+func (c *CLI) HandleServeCommand() {
+	port := "8080"
+	publicURL := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-port", "--port", "-p":
+			port = flag.Value
+		case "-url", "--url", "-u":
+			publicURL = flag.Value
+		}
+	}
+
+	if publicURL == "" {
+		c.HelpServeCommand()
+		os.Exit(ExitValidation)
+	}
+
+	boardID := c.config.GetBoardID()
+	if boardID == "" {
+		fmt.Println("❌ No board ID configured")
+		fmt.Println("💡 Run 'config set-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+
+	// Only Monday.com knows the URL with the token, anyone else reaching the port is rejected
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		exitWithError("Error creating webhook token", err)
+	}
+	server := &webhookServer{
+		client:    c.newClient(),
+		dataStore: monday.NewDataStore(),
+		boardID:   boardID,
+		token:     hex.EncodeToString(buf),
+	}
+	webhookURL := strings.TrimSuffix(publicURL, "/") + "/" + server.token
+
+	// Listen before subscribing, Monday.com sends a challenge as soon as a webhook is created
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		fmt.Printf("❌ Error listening on port %s: %v\n", port, err)
+		os.Exit(ExitError)
+	}
+	httpServer := &http.Server{Handler: http.HandlerFunc(server.handleWebhook), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("❌ Server error: %v\n", err)
+			os.Exit(ExitError)
+		}
+	}()
+	fmt.Printf("🛰️  Listening on port %s\n", port)
+
+	var webhooks []*monday.Webhook
+	for _, event := range monday.BoardWebhookEvents {
+		webhook, err := server.client.CreateWebhook(boardID, webhookURL, event)
+		if err != nil {
+			fmt.Printf("⚠️  Warning: Could not subscribe to %s: %v\n", event, err)
+			continue
+		}
+		webhooks = append(webhooks, webhook)
+		fmt.Printf("🔔 Subscribed to %s (webhook %s)\n", event, webhook.ID)
+	}
+	if len(webhooks) == 0 {
+		fmt.Println("❌ No webhooks could be created, is the URL reachable from Monday.com?")
+		httpServer.Close()
+		os.Exit(ExitError)
+	}
+
+	fmt.Printf("👀 Keeping board %s up to date (Ctrl+C to stop)\n", boardID)
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+
+	fmt.Println("")
+	fmt.Println("🧹 Removing webhooks...")
+	for _, webhook := range webhooks {
+		if err := server.client.DeleteWebhook(webhook.ID); err != nil {
+			fmt.Printf("⚠️  Warning: %v\n", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	httpServer.Shutdown(ctx)
+	fmt.Println("✅ Server stopped")
+}
+
+func (c *CLI) HelpServeCommand() {
+	fmt.Println("Serve Command:")
+	fmt.Println("  serve -url <public-url> [-port <port>]")
+	fmt.Println("    Runs a webhook listener that keeps the local cache up to date")
+	fmt.Println("    -url, -u <public-url>  URL where Monday.com can reach this server, a random token is added to its path")
+	fmt.Println("    -port, -p <port>       Port to listen on (default 8080)")
+}
+
+// handleWebhook answers the webhook challenge and applies board events to the cache. Requests
+// without the token of the webhook URL and events of other boards are ignored.
+func (s *webhookServer) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(path.Base(r.URL.Path)), []byte(s.token)) != 1 {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var payload monday.WebhookPayload
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBody)).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if payload.Challenge != "" {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"challenge": payload.Challenge})
+		return
+	}
+
+	if strconv.FormatInt(payload.Event.BoardID, 10) != s.boardID {
+		http.Error(w, "unknown board", http.StatusBadRequest)
+		return
+	}
+	s.applyEvent(payload.Event)
+	w.WriteHeader(http.StatusOK)
+}
+
+// applyEvent updates the cached task an event refers to
+func (s *webhookServer) applyEvent(event monday.WebhookEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	timestamp := time.Now().Format("15:04:05")
+	itemID := event.ItemID()
+	if itemID == "" {
+		return
+	}
+
+	// Other commands may have written the cache in the meantime
+	if err := s.dataStore.Load(); err != nil {
+		fmt.Printf("%s ⚠️  Warning: Could not load cache: %v\n", timestamp, err)
+	}
+
+	if event.IsRemoval() {
+		if s.dataStore.RemoveCachedTask(s.boardID, itemID) {
+			fmt.Printf("%s %s %s removed\n", timestamp, colorize("-", ColorRed), itemID)
+		}
+		return
+	}
+
+	task, err := s.client.GetTaskByID(itemID)
+	if err != nil {
+		fmt.Printf("%s ⚠️  Warning: Could not fetch task %s: %v\n", timestamp, itemID, err)
+		return
+	}
+	localId, err := s.dataStore.StoreTaskRequest(s.boardID, *task)
+	if err != nil {
+		fmt.Printf("%s ⚠️  Warning: Could not store task %s: %v\n", timestamp, itemID, err)
+		return
+	}
+	fmt.Printf("%s %s %s. %s (%s)\n", timestamp, colorize("~", ColorYellow), padLocalId(localId), task.Name, event.Type)
+}
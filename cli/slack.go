@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+const slackReportUsage = "report slack [-webhook <url>] [-view <name>] [-template <name>] [filter flags] [--dry-run]"
+
+// slackPostTimeout bounds posting a report, Slack answers within a few seconds
+const slackPostTimeout = 30 * time.Second
+
+// HandleSlackReportCommand posts the filtered cached tasks to a Slack incoming webhook as a
+// Block Kit message grouped by status. The template is picked with -template, else the one
+// named like the -view, else the default one. --dry-run prints the message instead.
+func (c *CLI) HandleSlackReportCommand() {
+	webhook := c.config.GetSlackWebhook()
+	templateName, viewName := "", ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-webhook", "--webhook":
+			webhook = flag.Value
+		case "-template", "--template":
+			templateName = flag.Value
+		case "-view", "--view", "-v":
+			viewName = flag.Value
+		}
+	}
+	dryRun := c.command.HasSwitch("dry-run")
+	if webhook == "" && !dryRun {
+		fmt.Println("Usage: monday-cli " + slackReportUsage)
+		fmt.Println("💡 Pass -webhook or run 'config set-slack-webhook <url>', Slack lists the URL under the app's Incoming Webhooks")
+		os.Exit(ExitConfigMissing)
+	}
+
+	tmpl, configured := c.config.GetSlackTemplate(templateName)
+	if templateName == "" {
+		tmpl, _ = c.config.GetSlackTemplate(viewName)
+	} else if !configured {
+		fmt.Printf("❌ Unknown Slack template: %s\n", templateName)
+		fmt.Println("💡 Add it with 'config set-slack-template <name> -title <template> -task <template>'")
+		os.Exit(ExitNotFound)
+	}
+
+	boardID := c.config.GetBoardID()
+	cached, _, ok := monday.NewDataStore().GetCachedTasks(boardID)
+	if !ok {
+		fmt.Println("❌ No cached tasks found")
+		fmt.Println("💡 Run 'tasks fetch' first")
+		os.Exit(ExitNotFound)
+	}
+	tasks := make([]monday.Task, 0, len(cached))
+	for _, task := range cached {
+		tasks = append(tasks, task)
+	}
+	order, reverse := c.sortOrder()
+	report := monday.SlackReport{
+		Title:       "Tasks",
+		Board:       c.boardName(boardID),
+		Date:        time.Now(),
+		Tasks:       monday.SortTasks(monday.FilterTasks(tasks, c.listFilters()), order.GroupBy("status"), reverse),
+		AccountSlug: c.config.GetAccountSlug(),
+		BoardID:     boardID,
+	}
+	if viewName != "" {
+		report.Title = viewName
+	}
+	message, err := report.Message(tmpl)
+	if err != nil {
+		exitWithError("Failed to render the Slack message", err)
+	}
+
+	if dryRun {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(message)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), slackPostTimeout)
+	defer cancel()
+	if err := monday.PostSlackMessage(ctx, c.httpTransport(), webhook, message); err != nil {
+		exitWithError("Failed to post to Slack", err)
+	}
+	printf("✅ Posted %d tasks to Slack\n", len(report.Tasks))
+	if report.AccountSlug == "" {
+		progressf("💡 Tasks are linked once the account is known, run 'config set-account-slug <slug>' or 'task url'\n")
+	}
+}
+
+// HandleSetSlackTemplateCommand saves a template of 'report slack'. The template is rendered
+// with a sample task first, so mistakes show up now rather than when posting.
+func (c *CLI) HandleSetSlackTemplateCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli config set-slack-template <name> [-title <template>] [-task <template>]")
+		fmt.Printf("Default title: %s\n", monday.DefaultSlackTemplate.Title)
+		fmt.Printf("Default task:  %s\n", monday.DefaultSlackTemplate.Task)
+		fmt.Println("Templates named like a view are used for 'report slack -view <name>', 'default' for all others")
+		os.Exit(ExitValidation)
+	}
+	name := c.command.Args[1]
+	var tmpl monday.SlackTemplate
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-title", "--title":
+			tmpl.Title = flag.Value
+		case "-task", "--task":
+			tmpl.Task = flag.Value
+		}
+	}
+	sample := monday.SlackReport{
+		Title: name,
+		Date:  time.Now(),
+		Tasks: []monday.Task{{ID: "1", Name: "Sample task", Status: "Working on it", Priority: "High", UserName: c.config.GetUserInfo().Name}},
+	}
+	message, err := sample.Message(tmpl)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	c.config.SetSlackTemplate(name, tmpl)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ Slack template %s saved, a sample task looks like:\n", name)
+	for _, block := range message.Blocks {
+		if block.Type == "section" {
+			fmt.Println(strings.TrimPrefix(block.Text.Text, "*Working on it*\n"))
+		}
+	}
+}
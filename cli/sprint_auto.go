@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"time"
+)
+
+// activeSprintID returns the configured sprint ID. With 'auto' the sprints of the sprint board
+// are fetched and cached, and the ID of the sprint running today is returned.
+func (c *CLI) activeSprintID(client *monday.Client) string {
+	if !c.config.IsSprintAuto() {
+		return c.config.GetSprintID()
+	}
+	sprintBoardID := c.config.GetSprintBoardID()
+	if sprintBoardID == "" {
+		fmt.Println("❌ No sprint board configured, it is needed to detect the current sprint")
+		fmt.Println("💡 Run 'config set-sprint-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+	progressf("🏃 Detecting the current sprint...\n")
+	sprints, err := client.GetBoardSprints(sprintBoardID)
+	if err != nil {
+		exitWithError("Failed to fetch sprints", err)
+	}
+	monday.NewDataStore().StoreBoardSprints(sprintBoardID, sprints)
+	sprint, ok := monday.CurrentSprint(sprints, monday.SprintIDAuto, time.Now())
+	if !ok {
+		fmt.Println("❌ No sprint on the sprint board runs today")
+		fmt.Println("💡 Check the sprint dates or run 'config set-sprint-id <sprint-id>'")
+		os.Exit(ExitNotFound)
+	}
+	progressf("🏃 Current sprint: %s (%s)\n", sprint.Name, sprint.ID)
+	return sprint.ID
+}
+
+// sprintIDSummary describes the configured sprint ID for 'config show', with 'auto' the
+// detected sprint is added when the sprints are cached
+func (c *CLI) sprintIDSummary() string {
+	if !c.config.IsSprintAuto() {
+		return c.config.GetSprintID()
+	}
+	if sprint, ok := c.cachedCurrentSprint(); ok {
+		return fmt.Sprintf("%s (%s)", monday.SprintIDAuto, sprint.Name)
+	}
+	return fmt.Sprintf("%s (no cached sprint runs today)", monday.SprintIDAuto)
+}
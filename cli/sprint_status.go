@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"monday-cli/monday"
+	"os"
+	"time"
+)
+
+// HandleSprintStatusCommand shows how far the current sprint is: the working days left, the
+// done and open tasks, the points burned and a bar comparing the work done with the time
+// passed. The sprint dates come from the timeline of the sprint board item, -refresh true
+// fetches the sprint board again instead of using the cached sprints.
+func (c *CLI) HandleSprintStatusCommand() {
+	var name string
+	refresh := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-sprint", "--sprint":
+			name = flag.Value
+		case "-refresh", "--refresh", "-r":
+			refresh = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	sprintBoardID := c.config.GetSprintBoardID()
+	if sprintBoardID == "" {
+		fmt.Println("❌ No sprint board configured")
+		fmt.Println("💡 Run 'config set-sprint-board-id <board-id>' first")
+		os.Exit(ExitConfigMissing)
+	}
+	dataStore := monday.NewDataStore()
+	if refresh {
+		sprints, err := c.newClient().GetBoardSprints(sprintBoardID)
+		if err != nil {
+			exitWithError("Failed to fetch sprints", err)
+		}
+		dataStore.StoreBoardSprints(sprintBoardID, sprints)
+	}
+	sprints, _, _ := dataStore.GetCachedBoardSprints(sprintBoardID)
+	sprint, ok := monday.CurrentSprint(sprints, c.config.GetSprintID(), time.Now())
+	if name != "" {
+		sprint, ok = findSprint(sprints, name), true
+	}
+	if !ok {
+		fmt.Println("❌ No current sprint found")
+		fmt.Println("💡 Run 'config set-sprint-id <sprint-id>' or pass -sprint <name>")
+		os.Exit(ExitConfigMissing)
+	}
+
+	analytics := monday.NewAnalyticsService(nil, dataStore)
+	progress, err := analytics.SprintProgress(c.config.GetBoardID(), sprint, time.Now())
+	if err != nil {
+		c.exitWithoutSprintTasks(err, sprint.Name)
+	}
+
+	heading := "🏃 " + colorize(sprint.Name, ColorCyan)
+	if sprint.HasDates() {
+		heading += colorize(fmt.Sprintf("  %s → %s", sprint.StartDate.Format("Jan 2"), sprint.EndDate.Format("Jan 2")), ColorGray)
+	}
+	printf("%s\n", heading)
+	switch {
+	case progress.Days == 0:
+		printf("📅 No dates on the sprint board\n")
+	case progress.Day == 0:
+		printf("📅 Starts %s, %d working days\n", sprint.StartDate.Format("Mon Jan 2"), progress.Days)
+	case sprint.EndedBefore(time.Now()):
+		printf("📅 Ended %s after %d working days\n", sprint.EndDate.Format("Mon Jan 2"), progress.Days)
+	default:
+		printf("📅 Day %d of %d, %d working days left\n", progress.Day, progress.Days, progress.DaysLeft())
+	}
+	printf("✅ %d of %d tasks done, %d open\n", progress.Done, progress.Done+progress.Open, progress.Open)
+	if progress.Points > 0 {
+		printf("🎯 %s of %s pts burned, %s to go\n", monday.FormatPoints(progress.DonePoints), monday.FormatPoints(progress.Points), monday.FormatPoints(progress.Points-progress.DonePoints))
+	}
+
+	// Green when the work keeps up with the time passed, yellow when it falls behind
+	done := progress.DoneShare()
+	color := ColorGreen
+	if progress.Days > 0 && done < progress.TimeShare() {
+		color = ColorYellow
+	}
+	line := fmt.Sprintf("   %s %d%%", drawBar(done, color), int(math.Round(done*100)))
+	if progress.Days > 0 {
+		line += colorize(fmt.Sprintf("  (%d%% of the time)", int(math.Round(progress.TimeShare()*100))), ColorGray)
+	}
+	printf("%s\n", line)
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+// HandleStatusCommand prints your open tasks per status and the day of the sprint on one
+// line. It reads only the cache, so it is fast enough for shell prompts and tmux status bars.
+// With --porcelain the line has no colors or emoji and a stable format for scripts, e.g.
+// "3 in-progress, 1 stuck, sprint 12 day 6/10".
+func (c *CLI) HandleStatusCommand() {
+	porcelain := c.command.HasSwitch("porcelain")
+	cached, _, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID())
+	if !ok {
+		if !porcelain {
+			fmt.Println("❌ No cached tasks, run 'tasks fetch' first")
+		}
+		os.Exit(ExitNotFound)
+	}
+	tasks := make([]monday.Task, 0, len(cached))
+	for _, task := range cached {
+		tasks = append(tasks, task)
+	}
+
+	var parts []string
+	for _, count := range monday.SummarizeTasks(monday.FilterTasks(tasks, c.listFilters())).ByStatus {
+		if !monday.IsActiveStatus(monday.Status(count.Value)) {
+			continue
+		}
+		status := strings.ToLower(count.Value)
+		if porcelain {
+			parts = append(parts, fmt.Sprintf("%d %s", count.Count, strings.ReplaceAll(status, " ", "-")))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s %d %s", getStatusIcon(count.Value), count.Count, colorize(status, getStatusColor(count.Value))))
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "0 open")
+	}
+
+	if sprint, ok := c.cachedCurrentSprint(); ok {
+		text := sprint.Name
+		if day, days := sprint.DayAt(time.Now()); day > 0 {
+			text += fmt.Sprintf(" day %d/%d", day, days)
+		}
+		if porcelain {
+			parts = append(parts, strings.ToLower(text))
+		} else {
+			parts = append(parts, "🏃 "+colorize(text, ColorCyan))
+		}
+	}
+
+	line := strings.Join(parts, ", ")
+	if porcelain {
+		fmt.Println(line)
+		return
+	}
+	printLine(line)
+}
+
+// cachedCurrentSprint returns the configured sprint, or the one running today, from the
+// cached sprints of the sprint board
+func (c *CLI) cachedCurrentSprint() (monday.Sprint, bool) {
+	sprintBoardID := c.config.GetSprintBoardID()
+	if sprintBoardID == "" {
+		return monday.Sprint{}, false
+	}
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(sprintBoardID)
+	return monday.CurrentSprint(sprints, c.config.GetSprintID(), time.Now())
+}
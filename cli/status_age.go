@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// statusAgeLimits are the configured days in a status until listings warn, set from the config
+var statusAgeLimits monday.StatusAgeLimits
+
+// formatStatusAge formats how long a task has been in its status as " ⏳ stuck for 6d", colored
+// by the limit of the status, or nothing for done tasks and tasks without a known time
+func formatStatusAge(task monday.Task) string {
+	text, color := statusAge(task)
+	if text == "" {
+		return ""
+	}
+	return " " + colorize("⏳ "+text, color)
+}
+
+// statusAge returns how long a task has been in its status as "stuck for 6d" with the color
+// of its level, "stuck for 14d+" when it entered the status before the activity read
+func statusAge(task monday.Task) (string, string) {
+	if task.Status == "" || monday.IsDoneStatus(task.Status) {
+		return "", ""
+	}
+	age, ok := task.TimeInStatus(time.Now())
+	if !ok {
+		return "", ""
+	}
+	text := fmt.Sprintf("%s for %s", strings.ToLower(string(task.Status)), formatAge(age))
+	if task.SinceBefore {
+		text += "+"
+	}
+	switch statusAgeLimits.For(task.Status).Level(age) {
+	case monday.StatusAgeWarn:
+		return text, ColorYellow
+	case monday.StatusAgeAlert:
+		return text, ColorRed
+	}
+	return text, ColorGray
+}
+
+// formatAge formats a time in status in whole days, or hours below a day
+func formatAge(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return "<1h"
+	}
+}
+
+// formatStatusAgeLimit formats a limit as "warn 3d, alert 7d", leaving out levels turned off
+func formatStatusAgeLimit(limit monday.StatusAgeLimit) string {
+	if limit == (monday.StatusAgeLimit{}) {
+		return "never warn"
+	}
+	var levels []string
+	if limit.Warn > 0 {
+		levels = append(levels, fmt.Sprintf("warn %dd", limit.Warn))
+	}
+	if limit.Alert > 0 {
+		levels = append(levels, fmt.Sprintf("alert %dd", limit.Alert))
+	}
+	return strings.Join(levels, ", ")
+}
+
+// HandleSetStatusAgeCommand sets the days a task may stay in a status before listings show
+// its time in the status in yellow and red, 0 turns a level off. Without -warn and -alert the
+// status falls back to the default limit.
+func (c *CLI) HandleSetStatusAgeCommand() {
+	usage := "config set-status-age <status|default> [-warn <days>] [-alert <days>]"
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + usage)
+		fmt.Printf("Statuses without a limit of their own use the default: %s\n", formatStatusAgeLimit(c.config.GetStatusAgeLimits().For("")))
+		os.Exit(ExitValidation)
+	}
+	status := strings.Join(c.command.Args[1:], " ")
+	var limit monday.StatusAgeLimit
+	reset := true
+	for _, flag := range c.command.Flags {
+		var days *int
+		switch flag.Flag {
+		case "-warn", "--warn":
+			days = &limit.Warn
+		case "-alert", "--alert":
+			days = &limit.Alert
+		default:
+			continue
+		}
+		value, err := strconv.Atoi(flag.Value)
+		if err != nil || value < 0 {
+			fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+			os.Exit(ExitValidation)
+		}
+		*days = value
+		reset = false
+	}
+	if limit.Warn > 0 && limit.Alert > 0 && limit.Alert < limit.Warn {
+		fmt.Println("❌ The alert comes after the warning, -alert has to be at least -warn")
+		os.Exit(ExitValidation)
+	}
+
+	if !strings.EqualFold(status, monday.StatusAgeDefault) {
+		status = labelAlias(status, getStatusValue)
+		board, err := c.newClient().GetBoard(c.config.GetBoardID())
+		if err != nil {
+			exitWithError("Failed to fetch board", err)
+		}
+		if column, _, _ := monday.FindLabelColumns(board); column != nil {
+			if status, err = monday.ValidateLabel(*column, status); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(ExitValidation)
+			}
+		}
+	}
+	if reset {
+		c.config.RemoveStatusAgeLimit(status)
+		c.config.Save(monday.GetConfigPath())
+		fmt.Printf("✅ %s uses the default limit: %s\n", status, formatStatusAgeLimit(c.config.GetStatusAgeLimits().For("")))
+		return
+	}
+	c.config.SetStatusAgeLimit(status, limit)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ %s: %s\n", status, formatStatusAgeLimit(limit))
+}
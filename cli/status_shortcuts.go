@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+// HandleTaskStatusShortcutCommand moves a task to the status of a shortcut with task done, start
+// or block, and posts the reason of a block or a -comment as an update
+func (c *CLI) HandleTaskStatusShortcutCommand(shortcut string) {
+	usage := fmt.Sprintf("task %s <task-index> [-comment <text>]", shortcut)
+	if shortcut == monday.ShortcutBlock {
+		usage = "task block <task-index> [reason] [-comment <text>]"
+	}
+	task := c.cachedTaskArg(usage)
+	comment := strings.Join(c.command.Args[2:], " ")
+	if shortcut != monday.ShortcutBlock && comment != "" {
+		fmt.Println("Usage: monday-cli " + usage)
+		os.Exit(ExitValidation)
+	}
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-comment", "--comment", "-m":
+			comment = strings.TrimSpace(strings.Join([]string{comment, flag.Value}, "\n"))
+		}
+	}
+	if shortcut == monday.ShortcutBlock && comment != "" {
+		comment = "🚧 Blocked: " + comment
+	}
+
+	status := c.config.GetStatusShortcut(shortcut)
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	updatedTask := &task
+	var err error
+	if strings.EqualFold(string(task.Status), status) {
+		fmt.Printf("ℹ️  Task %d is already %s\n", task.LocalId, task.Status)
+	} else {
+		updatedTask, err = client.UpdateTask(boardID, c.config.GetUserEmail(), task, status, "", "")
+	}
+	if c.queueIfOffline(err, monday.PendingOperation{
+		Kind:          monday.OperationUpdate,
+		TaskID:        task.ID,
+		TaskName:      task.Name,
+		Details:       monday.TaskDetails{Status: status},
+		BaseUpdatedAt: task.UpdatedAt,
+	}) {
+		if comment != "" {
+			fmt.Println("⚠️  The update was not posted, updates cannot be queued")
+		}
+		return
+	}
+	if errors.Is(err, monday.ErrInvalidLabel) {
+		fmt.Printf("❌ %v\n", err)
+		fmt.Printf("💡 Choose the label with 'config set-status-shortcut %s <label>'\n", shortcut)
+		os.Exit(ExitValidation)
+	}
+	if err != nil && !errors.Is(err, monday.ErrDryRun) {
+		exitWithError("Error updating task", err)
+	}
+	dryRun := errors.Is(err, monday.ErrDryRun)
+
+	if comment != "" {
+		_, err := client.CreateUpdate(task.ID, comment)
+		if err != nil && !errors.Is(err, monday.ErrDryRun) {
+			exitWithError("Error posting update", err)
+		}
+		if err == nil {
+			c.recordHistory(monday.HistoryComment, task, task, comment)
+			fmt.Printf("💬 Posted update on task %d\n", task.LocalId)
+		}
+	}
+	if dryRun || updatedTask == &task {
+		return
+	}
+
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	fmt.Printf("✅ Task %d is %s\n", task.LocalId, updatedTask.Status)
+	PrintTask(*updatedTask)
+}
+
+// HandleSetStatusShortcutCommand sets the status label of a shortcut, checked against the
+// status column of the board
+func (c *CLI) HandleSetStatusShortcutCommand() {
+	if len(c.command.Args) < 3 || !monday.IsStatusShortcut(c.command.Args[1]) {
+		fmt.Printf("Usage: monday-cli config set-status-shortcut <%s> <label>\n", strings.Join(monday.StatusShortcutNames(), "|"))
+		for _, name := range monday.StatusShortcutNames() {
+			fmt.Printf("  %-6s %s\n", name, c.config.GetStatusShortcut(name))
+		}
+		os.Exit(ExitValidation)
+	}
+	shortcut, label := c.command.Args[1], strings.Join(c.command.Args[2:], " ")
+
+	board, err := c.newClient().GetBoard(c.config.GetBoardID())
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+	if status, _, _ := monday.FindLabelColumns(board); status != nil {
+		if label, err = monday.ValidateLabel(*status, label); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(ExitValidation)
+		}
+	}
+	c.config.SetStatusShortcut(shortcut, label)
+	c.config.Save(monday.GetConfigPath())
+	fmt.Printf("✅ 'task %s' sets the status to %s\n", shortcut, label)
+}
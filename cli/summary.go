@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+)
+
+// HandleTasksCountCommand prints the number of cached tasks matching the filters, of the board
+// or of all boards with --all-boards. It prints only the number so it fits in scripts and
+// shell prompts, with --summary the counts per status, priority and assignee instead.
+func (c *CLI) HandleTasksCountCommand() {
+	var tasks map[string]monday.Task
+	if c.command.HasSwitch("all-boards") {
+		tasks, _ = c.cachedTasksOfAllBoards()
+	} else {
+		var ok bool
+		if tasks, _, ok = monday.NewDataStore().GetCachedTasks(c.config.GetBoardID()); !ok {
+			fmt.Println("❌ No cached tasks, run 'tasks fetch' first")
+			os.Exit(ExitNotFound)
+		}
+	}
+	tasksList := make([]monday.Task, 0, len(tasks))
+	for _, task := range tasks {
+		tasksList = append(tasksList, task)
+	}
+	filteredTasks := monday.FilterTasks(tasksList, c.listFilters())
+	if c.command.HasSwitch("summary") {
+		printSummary(filteredTasks)
+		return
+	}
+	fmt.Println(len(filteredTasks))
+}
+
+// printSummary prints the number of tasks and points per status, priority and assignee
+func printSummary(tasks []monday.Task) {
+	summary := monday.SummarizeTasks(tasks)
+	total := fmt.Sprintf("📊 %d tasks, %d active", summary.Total, summary.Active)
+	if summary.Points > 0 {
+		total += fmt.Sprintf(", %s pts", monday.FormatPoints(summary.Points))
+	}
+	printLine(total)
+
+	printFieldCounts("Status", summary.ByStatus, func(value string) (string, string) {
+		return getStatusIcon(value), getStatusColor(value)
+	})
+	printFieldCounts("Priority", summary.ByPriority, func(value string) (string, string) {
+		return getPriorityIcon(value), getPriorityColor(value)
+	})
+	printFieldCounts("Assignee", summary.ByAssignee, func(string) (string, string) {
+		return "👤", ""
+	})
+}
+
+// printFieldCounts prints the counts of one field under a title, each value after the icon
+// and in the color style returns for it. Values are padded before they are colored, so the
+// counts line up.
+func printFieldCounts(title string, counts []monday.FieldCount, style func(value string) (icon, color string)) {
+	if len(counts) == 0 {
+		return
+	}
+	width := 0
+	for _, count := range counts {
+		width = max(width, displayWidth(fieldValue(count.Value)))
+	}
+	printf("\n%s:\n", title)
+	for _, count := range counts {
+		icon, color := style(count.Value)
+		value := pad(fieldValue(count.Value), width)
+		if color != "" {
+			value = colorize(value, color)
+		}
+		line := fmt.Sprintf("  %s %s %4d", icon, value, count.Count)
+		if count.Points > 0 {
+			line += colorize(fmt.Sprintf("  %s pts", monday.FormatPoints(count.Points)), ColorGray)
+		}
+		printLine(line)
+	}
+}
+
+// fieldValue returns the value of a field or None when it is not set
+func fieldValue(value string) string {
+	if value == "" {
+		return "None"
+	}
+	return value
+}
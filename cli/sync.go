@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+)
+
+// HandleSyncCommand handles the queue of mutations made while offline
+func (c *CLI) HandleSyncCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpSyncCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "push", "p":
+		c.HandleSyncPushCommand()
+	case "status", "st":
+		c.HandleSyncStatusCommand()
+	case "drop", "rm":
+		c.HandleSyncDropCommand()
+	default:
+		c.HelpSyncCommand()
+	}
+}
+
+func (c *CLI) HelpSyncCommand() {
+	fmt.Println("Sync Commands:")
+	fmt.Println("  sync status (st)             Show task creates and edits queued while offline")
+	fmt.Println("  sync push (p) [-force true]  Send the queued changes, -force overwrites tasks changed on Monday meanwhile")
+	fmt.Println("  sync drop (rm) <id>          Discard a queued change")
+}
+
+// queueIfOffline queues the operation when err means the API is unreachable, it reports
+// whether the operation was queued
+func (c *CLI) queueIfOffline(err error, op monday.PendingOperation) bool {
+	if !monday.IsNetworkError(err) {
+		return false
+	}
+	queued, queueErr := monday.NewDataStore().QueueOperation(c.config.GetBoardID(), op)
+	if queueErr != nil {
+		monday.Logger().Warn("failed to queue operation", "error", queueErr)
+		return false
+	}
+	fmt.Printf("📴 Monday.com is unreachable, queued as change #%d\n", queued.ID)
+	fmt.Println("💡 Run 'sync push' when you are back online")
+	return true
+}
+
+// HandleSyncStatusCommand lists the queued operations
+func (c *CLI) HandleSyncStatusCommand() {
+	pending := monday.NewDataStore().GetPendingOperations(c.config.GetBoardID())
+	if len(pending) == 0 {
+		fmt.Println("✅ Nothing queued")
+		return
+	}
+	printf("📴 %d queued change(s):\n", len(pending))
+	for _, op := range pending {
+		PrintPendingOperation(op)
+	}
+}
+
+// PrintPendingOperation prints a queued operation with the fields it sets
+func PrintPendingOperation(op monday.PendingOperation) {
+	printf("  #%d %s %s %s\n", op.ID, colorize(op.QueuedAt.Local().Format("2006-01-02 15:04"), ColorGray), op.Kind, op.TaskName)
+	details := op.Details
+	for _, field := range [][2]string{
+		{"status", details.Status},
+		{"priority", details.Priority},
+		{"type", details.Type},
+		{"tags", op.TagChanges},
+	} {
+		if field[1] != "" {
+			printf("      %s: %s\n", field[0], field[1])
+		}
+	}
+}
+
+// HandleSyncPushCommand replays the queued operations in order. Conflicts and failures stay
+// queued, when the API is still unreachable it stops.
+func (c *CLI) HandleSyncPushCommand() {
+	force := false
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-force", "--force":
+			force = flag.Value == "true" || flag.Value == "yes" || flag.Value == "y"
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	pending := monday.NewDataStore().GetPendingOperations(boardID)
+	if len(pending) == 0 {
+		fmt.Println("✅ Nothing queued")
+		return
+	}
+
+	client := c.newClient()
+	failed := 0
+	for _, op := range pending {
+		before, after, err := client.ReplayOperation(boardID, op, force)
+		if errors.Is(err, monday.ErrDryRun) {
+			continue
+		}
+		if monday.IsNetworkError(err) {
+			exitWithError("Monday.com is still unreachable", err)
+		}
+		if err != nil {
+			failed++
+			PrintError(fmt.Sprintf("Change #%d (%s %s) not sent", op.ID, op.Kind, op.TaskName), err)
+			continue
+		}
+
+		if op.Kind == monday.OperationCreate {
+			c.cacheCreatedTask(boardID, after)
+		}
+		// Loaded after caching a created task, which saves its own data store
+		dataStore := monday.NewDataStore()
+		if op.Kind == monday.OperationUpdate {
+			if cached, _, ok := dataStore.GetCachedTask(boardID, after.ID); ok {
+				after.LocalId = cached.LocalId
+				dataStore.UpdateCachedTask(boardID, after.ID, *after)
+			}
+		}
+		if err := dataStore.RemovePendingOperation(boardID, op.ID); err != nil {
+			monday.Logger().Warn("failed to remove queued operation", "id", op.ID, "error", err)
+		}
+		action := monday.HistoryUpdate
+		if op.Kind == monday.OperationCreate {
+			action = monday.HistoryCreate
+		}
+		c.recordHistory(action, before, *after, "")
+		fmt.Printf("✅ Sent change #%d: %s %s\n", op.ID, op.Kind, after.Name)
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  %d change(s) still queued, see 'sync status'\n", failed)
+		os.Exit(ExitError)
+	}
+}
+
+// HandleSyncDropCommand removes a queued operation without sending it
+func (c *CLI) HandleSyncDropCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli sync drop <id>")
+		os.Exit(ExitValidation)
+	}
+	id, err := strconv.Atoi(c.command.Args[1])
+	if err != nil {
+		fmt.Printf("❌ Invalid change ID: %v\n", err)
+		os.Exit(ExitValidation)
+	}
+	if err := monday.NewDataStore().RemovePendingOperation(c.config.GetBoardID(), id); err != nil {
+		exitWithError("Error dropping change", err)
+	}
+	fmt.Printf("🗑️  Dropped change #%d\n", id)
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const taskEditorUsage = "task edit <task-index> --editor"
+
+// HandleTaskEditorCommand opens the editable fields of a task as YAML in $VISUAL or $EDITOR.
+// Once the editor closes the fields are compared with the task and only the changed columns
+// are written, in a single mutation. Invalid input can be fixed by editing again.
+func (c *CLI) HandleTaskEditorCommand() {
+	task := c.cachedTaskArg(taskEditorUsage)
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Failed to fetch board", err)
+	}
+
+	before := monday.NewEditableTask(task)
+	header := []string{
+		fmt.Sprintf("Task %d, save and close the editor to apply the changed fields", task.LocalId),
+		"Empty fields are cleared, due takes YYYY-MM-DD, today, friday or 3d",
+		"",
+	}
+	header = append(header, monday.EditHints(board)...)
+	file, err := os.CreateTemp("", fmt.Sprintf("monday-task-%d-*.yaml", task.LocalId))
+	if err != nil {
+		exitWithError("Cannot create the file to edit", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+	_, err = file.WriteString(before.Text(header))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		exitWithError("Cannot write the file to edit", err)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	var after monday.EditableTask
+	var due time.Time
+	for {
+		if err := runEditor(path); err != nil {
+			exitWithError("Editor failed", err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			exitWithError("Cannot read the edited file", err)
+		}
+		after, err = monday.ParseEditableTask(string(data), before)
+		if err == nil {
+			after, err = after.Validate(board)
+		}
+		if err == nil && after.Due != "" && after.Due != before.Due {
+			if due, err = monday.ParseDueDate(after.Due, time.Now()); err == nil {
+				after.Due = due.Format("2006-01-02")
+			}
+		}
+		if err == nil {
+			break
+		}
+		fmt.Printf("❌ %v\n", err)
+		if !confirm(reader, "Edit again?", true) {
+			fmt.Println("No changes applied")
+			os.Exit(ExitValidation)
+		}
+	}
+
+	changes := monday.DiffEditableTasks(before, after)
+	if len(changes) == 0 {
+		fmt.Println("📭 No changes")
+		return
+	}
+
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+	var edit monday.TaskEdit
+	predicted := task
+	for _, change := range changes {
+		value := change.New
+		switch change.Field {
+		case "name":
+			edit.Name = &value
+			predicted.Name = value
+		case "status":
+			edit.Status = &value
+			predicted.Status = monday.Status(value)
+		case "priority":
+			edit.Priority = &value
+			predicted.Priority = monday.Priority(value)
+		case "type":
+			edit.Type = &value
+			predicted.Type = monday.Type(value)
+		case "assignee":
+			var assignee monday.User
+			if value != "" && !strings.EqualFold(value, "none") {
+				assignee = c.resolveUser(client, value)
+			}
+			edit.AssigneeID = &assignee.ID
+			predicted.UserName = assignee.Name
+		case "sprint":
+			var sprint monday.Sprint
+			if value != "" {
+				sprint = findSprint(sprints, value)
+			}
+			edit.SprintID = &sprint.ID
+			predicted.Sprint = sprint.Name
+		case "due":
+			edit.DueDate = &due
+			predicted.DueDate = due
+		case "tags":
+			edit.Tags = &after.Tags
+			predicted.Tags = after.Tags
+		}
+	}
+
+	fmt.Printf("Updating task %d: %s\n", task.LocalId, task.Name)
+	for _, change := range changes {
+		fmt.Printf("  %s: %s -> %s\n", change.Field, colorize(displayValue(change.Old), ColorRed), colorize(displayValue(change.New), ColorGreen))
+	}
+	updatedTask, err := client.EditTask(boardID, task, edit)
+	if errors.Is(err, monday.ErrDryRun) {
+		fmt.Println("📝 Predicted cache change:")
+		PrintTaskChanges(monday.DiffTasks(map[string]monday.Task{task.ID: task}, map[string]monday.Task{task.ID: predicted}), "")
+		return
+	}
+	if err != nil {
+		exitWithError("Error updating task", err)
+	}
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	fmt.Printf("✅ Task %d updated successfully\n", task.LocalId)
+	PrintTask(*updatedTask)
+}
+
+// runEditor opens a file in $VISUAL or $EDITOR and waits until the editor is closed. The
+// variables may hold arguments, e.g. "code --wait". Without them vi is used, notepad on Windows.
+func runEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+	return nil
+}
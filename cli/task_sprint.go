@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+)
+
+const taskSprintUsage = "task sprint <task-index> <sprint-name | current | none>"
+
+// HandleTaskSprintCommand links a task to a sprint of the sprint board, "current" picks the
+// configured sprint or the one running today and "none" unlinks the task
+func (c *CLI) HandleTaskSprintCommand() {
+	task := c.cachedTaskArg(taskSprintUsage)
+	if len(c.command.Args) < 3 {
+		fmt.Println("Usage: monday-cli " + taskSprintUsage)
+		os.Exit(ExitValidation)
+	}
+	name := strings.Join(c.command.Args[2:], " ")
+
+	var sprint monday.Sprint
+	switch strings.ToLower(name) {
+	case "none":
+	case "current", "cur":
+		current, ok := c.cachedCurrentSprint()
+		if !ok {
+			fmt.Println("❌ No current sprint found")
+			fmt.Println("💡 Run 'config set-sprint-id <sprint-id | auto>' and 'tasks fetch' with a sprint board configured")
+			os.Exit(ExitConfigMissing)
+		}
+		sprint = current
+	default:
+		sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+		sprint = findSprint(sprints, name)
+	}
+	switch {
+	case task.Sprint == "" && sprint.ID == "":
+		fmt.Printf("📭 Task %d is not in a sprint\n", task.LocalId)
+		return
+	case strings.EqualFold(task.Sprint, sprint.Name):
+		fmt.Printf("📭 Task %d is already in %s\n", task.LocalId, sprint.Name)
+		return
+	}
+
+	boardID := c.config.GetBoardID()
+	fmt.Printf("Moving task %d: %s\n", task.LocalId, task.Name)
+	fmt.Printf("  sprint: %s -> %s\n", colorize(displayValue(task.Sprint), ColorRed), colorize(displayValue(sprint.Name), ColorGreen))
+	updatedTask, err := c.newClient().SetTaskSprint(boardID, task, sprint.ID)
+	if errors.Is(err, monday.ErrDryRun) {
+		predicted := task
+		predicted.Sprint = sprint.Name
+		fmt.Println("📝 Predicted cache change:")
+		PrintTaskChanges(monday.DiffTasks(map[string]monday.Task{task.ID: task}, map[string]monday.Task{task.ID: predicted}), "")
+		return
+	}
+	if err != nil {
+		exitWithError("Error linking task to sprint", err)
+	}
+	updatedTask.LocalId = task.LocalId
+	monday.NewDataStore().UpdateCachedTaskByLocalId(boardID, task.LocalId, *updatedTask)
+	c.recordHistory(monday.HistoryUpdate, task, *updatedTask, "")
+	if sprint.ID == "" {
+		fmt.Printf("✅ Task %d removed from its sprint\n", task.LocalId)
+	} else {
+		fmt.Printf("✅ Task %d moved to %s\n", task.LocalId, sprint.Name)
+	}
+	PrintTask(*updatedTask)
+}
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HandleTaskCreateWizard walks through the fields of a new task with menus built from the
+// board settings and the cached users and sprints, then creates it
+func (c *CLI) HandleTaskCreateWizard() {
+	reader := bufio.NewReader(os.Stdin)
+	client := c.newClient()
+	boardID := c.config.GetBoardID()
+	board, err := client.GetBoard(boardID)
+	if err != nil {
+		exitWithError("Error fetching board", err)
+	}
+
+	fmt.Println("📝 New task")
+	fmt.Println("=" + strings.Repeat("=", 50))
+
+	name := ""
+	if len(c.command.Args) > 1 {
+		name = c.command.Args[1]
+		fmt.Printf("Name: %s\n", name)
+	}
+	for name == "" {
+		name = prompt(reader, "Name: ")
+	}
+	c.confirmNotDuplicate(reader, client, boardID, name)
+
+	var details monday.TaskDetails
+	statusColumn, priorityColumn, typeColumn := monday.FindLabelColumns(board)
+	details.Status = selectLabel(reader, "Status:", statusColumn, "(none)")
+	details.Priority = selectLabel(reader, "Priority:", priorityColumn, "(none)")
+	details.Type = selectLabel(reader, "Type:", typeColumn, "(none)")
+
+	sprint := c.selectSprint(reader)
+	details.SprintID = sprint.ID
+
+	assignee := c.selectAssignee(reader)
+	details.AssigneeID = assignee.ID
+
+	details.DueDate = promptDueDate(reader)
+
+	task := monday.Task{
+		Name:     name,
+		Status:   monday.Status(details.Status),
+		Priority: monday.Priority(details.Priority),
+		Type:     monday.Type(details.Type),
+		Sprint:   sprint.Name,
+		UserName: assignee.Name,
+		DueDate:  details.DueDate,
+	}
+	printWizardSummary(task)
+	if answer := strings.ToLower(prompt(reader, "Create this task? [Y/n]: ")); answer != "" && answer != "y" && answer != "yes" {
+		fmt.Println("❌ Task not created")
+		os.Exit(ExitError)
+	}
+
+	created, err := client.CreateTaskWithDetails(boardID, name, details)
+	if c.queueIfOffline(err, monday.PendingOperation{Kind: monday.OperationCreate, TaskName: name, Details: details}) {
+		return
+	}
+	if errors.Is(err, monday.ErrDryRun) {
+		c.printPredictedCreate(task)
+		return
+	}
+	if err != nil {
+		exitWithError("Error creating task", err)
+	}
+	localId := c.cacheCreatedTask(boardID, created)
+	c.recordHistory(monday.HistoryCreate, monday.Task{}, *created, "")
+	fmt.Printf("✅ Task %s created with ID %d\n", created.Name, localId)
+	PrintTask(*created)
+}
+
+// printWizardSummary shows the fields of the task about to be created, unset ones as "-"
+func printWizardSummary(task monday.Task) {
+	dueDate := ""
+	if !task.DueDate.IsZero() {
+		dueDate = task.DueDate.Format("2006-01-02")
+	}
+	fmt.Println("")
+	for _, field := range [][2]string{
+		{"Name", task.Name},
+		{"Status", string(task.Status)},
+		{"Priority", string(task.Priority)},
+		{"Type", string(task.Type)},
+		{"Sprint", task.Sprint},
+		{"Assignee", task.UserName},
+		{"Due date", dueDate},
+	} {
+		if field[1] == "" {
+			field[1] = "-"
+		}
+		fmt.Printf("  %-9s %s\n", field[0]+":", field[1])
+	}
+}
+
+// selectLabel offers the labels of a column after the empty option, returning empty when
+// the column is missing or has no labels, or when the empty option is chosen
+func selectLabel(reader *bufio.Reader, question string, column *monday.Column, emptyOption string) string {
+	if column == nil {
+		return ""
+	}
+	settings, err := monday.ParseColumnSettings(*column)
+	if err != nil || len(settings.Labels) == 0 {
+		return ""
+	}
+	options := append([]string{emptyOption}, settings.LabelNames()...)
+	choice := selectOption(reader, question, options, 0)
+	if choice == 0 {
+		return ""
+	}
+	return options[choice]
+}
+
+// selectSprint offers the cached sprints, preselecting the current one
+func (c *CLI) selectSprint(reader *bufio.Reader) monday.Sprint {
+	sprints, _, _ := monday.NewDataStore().GetCachedBoardSprints(c.config.GetSprintBoardID())
+	if len(sprints) == 0 {
+		return monday.Sprint{}
+	}
+	options := []string{"(none)"}
+	selected := 0
+	current, hasCurrent := monday.CurrentSprint(sprints, c.config.GetSprintID(), time.Now())
+	for i, sprint := range sprints {
+		options = append(options, sprint.Name)
+		if hasCurrent && sprint.ID == current.ID {
+			selected = i + 1
+		}
+	}
+	choice := selectOption(reader, "Sprint:", options, selected)
+	if choice == 0 {
+		return monday.Sprint{}
+	}
+	return sprints[choice-1]
+}
+
+// selectAssignee offers the cached board users with the current user first
+func (c *CLI) selectAssignee(reader *bufio.Reader) monday.User {
+	me := c.config.GetUserInfo()
+	users := []monday.User{}
+	if me.ID != "" {
+		users = append(users, *me)
+	}
+	boardUsers, _, _ := monday.NewDataStore().GetCachedBoardUsers(c.config.GetBoardID())
+	sort.Slice(boardUsers, func(i, j int) bool { return boardUsers[i].Name < boardUsers[j].Name })
+	for _, user := range boardUsers {
+		if user.ID != me.ID {
+			users = append(users, user)
+		}
+	}
+
+	options := []string{"(unassigned)"}
+	for _, user := range users {
+		option := user.Name
+		if user.ID == me.ID {
+			option += " (me)"
+		}
+		options = append(options, option)
+	}
+	selected := 0
+	if me.ID != "" {
+		selected = 1
+	}
+	choice := selectOption(reader, "Assignee:", options, selected)
+	if choice == 0 {
+		return monday.User{}
+	}
+	return users[choice-1]
+}
+
+// promptDueDate reads a date (2024-01-31) or a duration from today (3d, 2w), empty for none
+func promptDueDate(reader *bufio.Reader) time.Time {
+	for {
+		answer := prompt(reader, "Due date (YYYY-MM-DD or 3d, 2w, empty for none): ")
+		if answer == "" {
+			return time.Time{}
+		}
+		if date, err := time.ParseInLocation("2006-01-02", answer, time.Local); err == nil {
+			return date
+		}
+		if duration, err := monday.ParseRelativeDuration(answer); err == nil {
+			due := time.Now().Add(duration)
+			return time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.Local)
+		}
+		fmt.Printf("❌ Invalid due date %q\n", answer)
+	}
+}
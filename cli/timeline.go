@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strings"
+	"time"
+)
+
+// timelineLabelWidth is the width of the task column left of the chart
+const timelineLabelWidth = 28
+
+// HandleTasksTimelineCommand draws a Gantt chart of the current sprint's tasks from their
+// timeline columns. Spans of unfinished tasks past their end are drawn in red up to today.
+func (c *CLI) HandleTasksTimelineCommand() {
+	sprintName := ""
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-sprint", "--sprint":
+			sprintName = flag.Value
+		}
+	}
+	if sprintName == "" {
+		sprintName = c.currentSprintName()
+	}
+
+	cached, timestamp, ok := monday.NewDataStore().GetCachedTasks(c.config.GetBoardID())
+	if !ok {
+		fmt.Println("❌ No tasks found in cache")
+		fmt.Println("💡 Run 'tasks fetch' first to fetch tasks")
+		os.Exit(ExitNotFound)
+	}
+	var tasks []monday.Task
+	for _, task := range cached {
+		if sprintName == "" || strings.EqualFold(task.Sprint, sprintName) {
+			tasks = append(tasks, task)
+		}
+	}
+	tasks = monday.FilterTasks(tasks, c.listFilters())
+	timeline := monday.TimelineTasks(tasks)
+	if len(timeline) == 0 {
+		fmt.Println("❌ No cached tasks with a timeline")
+		fmt.Println("💡 Timelines are read from columns whose ID contains 'timeline'")
+		os.Exit(ExitNotFound)
+	}
+
+	fmt.Println("Tasks cached at: " + timestamp.Format(time.RFC3339))
+	if sprintName != "" {
+		printf("🏃 Timeline of %s\n", sprintName)
+	}
+	for _, line := range renderTimeline(timeline, time.Now(), terminalWidth()) {
+		printLine(line)
+	}
+	if missing := len(tasks) - len(timeline); missing > 0 {
+		printf("%s\n", colorize(fmt.Sprintf("%d tasks without a timeline", missing), ColorGray))
+	}
+}
+
+// renderTimeline draws one bar per task between the first start and the last end date,
+// today included, scaled to the width
+func renderTimeline(tasks []monday.Task, now time.Time, width int) []string {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	first, last := tasks[0].StartDate, tasks[0].EndDate
+	for _, task := range tasks {
+		if task.StartDate.Before(first) {
+			first = task.StartDate
+		}
+		if task.EndDate.After(last) {
+			last = task.EndDate
+		}
+	}
+	overdue := false
+	for _, task := range tasks {
+		overdue = overdue || task.IsOverdue(now)
+	}
+	if overdue && today.After(last) {
+		last = today
+	}
+
+	days := daysBetween(first, last) + 1
+	chartWidth := max(10, width-timelineLabelWidth-1)
+	// column returns the first and lastColumn the last chart column covered by a day
+	column := func(day time.Time) int {
+		return min(chartWidth-1, daysBetween(first, day)*chartWidth/days)
+	}
+	lastColumn := func(day time.Time) int {
+		return max(column(day), daysBetween(first, day.AddDate(0, 0, 1))*chartWidth/days-1)
+	}
+	todayColumn := -1
+	if !today.Before(first) && !today.After(last) {
+		todayColumn = column(today)
+	}
+
+	axis := []rune(strings.Repeat("─", chartWidth))
+	if todayColumn >= 0 {
+		axis[todayColumn] = '┼'
+	}
+	startLabel, endLabel := first.Format("Jan 2"), last.Format("Jan 2")
+	dates := startLabel + strings.Repeat(" ", max(1, chartWidth-len(startLabel)-len(endLabel))) + endLabel
+	lines := []string{
+		strings.Repeat(" ", timelineLabelWidth+1) + dates,
+		strings.Repeat(" ", timelineLabelWidth+1) + string(axis),
+	}
+
+	for _, task := range tasks {
+		start, end := column(task.StartDate), lastColumn(task.EndDate)
+		var bar strings.Builder
+		runColor, run := "", ""
+		for i := 0; i <= chartWidth; i++ {
+			cell, color := " ", ""
+			switch {
+			case i == chartWidth:
+				cell = "" // Flushes the last run
+			case i >= start && i <= end:
+				cell, color = "█", timelineColor(task, now)
+			case task.IsOverdue(now) && i > end && i <= todayColumn:
+				cell, color = "▒", ColorRed
+			case i == todayColumn:
+				cell, color = "│", ColorGray
+			}
+			if color != runColor || i == chartWidth {
+				if runColor == "" {
+					bar.WriteString(run)
+				} else {
+					bar.WriteString(colorize(run, runColor))
+				}
+				runColor, run = color, ""
+			}
+			run += cell
+		}
+		label := pad(truncate(fmt.Sprintf("%d %s", task.LocalId, task.Name), timelineLabelWidth), timelineLabelWidth)
+		if task.IsOverdue(now) {
+			label = colorize(label, ColorRed)
+		}
+		lines = append(lines, label+" "+strings.TrimRight(bar.String(), " "))
+	}
+	return lines
+}
+
+// timelineColor colors bars by status, done tasks green and overdue ones red
+func timelineColor(task monday.Task, now time.Time) string {
+	if task.IsOverdue(now) {
+		return ColorRed
+	}
+	return getStatusColor(string(task.Status))
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	a = time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	b = time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
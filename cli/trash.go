@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"monday-cli/monday"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const taskRestoreUsage = "task restore <item-id>"
+
+// restoredFromPrefix starts the history detail of restored tasks, followed by the old item ID
+const restoredFromPrefix = "restored from item "
+
+// HandleBoardTrashCommand lists the items archived or deleted from the board recently that
+// are still archived or deleted, newest first, with the item IDs 'task restore' takes
+func (c *CLI) HandleBoardTrashCommand() {
+	days := int(monday.TrashWindow.Hours() / 24)
+	for _, flag := range c.command.Flags {
+		switch flag.Flag {
+		case "-days", "--days", "-d":
+			value, err := strconv.Atoi(flag.Value)
+			if err != nil || value < 1 {
+				fmt.Printf("❌ Invalid number of days: %s\n", flag.Value)
+				os.Exit(ExitValidation)
+			}
+			days = value
+		}
+	}
+
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	progressf("🗑️  Reading the items removed in the last %d days...\n", days)
+	trashed, err := client.GetTrashedItems(boardID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		exitWithError("Error reading the trash", err)
+	}
+	if len(trashed) == 0 {
+		fmt.Printf("📭 No items archived or deleted in the last %d days\n", days)
+		return
+	}
+
+	userIDs := make([]string, 0, len(trashed))
+	for _, entry := range trashed {
+		userIDs = append(userIDs, entry.UserID)
+	}
+	// Without a user directory the user IDs are shown instead of names
+	users, _ := monday.NewDataStore().ResolveUsers(client, userIDs)
+	restored := restoredItems()
+	for _, entry := range trashed {
+		icon, state := "📦", "archived"
+		if entry.Item.State == "deleted" {
+			icon, state = "🗑️ ", "deleted "
+		}
+		who := entry.UserID
+		if user, ok := users[entry.UserID]; ok {
+			who = user.Name
+		}
+		note := ""
+		if newID, ok := restored[entry.Item.ID]; ok {
+			note = colorize(" ↩ restored as "+newID, ColorGreen)
+		}
+		printf("  %s %s %s %-12s %s %s%s\n",
+			colorize(entry.At.Local().Format("2006-01-02 15:04"), ColorGray),
+			icon,
+			state,
+			entry.Item.ID,
+			entry.Item.Name,
+			colorize("👤 "+who, ColorGray),
+			note,
+		)
+	}
+	progressf("💡 Bring an item back with 'task restore <item-id>', deleted items are kept for %d days\n", int(monday.TrashWindow.Hours()/24))
+}
+
+// HandleTaskRestoreCommand brings back an archived or deleted item, listed by 'board trash'.
+// The item is created again with its column values, so it gets a new ID and index.
+func (c *CLI) HandleTaskRestoreCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli " + taskRestoreUsage)
+		fmt.Println("💡 Run 'board trash' to see the IDs of archived and deleted items")
+		os.Exit(ExitValidation)
+	}
+	itemID := c.command.Args[1]
+	boardID := c.config.GetBoardID()
+	client := c.newClient()
+	item, err := client.GetTrashedItem(itemID)
+	if err != nil {
+		exitWithError("Error finding the item", err)
+	}
+	if item.State != "archived" && item.State != "deleted" {
+		fmt.Printf("📭 Item %s is not archived or deleted\n", itemID)
+		return
+	}
+
+	fmt.Printf("Restoring %s item %s: %s\n", item.State, item.ID, item.Name)
+	task, err := client.RestoreTrashedItem(boardID, *item)
+	if errors.Is(err, monday.ErrDryRun) {
+		c.printPredictedCreate(monday.Task{Name: item.Name})
+		return
+	}
+	if err != nil {
+		exitWithError("Error restoring the item", err)
+	}
+	localId := c.cacheCreatedTask(boardID, task)
+	c.recordHistory(monday.HistoryRestore, monday.Task{}, *task, restoredFromPrefix+item.ID)
+	fmt.Printf("✅ Task %s restored with ID %d\n", task.Name, localId)
+	fmt.Println("💡 The restored task is a new item, its updates and files stay with the old one")
+	PrintTask(*task)
+}
+
+// restoredItems maps the IDs of items restored with 'task restore' to the IDs of their new
+// items, read from the history since the old items stay archived or deleted on Monday
+func restoredItems() map[string]string {
+	restored := make(map[string]string)
+	entries, err := monday.ReadHistory()
+	if err != nil {
+		return restored
+	}
+	for _, entry := range entries {
+		// Older histories recorded restores as creations
+		restore := entry.Action == monday.HistoryRestore || entry.Action == monday.HistoryCreate
+		if restore && strings.HasPrefix(entry.Detail, restoredFromPrefix) {
+			restored[strings.TrimPrefix(entry.Detail, restoredFromPrefix)] = entry.TaskID
+		}
+	}
+	return restored
+}
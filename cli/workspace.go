@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"monday-cli/monday"
+	"os"
+)
+
+// HandleWorkspaceCommand navigates workspaces and their boards
+func (c *CLI) HandleWorkspaceCommand() {
+	if len(c.command.Args) == 0 {
+		c.HelpWorkspaceCommand()
+		return
+	}
+	switch c.command.Args[0] {
+	case "list", "ls":
+		c.HandleWorkspaceListCommand()
+	case "boards", "b":
+		c.HandleWorkspaceBoardsCommand()
+	default:
+		c.HelpWorkspaceCommand()
+	}
+}
+
+func (c *CLI) HelpWorkspaceCommand() {
+	fmt.Println("Workspace Commands:")
+	fmt.Println("  workspace (ws) list (ls)                    List the workspaces of the account")
+	fmt.Println("  workspace (ws) boards (b) <workspace-id>    List the active boards of a workspace")
+}
+
+// HandleWorkspaceListCommand lists the workspaces of the account
+func (c *CLI) HandleWorkspaceListCommand() {
+	workspaces, err := monday.NewWorkspaceService(c.newClient()).ListWorkspaces()
+	if err != nil {
+		exitWithError("Error fetching workspaces", err)
+	}
+	if len(workspaces) == 0 {
+		fmt.Println("No workspaces found")
+		return
+	}
+	for _, workspace := range workspaces {
+		kind := workspace.Kind
+		if workspace.State != "" && workspace.State != "active" {
+			kind += ", " + workspace.State
+		}
+		printf("%-12s %-30s %s\n", workspace.ID, workspace.Name, colorize(kind, ColorGray))
+		if workspace.Description != "" {
+			printf("             %s\n", colorize(workspace.Description, ColorGray))
+		}
+	}
+	progressf("💡 Show the boards of a workspace with 'workspace boards <workspace-id>'\n")
+}
+
+// HandleWorkspaceBoardsCommand lists the active boards of a workspace, configured boards are marked
+func (c *CLI) HandleWorkspaceBoardsCommand() {
+	if len(c.command.Args) < 2 {
+		fmt.Println("Usage: monday-cli workspace boards <workspace-id>")
+		os.Exit(ExitValidation)
+	}
+	workspaceID := c.command.Args[1]
+	boards, err := monday.NewWorkspaceService(c.newClient()).GetWorkspaceBoards(workspaceID)
+	if err != nil {
+		exitWithError("Error fetching boards", err)
+	}
+	if len(boards) == 0 {
+		fmt.Printf("No boards found in workspace %s\n", workspaceID)
+		return
+	}
+	for _, board := range boards {
+		marker := "  "
+		if _, ok := c.config.FindBoard(board.ID); ok {
+			marker = colorize("* ", ColorGreen)
+		}
+		printf("%s%-12s %-30s %s\n", marker, board.ID, board.Name, colorize(fmt.Sprintf("%d items", board.ItemsCount), ColorGray))
+	}
+	progressf("💡 Fetch a board's tasks with 'tasks fetch -board <board-id>', or configure it with 'config add-board <board-id>'\n")
+}
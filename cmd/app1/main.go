@@ -7,13 +7,11 @@ import (
 )
 
 func main() {
-	fmt.Println("Starting Monday CLI...")
 	c := cli.NewCLI()
 	if c == nil {
 		fmt.Println("Error creating CLI")
 		os.Exit(1)
 	}
-	fmt.Println("CLI created successfully")
 	cmd := cli.Command{}
 	cmd.Command = "tasks"
 	cmd.Args = make([]string, 1)
@@ -8,13 +8,10 @@ import (
 )
 
 func main() {
-	fmt.Println("Starting Monday CLI...")
 	c := cli.NewCLI()
 	if c == nil {
 		fmt.Println("Error creating CLI")
 		os.Exit(1)
 	}
-	fmt.Println("CLI created successfully")
 	c.HandleCommand()
-	fmt.Println("done")
 }
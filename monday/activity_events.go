@@ -0,0 +1,254 @@
+package monday
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Activity log event names as Monday writes them
+const (
+	eventItemCreated       = "create_pulse"
+	eventItemDeleted       = "delete_pulse"
+	eventItemArchived      = "archive_pulse"
+	eventItemRenamed       = "update_name"
+	eventItemMovedToGroup  = "move_pulse_into_group"
+	eventColumnValueChange = "update_column_value"
+)
+
+// ActivityEvent is an activity log entry decoded into a typed event
+type ActivityEvent interface {
+	Info() EventInfo
+	Describe() string
+}
+
+// EventInfo holds the fields shared by all activity events
+type EventInfo struct {
+	LogID    string
+	Event    string
+	UserID   string
+	At       time.Time
+	ItemID   string
+	ItemName string
+}
+
+// Info returns the shared fields, it makes every event embedding EventInfo an ActivityEvent
+func (e EventInfo) Info() EventInfo {
+	return e
+}
+
+// ColumnValueChanged is written when a column value of an item changes
+type ColumnValueChanged struct {
+	EventInfo
+	ColumnID    string
+	ColumnTitle string
+	ColumnType  string
+	From        string
+	To          string
+}
+
+func (e ColumnValueChanged) Describe() string {
+	title := e.ColumnTitle
+	if title == "" {
+		title = e.ColumnID
+	}
+	return fmt.Sprintf("%s: %s → %s", title, emptyValue(e.From), emptyValue(e.To))
+}
+
+// ItemCreated is written when an item is created in a group
+type ItemCreated struct {
+	EventInfo
+	GroupID   string
+	GroupName string
+}
+
+func (e ItemCreated) Describe() string {
+	if e.GroupName == "" {
+		return "created"
+	}
+	return fmt.Sprintf("created in %s", e.GroupName)
+}
+
+// ItemMovedToGroup is written when an item is moved to another group of the board
+type ItemMovedToGroup struct {
+	EventInfo
+	FromGroup string
+	ToGroup   string
+}
+
+func (e ItemMovedToGroup) Describe() string {
+	return fmt.Sprintf("moved from %s to %s", emptyValue(e.FromGroup), emptyValue(e.ToGroup))
+}
+
+// ItemRenamed is written when the name of an item changes
+type ItemRenamed struct {
+	EventInfo
+	From string
+	To   string
+}
+
+func (e ItemRenamed) Describe() string {
+	return fmt.Sprintf("renamed from %q", e.From)
+}
+
+// ItemDeleted is written when an item is deleted, or archived when Archived is set
+type ItemDeleted struct {
+	EventInfo
+	Archived bool
+}
+
+func (e ItemDeleted) Describe() string {
+	if e.Archived {
+		return "archived"
+	}
+	return "deleted"
+}
+
+// UnknownEvent keeps entries of events that have no typed model yet
+type UnknownEvent struct {
+	EventInfo
+	Data string
+}
+
+func (e UnknownEvent) Describe() string {
+	return strings.ReplaceAll(e.Event, "_", " ")
+}
+
+// activityData is the union of the payload fields of the decoded events
+type activityData struct {
+	PulseID       json.Number     `json:"pulse_id"`
+	PulseName     string          `json:"pulse_name"`
+	GroupID       string          `json:"group_id"`
+	GroupName     string          `json:"group_name"`
+	ColumnID      string          `json:"column_id"`
+	ColumnTitle   string          `json:"column_title"`
+	ColumnType    string          `json:"column_type"`
+	Value         json.RawMessage `json:"value"`
+	PreviousValue json.RawMessage `json:"previous_value"`
+	Pulse         *struct {
+		ID   json.Number `json:"id"`
+		Name string      `json:"name"`
+	} `json:"pulse"`
+	SourceGroup *struct {
+		Title string `json:"title"`
+	} `json:"source_group"`
+	DestGroup *struct {
+		Title string `json:"title"`
+	} `json:"dest_group"`
+}
+
+// DecodeActivityLog decodes an activity log entry into its typed event.
+// Entries with an unknown event or a payload that cannot be decoded become an UnknownEvent.
+func DecodeActivityLog(entry ActivityLog) ActivityEvent {
+	info := EventInfo{LogID: entry.ID, Event: entry.Event, UserID: entry.UserID, At: entry.Time()}
+	var data activityData
+	decoder := json.NewDecoder(strings.NewReader(entry.Data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		logger.Debug("undecodable activity log entry", "id", entry.ID, "error", err)
+		return UnknownEvent{EventInfo: info, Data: entry.Data}
+	}
+	info.ItemID = data.PulseID.String()
+	info.ItemName = data.PulseName
+	if data.Pulse != nil {
+		info.ItemID = data.Pulse.ID.String()
+		info.ItemName = data.Pulse.Name
+	}
+
+	switch entry.Event {
+	case eventColumnValueChange:
+		return ColumnValueChanged{
+			EventInfo:   info,
+			ColumnID:    data.ColumnID,
+			ColumnTitle: data.ColumnTitle,
+			ColumnType:  data.ColumnType,
+			From:        activityValueText(data.PreviousValue),
+			To:          activityValueText(data.Value),
+		}
+	case eventItemCreated:
+		return ItemCreated{EventInfo: info, GroupID: data.GroupID, GroupName: data.GroupName}
+	case eventItemMovedToGroup:
+		event := ItemMovedToGroup{EventInfo: info}
+		if data.SourceGroup != nil {
+			event.FromGroup = data.SourceGroup.Title
+		}
+		if data.DestGroup != nil {
+			event.ToGroup = data.DestGroup.Title
+		}
+		return event
+	case eventItemRenamed:
+		return ItemRenamed{EventInfo: info, From: activityValueText(data.PreviousValue), To: activityValueText(data.Value)}
+	case eventItemDeleted:
+		return ItemDeleted{EventInfo: info}
+	case eventItemArchived:
+		return ItemDeleted{EventInfo: info, Archived: true}
+	}
+	return UnknownEvent{EventInfo: info, Data: entry.Data}
+}
+
+// DecodeActivityLogs decodes activity log entries into typed events, oldest first
+func DecodeActivityLogs(logs []ActivityLog) []ActivityEvent {
+	events := make([]ActivityEvent, 0, len(logs))
+	for _, entry := range logs {
+		events = append(events, DecodeActivityLog(entry))
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Info().At.Before(events[j].Info().At) })
+	return events
+}
+
+// activityValueText turns the value of a change into text. The shape depends on the column type:
+// labels of status columns, plain values of text and number columns, dates, names, or anything
+// else as compact JSON.
+func activityValueText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var value struct {
+		Label *struct {
+			Text string `json:"text"`
+		} `json:"label"`
+		Value *json.RawMessage `json:"value"`
+		Text  string           `json:"text"`
+		Date  string           `json:"date"`
+		Name  string           `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var text string
+		if json.Unmarshal(raw, &text) == nil {
+			return text
+		}
+		return string(raw)
+	}
+	switch {
+	case value.Label != nil:
+		return value.Label.Text
+	case value.Text != "":
+		return value.Text
+	case value.Date != "":
+		return value.Date
+	case value.Name != "":
+		return value.Name
+	case value.Value != nil:
+		var text string
+		if json.Unmarshal(*value.Value, &text) == nil {
+			return text
+		}
+		return string(*value.Value)
+	}
+	var compact bytes.Buffer
+	if json.Compact(&compact, raw) != nil {
+		return string(raw)
+	}
+	return compact.String()
+}
+
+// emptyValue shows an empty value as a dash
+func emptyValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
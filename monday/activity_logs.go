@@ -0,0 +1,64 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// activityLogsPageSize is the number of activity log entries requested per page
+const activityLogsPageSize = 500
+
+// GetActivityLogs retrieves the activity log of a board since the given time using pagination.
+// Monday returns the newest entries first.
+func (c *Client) GetActivityLogs(boardID string, from time.Time) ([]ActivityLog, error) {
+	var logs []ActivityLog
+	for page := 1; ; page++ {
+		query := buildQuery("GetActivityLogs", "$boardId: ID!, $from: ISO8601DateTime, $limit: Int!, $page: Int!",
+			boardByID([]field{
+				newField("activity_logs", scalars("id", "event", "entity", "user_id", "data", "created_at")).
+					withArgs("from: $from, limit: $limit, page: $page"),
+			}),
+		)
+		variables := map[string]interface{}{
+			"boardId": boardID,
+			"from":    from.UTC().Format(time.RFC3339),
+			"limit":   activityLogsPageSize,
+			"page":    page,
+		}
+		resp, err := c.ExecuteQuery(query, variables)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Boards []struct {
+				ActivityLogs []ActivityLog `json:"activity_logs"`
+			} `json:"boards"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal activity logs: %w", err)
+		}
+		if len(result.Boards) == 0 {
+			return nil, fmt.Errorf("board %s %w", boardID, ErrNotFound)
+		}
+		entries := result.Boards[0].ActivityLogs
+		logs = append(logs, entries...)
+		if len(entries) < activityLogsPageSize {
+			logger.Info("fetched activity logs", "board", boardID, "entries", len(logs))
+			return logs, nil
+		}
+	}
+}
+
+// Time converts created_at to a time. Monday sends a unix timestamp with 17 digits,
+// counting 100 nanosecond units; RFC3339 is accepted as well.
+func (l ActivityLog) Time() time.Time {
+	if units, err := strconv.ParseInt(l.CreatedAt, 10, 64); err == nil {
+		return time.Unix(0, units*100)
+	}
+	if t, err := time.Parse(time.RFC3339, l.CreatedAt); err == nil {
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,256 @@
+package monday
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// digestPeriod is how far back a weekly digest looks for completed tasks and new bugs
+const digestPeriod = 7 * 24 * time.Hour
+
+// AnalyticsService computes reports from the cached tasks of a board and its activity log
+type AnalyticsService struct {
+	client    *Client
+	dataStore *DataStore
+}
+
+// NewAnalyticsService creates a new analytics service. The client is only used for reports
+// that need the activity log and may be nil otherwise.
+func NewAnalyticsService(client *Client, dataStore *DataStore) *AnalyticsService {
+	return &AnalyticsService{client: client, dataStore: dataStore}
+}
+
+// BoardTasks returns the cached tasks of a board ordered by local ID
+func (as *AnalyticsService) BoardTasks(boardID string) ([]Task, error) {
+	cached, _, ok := as.dataStore.GetCachedTasks(boardID)
+	if !ok {
+		return nil, fmt.Errorf("cached tasks %w", ErrNotFound)
+	}
+	tasks := make([]Task, 0, len(cached))
+	for _, task := range cached {
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].LocalId < tasks[j].LocalId })
+	return tasks, nil
+}
+
+// SprintTasks returns the cached tasks of a board that belong to the named sprint
+func (as *AnalyticsService) SprintTasks(boardID, sprintName string) ([]Task, error) {
+	tasks, err := as.BoardTasks(boardID)
+	if err != nil {
+		return nil, err
+	}
+	sprintTasks := tasksInSprint(tasks, sprintName)
+	if len(sprintTasks) == 0 {
+		return nil, fmt.Errorf("tasks in sprint %s %w", sprintName, ErrNotFound)
+	}
+	return sprintTasks, nil
+}
+
+// Capacity sums the estimates per assignee for the named sprint
+func (as *AnalyticsService) Capacity(boardID, sprintName string) ([]Capacity, []Task, error) {
+	tasks, err := as.SprintTasks(boardID, sprintName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return SummarizeCapacity(tasks), tasks, nil
+}
+
+// AssigneeCount counts the sprint tasks of one person
+type AssigneeCount struct {
+	Assignee string
+	Done     int
+	Open     int
+}
+
+// Total returns the number of tasks assigned to the person
+func (a AssigneeCount) Total() int {
+	return a.Done + a.Open
+}
+
+// WeeklyDigest summarizes the past week of a sprint
+type WeeklyDigest struct {
+	Board       string
+	Sprint      string
+	From        time.Time
+	To          time.Time
+	Completed   []Task // Sprint tasks done within the period
+	CarriedOver []Task // Sprint tasks that are not done yet
+	NewBugs     []Task // Bugs on the board created within the period
+	Assignees   []AssigneeCount
+	TotalTasks  int
+}
+
+// WeeklyDigest builds the digest of the named sprint for the week before now.
+// New bugs are taken from the whole board since they are often not planned into a sprint yet.
+func (as *AnalyticsService) WeeklyDigest(boardID, sprintName string, now time.Time) (*WeeklyDigest, error) {
+	tasks, err := as.BoardTasks(boardID)
+	if err != nil {
+		return nil, err
+	}
+	sprintTasks := tasksInSprint(tasks, sprintName)
+	if len(sprintTasks) == 0 {
+		return nil, fmt.Errorf("tasks in sprint %s %w", sprintName, ErrNotFound)
+	}
+
+	digest := &WeeklyDigest{
+		Sprint:     sprintTasks[0].Sprint,
+		From:       now.Add(-digestPeriod),
+		To:         now,
+		Assignees:  countByAssignee(sprintTasks),
+		TotalTasks: len(sprintTasks),
+	}
+	for _, task := range sprintTasks {
+		if !IsDoneStatus(task.Status) {
+			digest.CarriedOver = append(digest.CarriedOver, task)
+		} else if !task.UpdatedAt.Before(digest.From) {
+			digest.Completed = append(digest.Completed, task)
+		}
+	}
+	for _, task := range tasks {
+		if isBugType(task.Type) && !task.CreatedAt.IsZero() && !task.CreatedAt.Before(digest.From) {
+			digest.NewBugs = append(digest.NewBugs, task)
+		}
+	}
+	return digest, nil
+}
+
+// CycleTimes computes the time in status of the tasks whose status changed since the given time.
+// Cached tasks supply local IDs; tasks missing from the cache are named from the activity log.
+func (as *AnalyticsService) CycleTimes(boardID string, since, now time.Time) ([]TaskCycle, error) {
+	logs, err := as.client.GetActivityLogs(boardID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity logs of board %s: %w", boardID, err)
+	}
+	cached, _, _ := as.dataStore.GetCachedTasks(boardID)
+	return CycleTimes(ParseStatusChanges(logs), cached, now), nil
+}
+
+// BoardActivity returns the activity log of a board since the given time as typed events, oldest first
+func (as *AnalyticsService) BoardActivity(boardID string, since time.Time) ([]ActivityEvent, error) {
+	logs, err := as.client.GetActivityLogs(boardID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity logs of board %s: %w", boardID, err)
+	}
+	return DecodeActivityLogs(logs), nil
+}
+
+// StaleGroup lists the stale tasks of one assignee, least recently updated first
+type StaleGroup struct {
+	Assignee string
+	Tasks    []Task
+}
+
+// StaleTasks returns the tasks in an active status that were not updated for the given
+// number of days, grouped by assignee with the most stale tasks first. Tasks with several
+// assignees are listed for each of them.
+func (as *AnalyticsService) StaleTasks(boardID string, days int, now time.Time) ([]StaleGroup, error) {
+	tasks, err := as.BoardTasks(boardID)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := now.AddDate(0, 0, -days)
+
+	byAssignee := make(map[string]*StaleGroup)
+	for _, task := range tasks {
+		if !IsActiveStatus(task.Status) || task.UpdatedAt.IsZero() || !task.UpdatedAt.Before(cutoff) {
+			continue
+		}
+		for _, assignee := range assigneeNames(task) {
+			group, ok := byAssignee[assignee]
+			if !ok {
+				group = &StaleGroup{Assignee: assignee}
+				byAssignee[assignee] = group
+			}
+			group.Tasks = append(group.Tasks, task)
+		}
+	}
+
+	groups := make([]StaleGroup, 0, len(byAssignee))
+	for _, group := range byAssignee {
+		sort.SliceStable(group.Tasks, func(i, j int) bool {
+			return group.Tasks[i].UpdatedAt.Before(group.Tasks[j].UpdatedAt)
+		})
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].Tasks) != len(groups[j].Tasks) {
+			return len(groups[i].Tasks) > len(groups[j].Tasks)
+		}
+		return groups[i].Assignee < groups[j].Assignee
+	})
+	return groups, nil
+}
+
+// IsActiveStatus reports whether a task is being worked on: it has a status that is
+// neither done nor removed
+func IsActiveStatus(status Status) bool {
+	if status == StatusNone || IsDoneStatus(status) {
+		return false
+	}
+	return !strings.Contains(strings.ToLower(string(status)), "removed")
+}
+
+// tasksInSprint returns the tasks whose sprint matches the name case-insensitively
+func tasksInSprint(tasks []Task, sprintName string) []Task {
+	var sprintTasks []Task
+	for _, task := range tasks {
+		if strings.EqualFold(task.Sprint, sprintName) {
+			sprintTasks = append(sprintTasks, task)
+		}
+	}
+	return sprintTasks
+}
+
+// countByAssignee counts done and open tasks per assignee, most tasks first.
+// Tasks with several assignees count for each of them.
+func countByAssignee(tasks []Task) []AssigneeCount {
+	byAssignee := make(map[string]*AssigneeCount)
+	for _, task := range tasks {
+		for _, assignee := range assigneeNames(task) {
+			count, ok := byAssignee[assignee]
+			if !ok {
+				count = &AssigneeCount{Assignee: assignee}
+				byAssignee[assignee] = count
+			}
+			if IsDoneStatus(task.Status) {
+				count.Done++
+			} else {
+				count.Open++
+			}
+		}
+	}
+
+	counts := make([]AssigneeCount, 0, len(byAssignee))
+	for _, count := range byAssignee {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Total() != counts[j].Total() {
+			return counts[i].Total() > counts[j].Total()
+		}
+		return counts[i].Assignee < counts[j].Assignee
+	})
+	return counts
+}
+
+// assigneeNames splits the people of a task, tasks without anyone count as "Unassigned"
+func assigneeNames(task Task) []string {
+	var names []string
+	for _, name := range strings.Split(task.UserName, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return []string{"Unassigned"}
+	}
+	return names
+}
+
+// isBugType reports whether a task type describes a bug
+func isBugType(taskType Type) bool {
+	return strings.Contains(strings.ToLower(string(taskType)), "bug")
+}
@@ -0,0 +1,78 @@
+package monday
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// AuthProviderType selects where the API token comes from
+type AuthProviderType string
+
+const (
+	AuthStatic  AuthProviderType = "static"  // The api_key from the config file
+	AuthCommand AuthProviderType = "command" // The output of a command, e.g. "pass show monday"
+	AuthOAuth   AuthProviderType = "oauth"   // The token of an OAuth app saved by 'auth login'
+)
+
+// AuthProvider supplies the token sent in the Authorization header
+type AuthProvider interface {
+	Token() (string, error)
+}
+
+// StaticTokenProvider returns a fixed token
+type StaticTokenProvider struct {
+	token string
+}
+
+// NewStaticTokenProvider creates a provider for a fixed token
+func NewStaticTokenProvider(token string) *StaticTokenProvider {
+	return &StaticTokenProvider{token: token}
+}
+
+// Token returns the fixed token
+func (p *StaticTokenProvider) Token() (string, error) {
+	if p.token == "" {
+		return "", fmt.Errorf("no API key configured")
+	}
+	return p.token, nil
+}
+
+// CommandTokenProvider runs a command and uses its trimmed output as token.
+// The command only runs once per process, its output is kept in memory.
+type CommandTokenProvider struct {
+	command string
+	once    sync.Once
+	token   string
+	err     error
+}
+
+// NewCommandTokenProvider creates a provider that reads the token from a shell command
+func NewCommandTokenProvider(command string) *CommandTokenProvider {
+	return &CommandTokenProvider{command: command}
+}
+
+// Token runs the command on first use and returns its output
+func (p *CommandTokenProvider) Token() (string, error) {
+	p.once.Do(func() {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", p.command)
+		} else {
+			cmd = exec.Command("sh", "-c", p.command)
+		}
+		output, err := cmd.Output()
+		if err != nil {
+			p.err = fmt.Errorf("token command %q failed: %w", p.command, err)
+			return
+		}
+		// Only the first line is used, like "pass show" which may list extra fields below
+		p.token = strings.TrimSpace(strings.SplitN(string(output), "\n", 2)[0])
+		if p.token == "" {
+			p.err = fmt.Errorf("token command %q returned no output", p.command)
+		}
+	})
+	return p.token, p.err
+}
@@ -0,0 +1,83 @@
+package monday
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BatchLine is a task of a batch: a line holding only the name, or a JSON object with the
+// fields. Fields are kept as written, empty ones take the values shared by the batch.
+type BatchLine struct {
+	Line     int    `json:"-"` // Line number in the input, starting at 1
+	Name     string `json:"name"`
+	Status   string `json:"status,omitempty"`
+	Priority string `json:"priority,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Assignee string `json:"assignee,omitempty"`
+	Sprint   string `json:"sprint,omitempty"`
+	Due      string `json:"due,omitempty"`
+}
+
+// ReadBatchLines reads one task per line, blank lines are skipped. Lines starting with { are
+// JSON objects with a name and optionally status, priority, type, assignee, sprint and due.
+func ReadBatchLines(r io.Reader) ([]BatchLine, error) {
+	var lines []BatchLine
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for number := 1; scanner.Scan(); number++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		line := BatchLine{Name: text}
+		if strings.HasPrefix(text, "{") {
+			line = BatchLine{}
+			decoder := json.NewDecoder(bytes.NewReader([]byte(text)))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&line); err != nil {
+				return nil, fmt.Errorf("line %d: %w", number, err)
+			}
+			line.Name = strings.TrimSpace(line.Name)
+			if line.Name == "" {
+				return nil, fmt.Errorf("line %d: no name", number)
+			}
+		}
+		line.Line = number
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tasks: %w", err)
+	}
+	return lines, nil
+}
+
+// WithDefaults returns the line with its empty fields taken from defaults
+func (l BatchLine) WithDefaults(defaults BatchLine) BatchLine {
+	for _, field := range []struct{ value, fallback *string }{
+		{&l.Status, &defaults.Status},
+		{&l.Priority, &defaults.Priority},
+		{&l.Type, &defaults.Type},
+		{&l.Assignee, &defaults.Assignee},
+		{&l.Sprint, &defaults.Sprint},
+		{&l.Due, &defaults.Due},
+	} {
+		if *field.value == "" {
+			*field.value = *field.fallback
+		}
+	}
+	return l
+}
+
+// NewItemInput validates the details of a new task against the board, like
+// CreateTaskWithDetails, and returns the item to create with CreateItems
+func NewItemInput(board *Board, name string, details TaskDetails) (ItemInput, error) {
+	values, err := taskColumnValues(board, details)
+	if err != nil {
+		return ItemInput{}, err
+	}
+	return ItemInput{Name: name, Values: values}, nil
+}
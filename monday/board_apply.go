@@ -0,0 +1,148 @@
+package monday
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BoardPlanAction is what a step of a board plan does
+type BoardPlanAction string
+
+const (
+	PlanAddColumn   BoardPlanAction = "add column"
+	PlanAddGroup    BoardPlanAction = "add group"
+	PlanUpdateBoard BoardPlanAction = "update board"
+	PlanManual      BoardPlanAction = "manual" // A difference the API cannot change, left to the board settings
+)
+
+// BoardPlanStep is a difference between a board and its spec
+type BoardPlanStep struct {
+	Action    BoardPlanAction
+	Column    ColumnSpec // Column to add, or the column of a manual step
+	Group     string     // Group to add
+	Attribute string     // Board attribute to update: name or description
+	Old, New  string     // Attribute values
+	Detail    string     // What to change by hand for manual steps
+}
+
+// BoardPlan is the list of changes that make a board match a spec
+type BoardPlan struct {
+	Board     *Board
+	Steps     []BoardPlanStep
+	Unmanaged []Column // Columns of the board the spec does not name, left as they are
+}
+
+// Applicable returns the number of steps board apply makes, manual steps are not counted
+func (p *BoardPlan) Applicable() int {
+	count := 0
+	for _, step := range p.Steps {
+		if step.Action != PlanManual {
+			count++
+		}
+	}
+	return count
+}
+
+// PlanBoardSpec compares a board and its groups with a spec. Columns are matched by title and
+// groups by title, ignoring case. Missing columns and groups are added and the name and
+// description updated, column types and labels are only reported as the API cannot change them.
+func PlanBoardSpec(board *Board, groups []Group, spec *BoardSpec) *BoardPlan {
+	plan := &BoardPlan{Board: board}
+	if spec.Name != "" && spec.Name != board.Name {
+		plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanUpdateBoard, Attribute: "name", Old: board.Name, New: spec.Name})
+	}
+	if spec.Description != "" && spec.Description != board.Description {
+		plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanUpdateBoard, Attribute: "description", Old: board.Description, New: spec.Description})
+	}
+
+	managed := make(map[string]bool)
+	for _, columnSpec := range spec.Columns {
+		index := slices.IndexFunc(board.Columns, func(column Column) bool { return strings.EqualFold(column.Title, columnSpec.Title) })
+		if index < 0 {
+			plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanAddColumn, Column: columnSpec})
+			continue
+		}
+		column := board.Columns[index]
+		managed[column.ID] = true
+		if column.Type != columnSpec.Type {
+			plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanManual, Column: columnSpec,
+				Detail: fmt.Sprintf("is a %s column on the board, the spec says %s", column.Type, columnSpec.Type)})
+			continue
+		}
+		settings, err := ParseColumnSettings(column)
+		if err != nil {
+			logger.Debug("skipping settings of column", "column", column.ID, "error", err)
+			continue
+		}
+		var missing []string
+		for _, label := range columnSpec.Labels {
+			if !slices.ContainsFunc(settings.LabelNames(), func(name string) bool { return strings.EqualFold(name, label) }) {
+				missing = append(missing, label)
+			}
+		}
+		if len(missing) > 0 {
+			plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanManual, Column: columnSpec,
+				Detail: "add the labels " + strings.Join(missing, ", ")})
+		}
+		if columnSpec.LinkedBoardID != "" && !slices.Contains(settings.BoardIDs, columnSpec.LinkedBoardID) {
+			plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanManual, Column: columnSpec,
+				Detail: "link board " + columnSpec.LinkedBoardID})
+		}
+	}
+	for _, column := range board.Columns {
+		if !managed[column.ID] && column.Type != "name" && column.Type != "subtasks" {
+			plan.Unmanaged = append(plan.Unmanaged, column)
+		}
+	}
+
+	for _, title := range spec.Groups {
+		if _, ok := FindGroup(groups, title); !ok {
+			plan.Steps = append(plan.Steps, BoardPlanStep{Action: PlanAddGroup, Group: title})
+		}
+	}
+	return plan
+}
+
+// PlanBoard fetches a board with its groups and compares it with a spec, see PlanBoardSpec
+func (bs *BoardService) PlanBoard(boardID string, spec *BoardSpec) (*BoardPlan, error) {
+	board, err := bs.client.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board %s: %w", boardID, err)
+	}
+	groups, err := bs.client.GetBoardGroups(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups of board %s: %w", boardID, err)
+	}
+	return PlanBoardSpec(board, groups, spec), nil
+}
+
+// ApplyBoardPlan makes the applicable changes of a plan one after the other, reporting each to
+// progress. It stops at the first failure, with a dry run client the mutations are printed.
+func (bs *BoardService) ApplyBoardPlan(plan *BoardPlan, progress func(BoardPlanStep, error)) error {
+	boardID := plan.Board.ID
+	for _, step := range plan.Steps {
+		var err error
+		switch step.Action {
+		case PlanAddColumn:
+			var defaults string
+			if defaults, err = step.Column.columnDefaults(); err == nil {
+				_, err = bs.client.CreateColumn(boardID, step.Column.Title, step.Column.Type, defaults)
+			}
+		case PlanAddGroup:
+			_, err = bs.client.CreateGroup(boardID, step.Group)
+		case PlanUpdateBoard:
+			err = bs.client.UpdateBoard(boardID, step.Attribute, step.New)
+		default:
+			continue
+		}
+		if progress != nil {
+			progress(step, err)
+		}
+		if err != nil && !errors.Is(err, ErrDryRun) {
+			return err
+		}
+	}
+	return nil
+}
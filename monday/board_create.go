@@ -0,0 +1,187 @@
+package monday
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CreateBoard creates an empty board in a workspace, the main workspace when workspaceID is
+// empty. kind is public, private or share.
+func (c *Client) CreateBoard(name, kind, workspaceID string) (*Board, error) {
+	vars, args := "$name: String!, $kind: BoardKind!", "board_name: $name, board_kind: $kind, empty: true"
+	variables := map[string]interface{}{"name": name, "kind": kind}
+	if workspaceID != "" {
+		vars += ", $workspaceId: ID"
+		args += ", workspace_id: $workspaceId"
+		variables["workspaceId"] = workspaceID
+	}
+	query := buildOperation("mutation", "CreateBoard", vars, newField("create_board", scalars("id", "name")).withArgs(args))
+	var result struct {
+		CreateBoard Board `json:"create_board"`
+	}
+	if err := c.execute(c.ctx, query, variables, &result); err != nil {
+		return nil, fmt.Errorf("failed to create board %s: %w", name, err)
+	}
+	logger.Info("created board", "board", result.CreateBoard.ID, "name", name)
+	return &result.CreateBoard, nil
+}
+
+// UpdateBoard changes an attribute of a board: name, description or communication
+func (c *Client) UpdateBoard(boardID, attribute, value string) error {
+	query := buildOperation("mutation", "UpdateBoard", "$boardId: ID!, $attribute: BoardAttributes!, $value: String!",
+		newField("update_board").withArgs("board_id: $boardId, board_attribute: $attribute, new_value: $value"),
+	)
+	variables := map[string]interface{}{"boardId": boardID, "attribute": attribute, "value": value}
+	if err := c.execute(c.ctx, query, variables, nil); err != nil {
+		return fmt.Errorf("failed to update %s of board %s: %w", attribute, boardID, err)
+	}
+	return nil
+}
+
+// CreateColumn adds a column to a board. defaults is the JSON of the column settings, e.g.
+// the labels of a status column, none when empty.
+func (c *Client) CreateColumn(boardID, title, columnType, defaults string) (*Column, error) {
+	vars, args := "$boardId: ID!, $title: String!, $type: ColumnType!", "board_id: $boardId, title: $title, column_type: $type"
+	variables := map[string]interface{}{"boardId": boardID, "title": title, "type": columnType}
+	if defaults != "" {
+		vars += ", $defaults: JSON"
+		args += ", defaults: $defaults"
+		variables["defaults"] = defaults
+	}
+	query := buildOperation("mutation", "CreateColumn", vars, newField("create_column", scalars("id", "title", "type")).withArgs(args))
+	var result struct {
+		CreateColumn Column `json:"create_column"`
+	}
+	if err := c.execute(c.ctx, query, variables, &result); err != nil {
+		return nil, fmt.Errorf("failed to create column %s: %w", title, err)
+	}
+	return &result.CreateColumn, nil
+}
+
+// DeleteColumn removes a column and its values from a board
+func (c *Client) DeleteColumn(boardID, columnID string) error {
+	query := buildOperation("mutation", "DeleteColumn", "$boardId: ID!, $columnId: String!",
+		newField("delete_column", scalars("id")).withArgs("board_id: $boardId, column_id: $columnId"),
+	)
+	if err := c.execute(c.ctx, query, map[string]interface{}{"boardId": boardID, "columnId": columnID}, nil); err != nil {
+		return fmt.Errorf("failed to delete column %s: %w", columnID, err)
+	}
+	return nil
+}
+
+// CreateGroup adds a group to a board, below the existing ones
+func (c *Client) CreateGroup(boardID, title string) (*Group, error) {
+	query := buildOperation("mutation", "CreateGroup", "$boardId: ID!, $name: String!",
+		newField("create_group", scalars("id", "title")).withArgs("board_id: $boardId, group_name: $name, position_relative_method: after_at"),
+	)
+	var result struct {
+		CreateGroup Group `json:"create_group"`
+	}
+	if err := c.execute(c.ctx, query, map[string]interface{}{"boardId": boardID, "name": title}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create group %s: %w", title, err)
+	}
+	return &result.CreateGroup, nil
+}
+
+// DeleteGroup removes a group and its items from a board
+func (c *Client) DeleteGroup(boardID, groupID string) error {
+	query := buildOperation("mutation", "DeleteGroup", "$boardId: ID!, $groupId: String!",
+		newField("delete_group", scalars("id")).withArgs("board_id: $boardId, group_id: $groupId"),
+	)
+	if err := c.execute(c.ctx, query, map[string]interface{}{"boardId": boardID, "groupId": groupID}, nil); err != nil {
+		return fmt.Errorf("failed to delete group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// dryRunBoardID stands for the board a dry run would create, in the printed mutations
+const dryRunBoardID = "NEW_BOARD_ID"
+
+// CreateBoardFromSpec creates a board with the columns and groups of a spec. The columns and
+// groups Monday adds to new boards are removed, so the board has only those of the spec. When
+// a step fails the board created so far is returned with the error. With a dry run client the
+// mutations are printed and ErrDryRun is returned.
+func (bs *BoardService) CreateBoardFromSpec(spec *BoardSpec) (*Board, error) {
+	if spec.Name == "" {
+		return nil, fmt.Errorf("board spec has no name")
+	}
+	kind := spec.Kind
+	if kind == "" {
+		kind = "public"
+	}
+	board, err := bs.client.CreateBoard(spec.Name, kind, spec.WorkspaceID)
+	dryRun := errors.Is(err, ErrDryRun)
+	if dryRun {
+		board, err = &Board{ID: dryRunBoardID, Name: spec.Name}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Defaults of the new board, removed once the spec's columns and groups exist
+	var defaults Board
+	if !dryRun {
+		if fresh, err := bs.client.GetBoard(board.ID); err == nil {
+			defaults.Columns = fresh.Columns
+		}
+		if groups, err := bs.client.GetBoardGroups(board.ID); err == nil {
+			defaults.Groups = groups
+		}
+	}
+
+	if spec.Description != "" {
+		err := bs.client.UpdateBoard(board.ID, "description", spec.Description)
+		if err != nil && !(dryRun && errors.Is(err, ErrDryRun)) {
+			return board, err
+		}
+	}
+	for _, columnSpec := range spec.Columns {
+		columnDefaults, err := columnSpec.columnDefaults()
+		if err != nil {
+			return board, err
+		}
+		column, err := bs.client.CreateColumn(board.ID, columnSpec.Title, columnSpec.Type, columnDefaults)
+		if dryRun && errors.Is(err, ErrDryRun) {
+			continue
+		}
+		if err != nil {
+			return board, err
+		}
+		board.Columns = append(board.Columns, *column)
+	}
+	for _, title := range spec.Groups {
+		group, err := bs.client.CreateGroup(board.ID, title)
+		if dryRun && errors.Is(err, ErrDryRun) {
+			continue
+		}
+		if err != nil {
+			return board, err
+		}
+		board.Groups = append(board.Groups, *group)
+	}
+	if dryRun {
+		return board, ErrDryRun
+	}
+
+	for _, column := range defaults.Columns {
+		if column.Type == "name" || column.Type == "subtasks" {
+			continue // Every board has a name column, subitems come with their own board
+		}
+		if err := bs.client.DeleteColumn(board.ID, column.ID); err != nil {
+			logger.Warn("failed to remove default column", "board", board.ID, "column", column.Title, "error", err)
+		}
+	}
+	// Groups are only removed when the spec has its own, a board needs at least one
+	if len(board.Groups) > 0 {
+		for _, group := range defaults.Groups {
+			if err := bs.client.DeleteGroup(board.ID, group.ID); err != nil {
+				logger.Warn("failed to remove default group", "board", board.ID, "group", group.Title, "error", err)
+			}
+		}
+	} else {
+		board.Groups = defaults.Groups
+	}
+	logger.Info("provisioned board", "board", board.ID, "columns", len(board.Columns), "groups", strings.Join(spec.Groups, ", "))
+	return board, nil
+}
@@ -0,0 +1,209 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// BoardSpec describes a board: its columns with their labels and its groups. It creates a
+// board with board create and is applied to an existing one with board apply.
+type BoardSpec struct {
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Kind        string       `json:"kind,omitempty"` // public, private or share, public when empty
+	WorkspaceID string       `json:"workspace_id,omitempty"`
+	Groups      []string     `json:"groups,omitempty"` // Top group first
+	Columns     []ColumnSpec `json:"columns"`
+}
+
+// ColumnSpec is a column of a board spec. Labels are the labels of status and dropdown
+// columns, LinkedBoardID the board a board_relation column links to.
+type ColumnSpec struct {
+	Title         string   `json:"title"`
+	Type          string   `json:"type"`
+	Labels        []string `json:"labels,omitempty"`
+	LinkedBoardID string   `json:"linked_board_id,omitempty"`
+}
+
+// SpecColumnTypes are the column types a board spec can create
+var SpecColumnTypes = []string{
+	"status", "dropdown", "people", "date", "timeline", "numbers", "text", "long_text",
+	"board_relation", "link", "tags", "checkbox", "email", "phone", "rating",
+}
+
+// SprintBoardDefault in the sprint field of a YAML spec links the Sprint column to the
+// configured sprint board
+const SprintBoardDefault = "default"
+
+// LoadBoardSpec reads a board spec, JSON for .json files and a flat YAML subset otherwise:
+//
+//	name: Team board
+//	description: Tasks of the web team
+//	groups: Backlog, This sprint, Done
+//	owner: Owner
+//	status: Backlog, Working on it, Stuck, Done
+//	priority: Critical, High, Medium, Low
+//	type: Bug, Feature
+//	sprint: <sprint-board-id|default>
+//	due_date: Due date
+//	columns:
+//	  Estimate: numbers
+//	  Area: dropdown Frontend, Backend
+//
+// The owner, status, priority, type, sprint and due date columns get the titles the CLI
+// recognizes, the extra columns are added after them in alphabetical order.
+func LoadBoardSpec(path string) (*BoardSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board spec: %w", err)
+	}
+	spec := &BoardSpec{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(data, spec); err != nil {
+			return nil, fmt.Errorf("failed to parse board spec: %w", err)
+		}
+		return spec, nil
+	}
+
+	fields, sections, err := parseSimpleYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse board spec: %w", err)
+	}
+	for _, key := range []string{"owner", "status", "priority", "type", "sprint", "due_date"} {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		switch key {
+		case "owner":
+			spec.Columns = append(spec.Columns, ColumnSpec{Title: value, Type: "people"})
+		case "status", "priority", "type":
+			title := strings.ToUpper(key[:1]) + key[1:]
+			spec.Columns = append(spec.Columns, ColumnSpec{Title: title, Type: "status", Labels: splitList(value)})
+		case "sprint":
+			if value == SprintBoardDefault {
+				value = ""
+			}
+			spec.Columns = append(spec.Columns, ColumnSpec{Title: "Sprint", Type: "board_relation", LinkedBoardID: value})
+		case "due_date":
+			spec.Columns = append(spec.Columns, ColumnSpec{Title: value, Type: "date"})
+		}
+		delete(fields, key)
+	}
+	for key, value := range fields {
+		switch key {
+		case "name":
+			spec.Name = value
+		case "description":
+			spec.Description = value
+		case "kind":
+			spec.Kind = value
+		case "workspace_id":
+			spec.WorkspaceID = value
+		case "groups":
+			spec.Groups = splitList(value)
+		default:
+			return nil, fmt.Errorf("unknown board spec field: %s", key)
+		}
+	}
+	for key, values := range sections {
+		if key != "columns" {
+			return nil, fmt.Errorf("unknown board spec section: %s", key)
+		}
+		titles := make([]string, 0, len(values))
+		for title := range values {
+			titles = append(titles, title)
+		}
+		sort.Strings(titles)
+		for _, title := range titles {
+			columnType, labels, _ := strings.Cut(values[title], " ")
+			spec.Columns = append(spec.Columns, ColumnSpec{Title: title, Type: columnType, Labels: splitList(labels)})
+		}
+	}
+	return spec, nil
+}
+
+// splitList splits a comma separated list, dropping empty entries
+func splitList(text string) []string {
+	var list []string
+	for _, entry := range strings.Split(text, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// Validate checks the kind, the column types and that titles and labels are not repeated
+func (s *BoardSpec) Validate() error {
+	switch s.Kind {
+	case "", "public", "private", "share":
+	default:
+		return fmt.Errorf("invalid board kind %q, use public, private or share", s.Kind)
+	}
+	titles := make(map[string]bool)
+	for _, column := range s.Columns {
+		if column.Title == "" {
+			return fmt.Errorf("column of type %s has no title", column.Type)
+		}
+		if titles[strings.ToLower(column.Title)] {
+			return fmt.Errorf("column %s is repeated", column.Title)
+		}
+		titles[strings.ToLower(column.Title)] = true
+		if !slices.Contains(SpecColumnTypes, column.Type) {
+			return fmt.Errorf("column %s has unknown type %q, use one of %s", column.Title, column.Type, strings.Join(SpecColumnTypes, ", "))
+		}
+		if len(column.Labels) > 0 && column.Type != "status" && column.Type != "dropdown" {
+			return fmt.Errorf("column %s of type %s cannot have labels", column.Title, column.Type)
+		}
+		seen := make(map[string]bool)
+		for _, label := range column.Labels {
+			if seen[strings.ToLower(label)] {
+				return fmt.Errorf("column %s has the label %s twice", column.Title, label)
+			}
+			seen[strings.ToLower(label)] = true
+		}
+	}
+	groups := make(map[string]bool)
+	for _, group := range s.Groups {
+		if groups[strings.ToLower(group)] {
+			return fmt.Errorf("group %s is repeated", group)
+		}
+		groups[strings.ToLower(group)] = true
+	}
+	return nil
+}
+
+// columnDefaults returns the defaults argument of create_column for a column spec: the labels
+// of status and dropdown columns and the linked board of board_relation columns
+func (c ColumnSpec) columnDefaults() (string, error) {
+	var defaults interface{}
+	switch {
+	case c.Type == "status" && len(c.Labels) > 0:
+		labels := make(map[string]string, len(c.Labels))
+		for i, label := range c.Labels {
+			labels[fmt.Sprint(i)] = label
+		}
+		defaults = map[string]interface{}{"labels": labels}
+	case c.Type == "dropdown" && len(c.Labels) > 0:
+		labels := make([]map[string]interface{}, len(c.Labels))
+		for i, label := range c.Labels {
+			labels[i] = map[string]interface{}{"id": i + 1, "name": label}
+		}
+		defaults = map[string]interface{}{"settings": map[string]interface{}{"labels": labels}}
+	case c.Type == "board_relation" && c.LinkedBoardID != "":
+		defaults = map[string]interface{}{"boardIds": []string{c.LinkedBoardID}}
+	default:
+		return "", nil
+	}
+	data, err := json.Marshal(defaults)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode defaults of column %s: %w", c.Title, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,84 @@
+package monday
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultBranchPattern is used when no branch pattern is configured
+const DefaultBranchPattern = "feature/{id}-{slug}"
+
+// maxSlugLength keeps branch names readable for long task names
+const maxSlugLength = 40
+
+var (
+	nonSlugChars  = regexp.MustCompile(`[^a-z0-9]+`)
+	branchNumbers = regexp.MustCompile(`[0-9]+`)
+)
+
+// Slugify turns a task name into a lower case, dash separated branch name part
+func Slugify(name string) string {
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+// BranchName fills a branch pattern for a task. Supported placeholders are {id} for the
+// item ID, {local} for the local index, {slug} for the task name and {type} for the task type.
+func BranchName(pattern string, task Task) string {
+	if pattern == "" {
+		pattern = DefaultBranchPattern
+	}
+	taskType := Slugify(string(task.Type))
+	if taskType == "" {
+		taskType = "task"
+	}
+	replacer := strings.NewReplacer(
+		"{id}", task.ID,
+		"{local}", strconv.Itoa(task.LocalId),
+		"{slug}", Slugify(task.Name),
+		"{type}", taskType,
+	)
+	return replacer.Replace(pattern)
+}
+
+// StoreTaskBranch records that a git branch belongs to a task
+func (ds *DataStore) StoreTaskBranch(boardID, branch, taskID string) error {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return ErrNotFound
+	}
+	if cache.Branches == nil {
+		cache.Branches = make(map[string]string)
+	}
+	cache.Branches[branch] = taskID
+	ds.cache[boardID] = cache
+	return ds.Save()
+}
+
+// GetTaskByBranch finds the task of a git branch. Branches created elsewhere are matched
+// by an item ID contained in the branch name.
+func (ds *DataStore) GetTaskByBranch(boardID, branch string) (Task, bool) {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return Task{}, false
+	}
+	if taskID, ok := cache.Branches[branch]; ok {
+		task, ok := cache.Tasks[taskID]
+		return task, ok
+	}
+	for _, number := range branchNumbers.FindAllString(branch, -1) {
+		if task, ok := cache.Tasks[number]; ok {
+			return task, true
+		}
+	}
+	return Task{}, false
+}
+
+// CommitTrailer returns the commit message trailer linking a commit to a task
+func CommitTrailer(task Task) string {
+	return "monday: #" + task.ID
+}
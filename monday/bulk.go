@@ -0,0 +1,99 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ItemUpdate is the new column values of an item for ChangeItems
+type ItemUpdate struct {
+	ItemID string
+	Values ColumnValues
+}
+
+// ChangeItems changes the column values of several items, sending the given number of
+// change_multiple_column_values mutations per request. It returns the IDs of the changed items
+// in order; on an error the IDs of the batches changed before are returned with it. Batches
+// are split and retried like the batches of CreateItems.
+func (c *Client) ChangeItems(boardID string, changes []ItemUpdate, batchSize int) ([]string, error) {
+	var ids []string
+	err := c.sendBatches("ChangeItems", boardID, len(changes), batchSize, func(start, end int) error {
+		batch, err := c.changeItemBatch(boardID, changes[start:end])
+		ids = append(ids, batch...)
+		return err
+	})
+	return ids, err
+}
+
+// changeItemBatch changes several items in one request using aliased mutations
+func (c *Client) changeItemBatch(boardID string, changes []ItemUpdate) ([]string, error) {
+	var declarations, mutations strings.Builder
+	declarations.WriteString("$boardId: ID!")
+	variables := map[string]interface{}{"boardId": boardID}
+	for i, change := range changes {
+		columnValues, err := change.Values.JSON()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&declarations, ", $itemId%d: ID!, $columnValues%d: JSON!", i, i)
+		variables[fmt.Sprintf("itemId%d", i)] = change.ItemID
+		variables[fmt.Sprintf("columnValues%d", i)] = columnValues
+		fmt.Fprintf(&mutations, "\t\t\titem%d: change_multiple_column_values(board_id: $boardId, item_id: $itemId%d, column_values: $columnValues%d) {\n\t\t\t\tid\n\t\t\t}\n", i, i, i)
+	}
+	query := fmt.Sprintf("\n\t\tmutation ChangeItems(%s) {\n%s\t\t}\n\t", declarations.String(), mutations.String())
+
+	resp, err := c.ExecuteQuery(query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change items: %w", err)
+	}
+	var result map[string]struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse changed items: %w", err)
+	}
+	ids := make([]string, 0, len(changes))
+	for i := range changes {
+		ids = append(ids, result[fmt.Sprintf("item%d", i)].ID)
+	}
+	logger.Info("changed items", "board", boardID, "items", len(ids))
+	return ids, nil
+}
+
+// BulkResult is the outcome of a bulk edit for one task, Err is nil when it was changed
+type BulkResult struct {
+	Task Task
+	Err  error
+}
+
+// BulkEditTasks applies the same edit to several tasks with a mutation per task, so a failing
+// task does not stop the others. Labels are checked against the board before the first
+// mutation, report is called after each task. Tags are not supported.
+func (c *Client) BulkEditTasks(boardID string, tasks []Task, edit TaskEdit, report func(BulkResult)) ([]BulkResult, error) {
+	if edit.Tags != nil {
+		return nil, fmt.Errorf("tags cannot be bulk edited")
+	}
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	values, err := editColumnValues(board, edit)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]BulkResult, 0, len(tasks))
+	for _, task := range tasks {
+		result := BulkResult{Task: task, Err: c.changeColumnValues(boardID, task.ID, values)}
+		results = append(results, result)
+		if report != nil {
+			report(result)
+		}
+		if errors.Is(result.Err, context.Canceled) {
+			break
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,105 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CacheSchemaVersion is the version of the cache format written by this build.
+// Raise it together with a migration in cacheMigrations when the format changes.
+const CacheSchemaVersion = 2
+
+// cacheDocument is the stored cache: the schema version and the boards, kept as raw
+// fields while migrations run so they can rename, fill or drop them
+type cacheDocument struct {
+	Version int                                   `json:"version"`
+	Boards  map[string]map[string]json.RawMessage `json:"boards"`
+}
+
+// cacheMigration upgrades a cache document to the version To
+type cacheMigration struct {
+	To          int
+	Description string
+	Apply       func(doc *cacheDocument) error
+}
+
+// cacheMigrations run in order on caches older than their version
+var cacheMigrations = []cacheMigration{
+	{To: 2, Description: "versioned document with all board maps present", Apply: fillBoardMaps},
+}
+
+// EncodeCache encodes the boards as a cache document of the current version.
+// Store backends that keep the boards encoded use it together with DecodeCache.
+func EncodeCache(boards map[string]TaskCache) ([]byte, error) {
+	data, err := json.Marshal(struct {
+		Version int                  `json:"version"`
+		Boards  map[string]TaskCache `json:"boards"`
+	}{CacheSchemaVersion, boards})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache: %w", err)
+	}
+	return data, nil
+}
+
+// DecodeCache decodes a cache document, migrating it from older versions. Caches from
+// before versioning are a bare map of boards and count as version 1. A cache written by
+// a newer version fails with ErrCacheTooNew instead of losing the fields this build
+// does not know.
+func DecodeCache(data []byte) (map[string]TaskCache, error) {
+	var probe struct {
+		Version *int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cache: %w", err)
+	}
+
+	doc := cacheDocument{Version: 1}
+	target := interface{}(&doc.Boards)
+	if probe.Version != nil {
+		if *probe.Version > CacheSchemaVersion {
+			return nil, fmt.Errorf("%w (version %d, this build reads up to %d)", ErrCacheTooNew, *probe.Version, CacheSchemaVersion)
+		}
+		target = &doc
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cache: %w", err)
+	}
+
+	for _, migration := range cacheMigrations {
+		if doc.Version >= migration.To {
+			continue
+		}
+		if err := migration.Apply(&doc); err != nil {
+			return nil, fmt.Errorf("failed to migrate cache to version %d: %w", migration.To, err)
+		}
+		logger.Info("migrated cache", "from", doc.Version, "to", migration.To, "change", migration.Description)
+		doc.Version = migration.To
+	}
+
+	migrated, err := json.Marshal(doc.Boards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal migrated cache: %w", err)
+	}
+	boards := make(map[string]TaskCache)
+	if err := json.Unmarshal(migrated, &boards); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cache: %w", err)
+	}
+	return boards, nil
+}
+
+// fillBoardMaps adds the task, local ID, item and user maps missing from boards that were
+// cached by a single task or sprint request, so they can be written to without checks
+func fillBoardMaps(doc *cacheDocument) error {
+	for boardID, board := range doc.Boards {
+		if board == nil {
+			board = make(map[string]json.RawMessage)
+			doc.Boards[boardID] = board
+		}
+		for _, key := range []string{"Tasks", "LocalIdMap", "RawItems", "Users"} {
+			if value, ok := board[key]; !ok || string(value) == "null" {
+				board[key] = json.RawMessage("{}")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package monday
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecodeCache(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		tasks map[string]int // Tasks expected per board
+	}{
+		{"v1 full board", `{"1":{"Tasks":{"10":{"id":"10","name":"Fix login"}},"LocalIdMap":{"1":"10"},"RawItems":{},"Users":{}}}`, map[string]int{"1": 1}},
+		{"v1 sprint board without maps", `{"1":{"Tasks":{"10":{"id":"10"}}},"2":{"Sprints":[{"id":"7","name":"Sprint 7"}]}}`, map[string]int{"1": 1, "2": 0}},
+		{"v1 null maps", `{"1":{"Tasks":null,"LocalIdMap":null,"RawItems":null,"Users":null}}`, map[string]int{"1": 0}},
+		{"v1 null board", `{"1":null}`, map[string]int{"1": 0}},
+		{"v1 empty", `{}`, map[string]int{}},
+		{"v2", `{"version":2,"boards":{"1":{"Tasks":{"10":{"id":"10"}},"LocalIdMap":{},"RawItems":{},"Users":{}}}}`, map[string]int{"1": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boards, err := DecodeCache([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(boards) != len(tt.tasks) {
+				t.Fatalf("got %d boards, want %d", len(boards), len(tt.tasks))
+			}
+			for boardID, want := range tt.tasks {
+				board, ok := boards[boardID]
+				if !ok {
+					t.Fatalf("board %s missing", boardID)
+				}
+				if len(board.Tasks) != want {
+					t.Errorf("board %s has %d tasks, want %d", boardID, len(board.Tasks), want)
+				}
+				if board.Tasks == nil || board.LocalIdMap == nil || board.RawItems == nil || board.Users == nil {
+					t.Errorf("board %s has nil maps: %+v", boardID, board)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeCacheKeepsFields(t *testing.T) {
+	boards, err := DecodeCache([]byte(`{"1":{"Tasks":{"10":{"id":"10","name":"Fix login"}},"LocalIdMap":{"3":"10"},"Timestamp":"2026-10-14T09:00:00Z"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := boards["1"]
+	if board.Tasks["10"].Name != "Fix login" || board.LocalIdMap[3] != "10" {
+		t.Errorf("migrated board = %+v", board)
+	}
+	if want := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC); !board.Timestamp.Equal(want) {
+		t.Errorf("timestamp = %v, want %v", board.Timestamp, want)
+	}
+}
+
+func TestDecodeCacheErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		tooNew bool
+	}{
+		{"newer version", `{"version":3,"boards":{}}`, true},
+		{"much newer version", `{"version":99}`, true},
+		{"invalid json", `{"1":`, false},
+		{"boards not a map", `{"version":2,"boards":[]}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boards, err := DecodeCache([]byte(tt.data))
+			if err == nil {
+				t.Fatalf("DecodeCache(%s) = %v, want an error", tt.data, boards)
+			}
+			if errors.Is(err, ErrCacheTooNew) != tt.tooNew {
+				t.Errorf("DecodeCache(%s) error = %v, ErrCacheTooNew %v", tt.data, err, tt.tooNew)
+			}
+		})
+	}
+}
+
+func TestEncodeCacheRoundTrip(t *testing.T) {
+	data, err := EncodeCache(map[string]TaskCache{"1": {Tasks: map[string]Task{"10": {ID: "10", Name: "Fix login"}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	boards, err := DecodeCache(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if boards["1"].Tasks["10"].Name != "Fix login" {
+		t.Errorf("decoded %+v", boards)
+	}
+}
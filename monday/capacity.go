@@ -0,0 +1,95 @@
+package monday
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Capacity sums up the estimates assigned to one person
+type Capacity struct {
+	Assignee    string
+	Tasks       int
+	Points      float64
+	DonePoints  float64
+	Unestimated int // Tasks without an estimate
+}
+
+// RemainingPoints returns the points of tasks that are not done yet
+func (c Capacity) RemainingPoints() float64 {
+	return c.Points - c.DonePoints
+}
+
+// SummarizeCapacity sums estimates per assignee, most points first.
+// Tasks with several assignees count fully for each of them.
+func SummarizeCapacity(tasks []Task) []Capacity {
+	byAssignee := make(map[string]*Capacity)
+	for _, task := range tasks {
+		for _, assignee := range assigneeNames(task) {
+			capacity, ok := byAssignee[assignee]
+			if !ok {
+				capacity = &Capacity{Assignee: assignee}
+				byAssignee[assignee] = capacity
+			}
+			capacity.Tasks++
+			capacity.Points += task.Estimate
+			if task.Estimate == 0 {
+				capacity.Unestimated++
+			}
+			if IsDoneStatus(task.Status) {
+				capacity.DonePoints += task.Estimate
+			}
+		}
+	}
+
+	summary := make([]Capacity, 0, len(byAssignee))
+	for _, capacity := range byAssignee {
+		summary = append(summary, *capacity)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Points != summary[j].Points {
+			return summary[i].Points > summary[j].Points
+		}
+		return summary[i].Assignee < summary[j].Assignee
+	})
+	return summary
+}
+
+// TotalEstimate sums the estimates of all tasks
+func TotalEstimate(tasks []Task) float64 {
+	total := 0.0
+	for _, task := range tasks {
+		total += task.Estimate
+	}
+	return total
+}
+
+// FormatPoints formats an estimate without trailing zeros, e.g. "3" or "0.5"
+func FormatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+// IsDoneStatus reports whether a status counts as finished
+func IsDoneStatus(status Status) bool {
+	s := strings.ToLower(string(status))
+	return strings.Contains(s, "done") || strings.Contains(s, "completed")
+}
+
+// CurrentSprint finds the sprint with the given ID, or the one running at the given time when
+// the ID is empty, SprintIDAuto or not found
+func CurrentSprint(sprints []Sprint, sprintID string, now time.Time) (Sprint, bool) {
+	if sprintID != "" && !strings.EqualFold(sprintID, SprintIDAuto) {
+		for _, sprint := range sprints {
+			if sprint.ID == sprintID {
+				return sprint, true
+			}
+		}
+	}
+	for _, sprint := range sprints {
+		if sprint.IsActiveAt(now) {
+			return sprint, true
+		}
+	}
+	return Sprint{}, false
+}
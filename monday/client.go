@@ -2,31 +2,115 @@ package monday
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
-	"sort"
+	"net/http/httptrace"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Client represents a Monday.com API client
+// Client is a Monday.com API client. It only talks to the API: it prints nothing and
+// leaves caching to the caller, so it can be used by other Go programs as well as the CLI.
 type Client struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
+	auth      AuthProvider
+	baseURL   string
+	transport Transport
+	http      httpSettings
+	dryRun    io.Writer // Receives mutations instead of the API when set
+	pageSize  int       // Items per page of paginated fetches, 0 for the default of each query
+	columnIDs []string  // Column values fetched for board tasks, all when empty
+	progress  func(PageProgress)
+	ctx       context.Context
 }
 
-// NewClient creates a new Monday.com API client
-func NewClient(apiKey string, timeout int) *Client {
-	return &Client{
-		apiKey:  apiKey,
-		baseURL: "https://api.monday.com/v2",
-		httpClient: &http.Client{
-			Timeout: time.Duration(timeout) * time.Second,
-		},
+// Transport sends HTTP requests to the API. *http.Client implements it,
+// tests can use a fake such as mondaytest.Server instead.
+type Transport interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// NewClient creates a new Monday.com API client, e.g.
+//
+//	client := monday.NewClient(monday.WithAPIKey(key), monday.WithTimeout(10*time.Second))
+func NewClient(opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL: DefaultBaseURL,
+		http:    httpSettings{timeout: DefaultTimeout},
+		ctx:     context.Background(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.transport == nil {
+		c.transport = c.http.client()
+	}
+	return c
+}
+
+// WithContext returns a copy of the client whose requests use ctx, so they are
+// cancelled together with it. Use ExecuteQueryContext for a single request.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// IsDryRun reports whether mutations are only written out
+func (c *Client) IsDryRun() bool {
+	return c.dryRun != nil
+}
+
+// operationName returns the name of a GraphQL operation, e.g. "GetBoard", for logging
+func operationName(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) < 2 || fields[1] == "{" {
+		return "anonymous"
+	}
+	name, _, _ := strings.Cut(fields[1], "(")
+	return name
+}
+
+// isMutation reports whether a GraphQL document is a mutation
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// writeDryRun writes the mutation and variables that would have been sent
+func writeDryRun(w io.Writer, query string, variables map[string]interface{}) {
+	fmt.Fprintln(w, "🧪 Dry run, the following mutation would be sent:")
+	lines := strings.Split(strings.TrimSpace(query), "\n")
+	for _, line := range lines {
+		fmt.Fprintln(w, "    "+strings.TrimSpace(line))
 	}
+	data, err := json.MarshalIndent(variables, "    ", "  ")
+	if err != nil {
+		fmt.Fprintf(w, "    variables: %v\n", variables)
+		return
+	}
+	fmt.Fprintln(w, "  Variables:")
+	fmt.Fprintln(w, "    "+string(data))
+}
+
+// authorize sets the Authorization header from the auth provider
+func (c *Client) authorize(req *http.Request) error {
+	if c.auth == nil {
+		return fmt.Errorf("%w: no API key or auth provider set", ErrUnauthorized)
+	}
+	token, err := c.auth.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	req.Header.Set("Authorization", token)
+	return nil
 }
 
 // GraphQLRequest represents a GraphQL request to Monday.com
@@ -38,73 +122,278 @@ type GraphQLRequest struct {
 // GraphQLResponse represents a GraphQL response from Monday.com
 type GraphQLResponse struct {
 	Data   json.RawMessage `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors,omitempty"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+
+	// Older API versions report some errors outside of the errors list
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
 }
 
-// ExecuteQuery executes a GraphQL query against Monday.com API
+// ExecuteQuery executes a GraphQL query against Monday.com API with the context of the client
 func (c *Client) ExecuteQuery(query string, variables map[string]interface{}) (*GraphQLResponse, error) {
-	reqBody := GraphQLRequest{
-		Query:     query,
-		Variables: variables,
+	return c.ExecuteQueryContext(c.ctx, query, variables)
+}
+
+// ExecuteQueryContext executes a GraphQL query that is cancelled together with ctx
+func (c *Client) ExecuteQueryContext(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	var data json.RawMessage
+	if err := c.execute(ctx, query, variables, &data); err != nil {
+		return nil, err
 	}
+	return &GraphQLResponse{Data: data}, nil
+}
 
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
+// requestBuffers reuses the buffers requests are encoded into, e.g. for the pages of a board
+var requestBuffers = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// maxPooledBuffer is the capacity above which a request buffer is dropped instead of reused
+const maxPooledBuffer = 64 << 10
+
+// pooledBody is a request body read from a pooled buffer. The buffer goes back to the pool
+// when the transport closes the body, net/http may still be writing it after Do returns.
+type pooledBody struct {
+	*bytes.Reader
+	buf  *bytes.Buffer
+	once sync.Once
+}
+
+// newPooledBody encodes a GraphQL request into a buffer of the pool
+func newPooledBody(request GraphQLRequest) (*pooledBody, error) {
+	buf := requestBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	if err := json.NewEncoder(buf).Encode(request); err != nil {
+		requestBuffers.Put(buf)
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
+	return &pooledBody{Reader: bytes.NewReader(buf.Bytes()), buf: buf}, nil
+}
+
+// Close returns the buffer to the pool, once
+func (b *pooledBody) Close() error {
+	b.once.Do(func() {
+		if b.buf.Cap() <= maxPooledBuffer {
+			requestBuffers.Put(b.buf)
+		}
+	})
+	return nil
+}
+
+// execute sends a query and decodes the data field of the response into target while it is
+// read, so large responses are not held in memory both as bytes and as values
+func (c *Client) execute(ctx context.Context, query string, variables map[string]interface{}, target interface{}) error {
+	if c.dryRun != nil && isMutation(query) {
+		writeDryRun(c.dryRun, query, variables)
+		return ErrDryRun
+	}
+
+	request := GraphQLRequest{Query: query, Variables: variables}
+	err := c.send(ctx, request, target)
+	var apiErr *APIError
+	if refresher, ok := c.auth.(RefreshableAuth); ok && errors.As(err, &apiErr) && errors.Is(err, ErrUnauthorized) {
+		// The token may have been revoked or expired early, renew it once
+		if refreshErr := refresher.Refresh(); refreshErr != nil {
+			logger.Debug("token refresh failed", "error", refreshErr)
+			return err
+		}
+		err = c.send(ctx, request, target)
+	}
+	return err
+}
+
+// graphQLEnvelope is a GraphQL response whose data field is decoded into Data
+type graphQLEnvelope struct {
+	Data         interface{}    `json:"data"`
+	Errors       []GraphQLError `json:"errors,omitempty"`
+	ErrorCode    string         `json:"error_code,omitempty"`
+	ErrorMessage string         `json:"error_message,omitempty"`
+}
+
+// maxErrorBody limits how much of a failed response is read for the error message
+const maxErrorBody = 64 << 10
+
+// send posts a GraphQL request and decodes the data of the response into target
+func (c *Client) send(ctx context.Context, request GraphQLRequest, target interface{}) error {
+	query := request.Query
+	body, err := newPooledBody(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, body)
+	if err != nil {
+		body.Close()
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = int64(body.Len())
 
-	req, err := http.NewRequest("POST", c.baseURL, bytes.NewBuffer(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	if err := c.authorize(req); err != nil {
+		body.Close()
+		return err
+	}
+
+	start := time.Now()
+	resp, err := c.transport.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		logger.Debug("request failed", "error", err)
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	envelope := graphQLEnvelope{Data: target}
+	if resp.StatusCode != http.StatusOK {
+		// Error responses are small and not always JSON, keep the text for the message
+		text, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		logger.Debug("graphql request", "operation", operationName(query), "status", resp.StatusCode, "duration", time.Since(start), "bytes", len(text))
+		envelope.Data = nil
+		if err := json.Unmarshal(text, &envelope); err != nil {
+			return newAPIError(resp.StatusCode, "", strings.TrimSpace(string(text)), nil)
+		}
+		return newAPIError(resp.StatusCode, envelope.ErrorCode, envelope.ErrorMessage, envelope.Errors)
+	}
+
+	counter := &countingReader{r: resp.Body}
+	decodeErr := json.NewDecoder(counter).Decode(&envelope)
+	// Drain the rest so the connection can be reused
+	io.Copy(io.Discard, resp.Body)
+	logger.Debug("graphql request", "operation", operationName(query), "status", resp.StatusCode, "duration", time.Since(start), "bytes", counter.n)
+
+	if len(envelope.Errors) > 0 || envelope.ErrorCode != "" {
+		return newAPIError(resp.StatusCode, envelope.ErrorCode, envelope.ErrorMessage, envelope.Errors)
 	}
+	if decodeErr != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", decodeErr)
+	}
+	return nil
+}
+
+// countingReader counts the bytes read through it, for logging the size of streamed responses
+type countingReader struct {
+	r io.Reader
+	n int64
+}
 
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// PingResult holds the outcome of a connection test against the Monday.com API
+type PingResult struct {
+	URL          string
+	Proxy        string
+	Latency      time.Duration
+	DNSTime      time.Duration
+	ConnectTime  time.Duration
+	TLSTime      time.Duration
+	StatusCode   int
+	TLSVersion   string
+	CipherSuite  string
+	ServerName   string
+	User         *User
+	NetworkError error // Set when the API could not be reached
+	AuthError    error // Set when the API was reached but rejected the request
+}
+
+// Ping performs a trivial query and reports latency, connection details and the authenticated user
+func (c *Client) Ping() *PingResult {
+	result := &PingResult{URL: c.baseURL}
+
+	jsonData, err := json.Marshal(GraphQLRequest{Query: "query { me { id name email } }"})
+	if err != nil {
+		result.NetworkError = fmt.Errorf("failed to marshal request: %w", err)
+		return result
+	}
+
+	var dnsStart, connectStart, tlsStart time.Time
+	trace := &httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { dnsStart = time.Now() },
+		DNSDone:           func(httptrace.DNSDoneInfo) { result.DNSTime = time.Since(dnsStart) },
+		ConnectStart:      func(string, string) { connectStart = time.Now() },
+		ConnectDone:       func(string, string, error) { result.ConnectTime = time.Since(connectStart) },
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { result.TLSTime = time.Since(tlsStart) },
+	}
+
+	req, err := http.NewRequestWithContext(c.ctx, "POST", c.baseURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		result.NetworkError = fmt.Errorf("failed to create request: %w", err)
+		return result
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.apiKey)
+	if err := c.authorize(req); err != nil {
+		result.AuthError = err
+		return result
+	}
 
-	resp, err := c.httpClient.Do(req)
+	proxyURL, err := http.ProxyFromEnvironment(req)
+	if c.http.proxy != nil {
+		proxyURL, err = c.http.proxy, nil
+	}
+	if err == nil && proxyURL != nil {
+		result.Proxy = proxyURL.Redacted()
+	}
+
+	start := time.Now()
+	resp, err := c.transport.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		result.NetworkError = fmt.Errorf("failed to execute request: %w", err)
+		return result
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
+	result.Latency = time.Since(start)
+	result.StatusCode = resp.StatusCode
+	if resp.TLS != nil {
+		result.TLSVersion = tls.VersionName(resp.TLS.Version)
+		result.CipherSuite = tls.CipherSuiteName(resp.TLS.CipherSuite)
+		result.ServerName = resp.TLS.ServerName
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		result.NetworkError = fmt.Errorf("failed to read response: %w", err)
+		return result
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		result.AuthError = newAPIError(resp.StatusCode, "", "API key rejected", nil)
+		return result
 	}
 
 	var graphqlResp GraphQLResponse
 	if err := json.Unmarshal(body, &graphqlResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		result.NetworkError = fmt.Errorf("unexpected response (HTTP %d): %w", resp.StatusCode, err)
+		return result
+	}
+	if len(graphqlResp.Errors) > 0 || graphqlResp.ErrorCode != "" {
+		result.AuthError = newAPIError(resp.StatusCode, graphqlResp.ErrorCode, graphqlResp.ErrorMessage, graphqlResp.Errors)
+		return result
 	}
 
-	if len(graphqlResp.Errors) > 0 {
-		return nil, fmt.Errorf("GraphQL errors: %v", graphqlResp.Errors)
+	var data struct {
+		Me User `json:"me"`
+	}
+	if err := json.Unmarshal(graphqlResp.Data, &data); err != nil || data.Me.ID == "" {
+		result.AuthError = fmt.Errorf("no authenticated user returned")
+		return result
 	}
+	result.User = &data.Me
 
-	return &graphqlResp, nil
+	return result
 }
 
 // GetBoard retrieves a specific board by ID
 func (c *Client) GetBoard(boardID string) (*Board, error) {
-	query := `
-		query GetBoard($boardId: ID!) {
-			boards(ids: [$boardId]) {
-				id
-				name
-				description
-				state
-				updated_at
-				columns {
-					id
-					title
-					type
-					settings_str
-				}
-			}
-		}
-	`
+	query := buildQuery("GetBoard", "$boardId: ID!",
+		boardByID(
+			scalars("id", "name", "description", "state", "updated_at", "items_count"),
+			[]field{newField("columns", scalars("id", "title", "type", "settings_str"))},
+		),
+	)
 
 	variables := map[string]interface{}{
 		"boardId": boardID,
@@ -124,7 +413,7 @@ func (c *Client) GetBoard(boardID string) (*Board, error) {
 	}
 
 	if len(result.Boards) == 0 {
-		return nil, fmt.Errorf("board not found")
+		return nil, fmt.Errorf("board %w", ErrNotFound)
 	}
 
 	return &result.Boards[0], nil
@@ -137,203 +426,114 @@ func (c *Client) GetBoardItems(boardID string) ([]Task, []Item, error) {
 		return nil, nil, fmt.Errorf("failed to get board: %w", err)
 	}
 
-	// Find the owner column ID
-	var ownerColumnID string
-	for _, column := range board.Columns {
-		if strings.Contains(strings.ToLower(column.Title), "owner") {
-			ownerColumnID = column.ID
-			break
-		}
+	owner := FindOwnerColumn(board)
+	if owner == nil {
+		return nil, nil, fmt.Errorf("owner column %w on board %s", ErrNotFound, boardID)
 	}
-	if ownerColumnID == "" {
-		return nil, nil, fmt.Errorf("owner column not found in board")
-	}
-
-	var allItems []Item
-	cursor := ""
-	limit := 25 // Smaller page size for better performance
-
-	for {
-		query := `
-			query GetBoardItemsByOwner($boardId: ID!, $limit: Int!, $cursor: String) {
-				boards(ids: [$boardId]) {
-					items_page(limit: $limit, cursor: $cursor) {
-						items {
-							id
-							name
-							column_values {
-								id
-								text
-								value
-							}
-							updated_at
-						}
-						cursor
-					}
-				}
-			}
-		`
-
-		variables := map[string]interface{}{
-			"boardId": boardID,
-			"limit":   limit,
-		}
-
-		if cursor != "" {
-			variables["cursor"] = cursor
-		}
 
-		resp, err := c.ExecuteQuery(query, variables)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		var result struct {
-			Boards []struct {
-				ItemsPage struct {
-					Items  []Item `json:"items"`
-					Cursor string `json:"cursor"`
-				} `json:"items_page"`
-			} `json:"boards"`
-		}
-
-		if err := json.Unmarshal(resp.Data, &result); err != nil {
-			return nil, nil, fmt.Errorf("failed to unmarshal board items: %w", err)
-		}
+	p := c.newItemsPaginator("GetBoardItemsByOwner", boardID, DefaultPageSize, itemFragment(columnValueFragment))
+	p.total = board.ItemsCount
+	if len(c.columnIDs) > 0 && !slices.Contains(c.columnIDs, owner.ID) {
+		p.columnIDs = append(slices.Clone(c.columnIDs), owner.ID)
+	} else {
+		p.columnIDs = c.columnIDs
+	}
+	_, items, err := p.fetch()
+	if err != nil {
+		return nil, nil, err
+	}
+	return boardItemTasks(items), items, nil
+}
 
-		if len(result.Boards) == 0 {
-			return nil, nil, fmt.Errorf("board not found")
-		}
+// boardItemTasks converts board items to tasks numbered from 1 in board order
+func boardItemTasks(items []Item) []Task {
+	var tasks []Task
+	for i, item := range items {
+		task := parseBoardItem(item)
+		task.LocalId = i + 1
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
 
-		allItems = append(allItems, result.Boards[0].ItemsPage.Items...)
+// parseBoardItem converts a board item to a task by matching column IDs
+func parseBoardItem(item Item) Task {
+	task := Task{
+		ID:        item.ID,
+		Name:      item.Name,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+	if item.Creator != nil {
+		task.CreatorID = item.Creator.ID
+		task.CreatorName = item.Creator.Name
+	}
 
-		cursor = result.Boards[0].ItemsPage.Cursor
-		if cursor == "" || len(result.Boards[0].ItemsPage.Items) < limit {
-			break
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		for _, cv := range item.ColumnValues {
+			logger.Debug("task column", "task", item.ID, "column", cv.ID, "text", cv.Text)
 		}
-		fmt.Printf("Fetching next page... currently %d items\n", len(allItems))
 	}
 
-	var allTasks []Task
-	localId := 1
-	for _, item := range allItems {
-		task := Task{
-			ID:        item.ID,
-			LocalId:   localId,
-			Name:      item.Name,
-			UpdatedAt: item.UpdatedAt,
+	for _, cv := range item.ColumnValues {
+		// Columns with a handler for their type are only parsed by it, so a mirrored
+		// "Epic status" is not taken for the status of the task
+		if handler, ok := columnTypeHandler(cv); ok {
+			handler.Parse(cv, &task)
+			continue
 		}
-		localId++
-
-		// Debug: Show all column IDs for the first few tasks
-		if localId <= 3 {
-			fmt.Printf("🔍 Task %d columns:\n", localId)
-			for j, cv := range item.ColumnValues {
-				if j < 10 { // Only show first 10 columns
-					fmt.Printf("  Column %d: ID=%s, Text='%s'\n", j+1, cv.ID, cv.Text)
-				}
-			}
+		// Look for sprint columns with more flexible matching
+		columnID := strings.ToLower(cv.ID)
+		columnText := strings.ToLower(cv.Text)
+
+		if (strings.Contains(columnID, "sprint") ||
+			strings.Contains(columnID, "iteration") ||
+			strings.Contains(columnID, "cycle") ||
+			strings.Contains(columnID, "release") ||
+			strings.Contains(columnID, "milestone") ||
+			strings.Contains(columnID, "phase") ||
+			strings.Contains(columnText, "sprint") ||
+			strings.Contains(columnText, "iteration") ||
+			strings.Contains(columnText, "cycle") ||
+			strings.Contains(columnText, "release") ||
+			strings.Contains(columnText, "milestone") ||
+			strings.Contains(columnText, "phase")) &&
+			cv.Text != "" && !isDependencyColumn(cv.ID) {
+			task.Sprint = cv.Text
+			logger.Debug("task assigned to sprint", "task", task.Name, "sprint", cv.Text, "column", cv.ID)
 		}
-
-		for _, cv := range item.ColumnValues {
-			if strings.Contains(strings.ToLower(cv.ID), "status") && cv.Text != "" {
-				task.Status = Status(cv.Text)
-			}
-			if strings.Contains(strings.ToLower(cv.ID), "priority") && cv.Text != "" {
-				task.Priority = Priority(cv.Text)
-			}
-			if strings.Contains(strings.ToLower(cv.ID), "type") && cv.Text != "" {
-				task.Type = Type(cv.Text)
+		if isDueDateColumn(cv.ID) {
+			if dueDate := parseDateColumn(cv); !dueDate.IsZero() {
+				task.DueDate = dueDate
 			}
-			// Look for sprint columns with more flexible matching
-			columnID := strings.ToLower(cv.ID)
-			columnText := strings.ToLower(cv.Text)
-
-			if (strings.Contains(columnID, "sprint") ||
-				strings.Contains(columnID, "iteration") ||
-				strings.Contains(columnID, "cycle") ||
-				strings.Contains(columnID, "release") ||
-				strings.Contains(columnID, "milestone") ||
-				strings.Contains(columnID, "phase") ||
-				strings.Contains(columnText, "sprint") ||
-				strings.Contains(columnText, "iteration") ||
-				strings.Contains(columnText, "cycle") ||
-				strings.Contains(columnText, "release") ||
-				strings.Contains(columnText, "milestone") ||
-				strings.Contains(columnText, "phase")) &&
-				cv.Text != "" {
-				task.Sprint = Sprint(cv.Text)
-				fmt.Printf("🔍 Task '%s' assigned to sprint: %s (column: %s)\n", task.Name, cv.Text, cv.ID)
-			}
-			// Handle user assignments from task_owner column
-			if strings.Contains(strings.ToLower(cv.ID), "person") ||
-				strings.Contains(strings.ToLower(cv.ID), "user") ||
-				strings.Contains(strings.ToLower(cv.ID), "owner") ||
-				strings.Contains(strings.ToLower(cv.ID), "assign") {
-
-				// Parse the user assignment data
-				var personData struct {
-					PersonsAndTeams []struct {
-						ID   int    `json:"id"`
-						Kind string `json:"kind"`
-					} `json:"personsAndTeams"`
-				}
-
-				// First unmarshal the JSON string, then unmarshal the actual data
-				var jsonStr string
-				if err := json.Unmarshal(cv.Value, &jsonStr); err == nil {
-					if err := json.Unmarshal([]byte(jsonStr), &personData); err == nil {
-						// Extract unique user names from the text representation
-						userNames := strings.Split(cv.Text, ",")
-						seenUsers := make(map[string]bool)
-						var taskUserNames []string
-						var taskUserEmails []string
-
-						// Process each name in the text (this handles the actual user assignments)
-						for _, name := range userNames {
-							trimmedName := strings.TrimSpace(name)
-							if trimmedName != "" && !seenUsers[trimmedName] {
-								seenUsers[trimmedName] = true
-								taskUserNames = append(taskUserNames, trimmedName)
-								taskUserEmails = append(taskUserEmails, trimmedName)
-							}
-						}
-
-						// Join multiple users with comma
-						if len(taskUserNames) > 0 {
-							task.UserName = strings.Join(taskUserNames, ", ")
-						}
-						if len(taskUserEmails) > 0 {
-							task.UserEmail = strings.Join(taskUserEmails, ", ")
-						}
-					}
-				}
+		}
+		if isEstimateColumn(cv.ID) {
+			if estimate, ok := parseNumberColumn(cv); ok {
+				task.Estimate = estimate
 			}
 		}
-		allTasks = append(allTasks, task)
+		if isTagsColumn(cv.ID) {
+			task.Tags = parseTagsColumn(cv)
+		}
+		if isLongTextColumn(cv.ID) {
+			task.Description = parseLongTextColumn(cv)
+		}
+		if isDependencyColumn(cv.ID) {
+			task.DependsOn = parseDependencyColumn(cv)
+		}
+		if isTimelineColumn(cv.ID) {
+			task.StartDate, task.EndDate = parseTimelineColumn(cv)
+		}
 	}
-	return allTasks, allItems, nil
+	return task
 }
 
-// GetBoardUsers retrieves all users who are assigned to tasks on a specific board
+// GetBoardUsers retrieves all users who are assigned to tasks on a specific board. The people
+// columns only hold user IDs, the details come from the users query.
 func (c *Client) GetBoardUsers(boardID string) ([]User, error) {
-	query := `
-		query GetBoardUsers($boardId: ID!) {
-			boards(ids: [$boardId]) {
-				items_page(limit: 100) {
-					items {
-						id
-						name
-						column_values {
-							id
-							text
-							value
-						}
-					}
-				}
-			}
-		}
-	`
+	query := buildQuery("GetBoardUsers", "$boardId: ID!",
+		boardByID([]field{itemsPageFragment("limit: 100", columnValueFragment)}),
+	)
 
 	variables := map[string]interface{}{
 		"boardId": boardID,
@@ -348,8 +548,6 @@ func (c *Client) GetBoardUsers(boardID string) ([]User, error) {
 		Boards []struct {
 			ItemsPage struct {
 				Items []struct {
-					ID           string        `json:"id"`
-					Name         string        `json:"name"`
 					ColumnValues []ColumnValue `json:"column_values"`
 				} `json:"items"`
 			} `json:"items_page"`
@@ -361,140 +559,42 @@ func (c *Client) GetBoardUsers(boardID string) ([]User, error) {
 	}
 
 	if len(result.Boards) == 0 {
-		return nil, fmt.Errorf("board not found")
+		return nil, fmt.Errorf("board %w", ErrNotFound)
 	}
 
-	// Extract unique users from task assignments
-	userMap := make(map[string]User)
-
+	// Collect the unique users assigned in person columns, teams are skipped
+	seen := make(map[string]bool)
+	var userIDs []string
 	for _, item := range result.Boards[0].ItemsPage.Items {
 		for _, cv := range item.ColumnValues {
-			// Look for person columns (user assignments)
-			if strings.Contains(strings.ToLower(cv.ID), "person") ||
-				strings.Contains(strings.ToLower(cv.ID), "user") ||
-				strings.Contains(strings.ToLower(cv.ID), "owner") ||
-				strings.Contains(strings.ToLower(cv.ID), "assign") {
-
-				// Parse the value to extract user information
-				var personData struct {
-					PersonsAndTeams []struct {
-						ID   int    `json:"id"`
-						Kind string `json:"kind"`
-					} `json:"personsAndTeams"`
-				}
-
-				// First unmarshal the JSON string, then unmarshal the actual data
-				var jsonStr string
-				if err := json.Unmarshal(cv.Value, &jsonStr); err == nil {
-					if err := json.Unmarshal([]byte(jsonStr), &personData); err == nil {
-						// Split the text by comma to get individual user names
-						userNames := strings.Split(cv.Text, ",")
-						for i, person := range personData.PersonsAndTeams {
-							if person.Kind == "person" {
-								// Get the corresponding user name (trimmed of whitespace)
-								userName := ""
-								if i < len(userNames) {
-									userName = strings.TrimSpace(userNames[i])
-								} else {
-									// Fallback to the full text if we don't have enough names
-									userName = cv.Text
-								}
-
-								// Create a user entry - we'll need to fetch full details later
-								user := User{
-									ID:       fmt.Sprintf("%d", person.ID),
-									Name:     userName,
-									Email:    "", // We'll need to fetch this separately
-									Title:    "",
-									PhotoURL: "",
-									Enabled:  true,
-								}
-								userMap[user.ID] = user
-							}
-						}
-					}
+			if !isPersonColumn(cv.ID) {
+				continue
+			}
+			for _, id := range parsePersonIDs(cv) {
+				if !seen[id] {
+					seen[id] = true
+					userIDs = append(userIDs, id)
 				}
 			}
 		}
 	}
-
-	// Convert map to slice
-	var users []User
-	for _, user := range userMap {
-		users = append(users, user)
+	if len(userIDs) == 0 {
+		return nil, nil
 	}
-
-	return users, nil
+	return c.GetUsersByIDs(userIDs)
 }
 
 // GetBoardSprints retrieves all sprints from a specific board
 func (c *Client) GetBoardSprints(boardID string) ([]Sprint, error) {
-	// Use pagination to fetch all items from the sprint board
-	limit := 25
-	cursor := ""
-	var allItems []Item
-
-	for {
-		query := `
-			query GetSprintBoardItems($boardId: ID!, $limit: Int!, $cursor: String) {
-				boards(ids: [$boardId]) {
-					items_page(limit: $limit, cursor: $cursor) {
-						items {
-							id
-							name
-							column_values {
-								id
-								text
-								value
-							}
-							updated_at
-						}
-						cursor
-					}
-				}
-			}
-		`
-
-		variables := map[string]interface{}{
-			"boardId": boardID,
-			"limit":   limit,
-		}
-		if cursor != "" {
-			variables["cursor"] = cursor
-		}
-
-		resp, err := c.ExecuteQuery(query, variables)
-		if err != nil {
-			return nil, err
-		}
-
-		var result struct {
-			Boards []struct {
-				ItemsPage struct {
-					Items  []Item `json:"items"`
-					Cursor string `json:"cursor"`
-				} `json:"items_page"`
-			} `json:"boards"`
-		}
-
-		if err := json.Unmarshal(resp.Data, &result); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal sprint board items: %w", err)
-		}
-
-		if len(result.Boards) == 0 {
-			return nil, fmt.Errorf("sprint board not found")
-		}
-
-		allItems = append(allItems, result.Boards[0].ItemsPage.Items...)
-		cursor = result.Boards[0].ItemsPage.Cursor
-
-		if cursor == "" || len(result.Boards[0].ItemsPage.Items) < limit {
-			break
-		}
-		fmt.Printf("Fetching next page... currently %d sprint items\n", len(allItems))
+	_, allItems, err := c.newItemsPaginator("GetSprintBoardItems", boardID, DefaultPageSize, itemFragment(columnValueFragment)).fetch()
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("sprint %w", err)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	fmt.Printf("🔍 Found %d total items in sprint board\n", len(allItems))
+	logger.Info("fetched sprint board items", "items", len(allItems))
 
 	// Extract unique sprints from all items
 	sprintSet := make(map[string]bool)
@@ -530,25 +630,96 @@ func (c *Client) GetBoardSprints(boardID string) ([]Sprint, error) {
 		// Add sprint if we found a valid name
 		if sprintName != "" && !sprintSet[sprintName] {
 			sprintSet[sprintName] = true
-			sprints = append(sprints, Sprint(sprintName))
-			fmt.Printf("🔍 Found sprint: %s (ID: %s)\n", sprintName, item.ID)
+			sprint := parseSprintColumns(item)
+			sprint.ID = item.ID
+			sprint.Name = sprintName
+			sprints = append(sprints, sprint)
+			logger.Debug("found sprint", "name", sprintName, "id", item.ID)
 		}
 	}
 
 	return sprints, nil
 }
 
+// parseSprintColumns extracts dates and state from the columns of a sprint board item
+func parseSprintColumns(item Item) Sprint {
+	var sprint Sprint
+	for _, cv := range item.ColumnValues {
+		columnID := strings.ToLower(cv.ID)
+		columnType := strings.ToLower(cv.Type)
+
+		// Column values are JSON encoded strings, e.g. "{\"from\":\"2024-01-01\",\"to\":\"2024-01-14\"}"
+		var jsonStr string
+		if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+			jsonStr = string(cv.Value)
+		}
+
+		switch {
+		case columnType == "timeline" || strings.Contains(columnID, "timeline"):
+			var timeline struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+			}
+			if err := json.Unmarshal([]byte(jsonStr), &timeline); err == nil {
+				if from, err := time.ParseInLocation("2006-01-02", timeline.From, time.Local); err == nil {
+					sprint.StartDate = from
+				}
+				if to, err := time.ParseInLocation("2006-01-02", timeline.To, time.Local); err == nil {
+					sprint.EndDate = to
+				}
+			}
+		case columnType == "date" || strings.Contains(columnID, "date"):
+			var date struct {
+				Date string `json:"date"`
+			}
+			if err := json.Unmarshal([]byte(jsonStr), &date); err != nil {
+				continue
+			}
+			parsed, err := time.ParseInLocation("2006-01-02", date.Date, time.Local)
+			if err != nil {
+				continue
+			}
+			if strings.Contains(columnID, "start") && sprint.StartDate.IsZero() {
+				sprint.StartDate = parsed
+			} else if strings.Contains(columnID, "end") && sprint.EndDate.IsZero() {
+				sprint.EndDate = parsed
+			}
+		case strings.Contains(columnID, "activation") || strings.Contains(columnID, "state"):
+			if state := parseSprintState(cv.Text); state != SprintStateNone {
+				sprint.State = state
+			} else if columnType == "checkbox" && cv.Text == "v" {
+				sprint.State = SprintStateActive
+			}
+		case strings.Contains(columnID, "completion") || strings.Contains(columnID, "completed"):
+			if columnType == "checkbox" && cv.Text == "v" {
+				sprint.State = SprintStateCompleted
+			}
+		}
+	}
+	return sprint
+}
+
+// parseSprintState maps a sprint state label to a SprintState
+func parseSprintState(label string) SprintState {
+	label = strings.ToLower(label)
+	switch {
+	case strings.Contains(label, "active") || strings.Contains(label, "progress") || strings.Contains(label, "current"):
+		return SprintStateActive
+	case strings.Contains(label, "complete") || strings.Contains(label, "done") || strings.Contains(label, "closed"):
+		return SprintStateCompleted
+	case strings.Contains(label, "planned") || strings.Contains(label, "future") || strings.Contains(label, "upcoming"):
+		return SprintStatePlanned
+	default:
+		return SprintStateNone
+	}
+}
+
 // GetSprintItems retrieves items from a specific sprint with pagination
 func (c *Client) GetSprintItems(sprintID string) ([]Task, []Item, error) {
 	// First, get the sprint info to know the sprint name
-	sprintQuery := `
-		query GetSprintInfo($sprintId: ID!) {
-			sprints(ids: [$sprintId]) {
-				id
-				name
-			}
-		}
-	`
+	sprintQuery := buildQuery("GetSprintInfo", "$sprintId: ID!",
+		newField("sprints", scalars("id", "name")).withArgs("ids: [$sprintId]"),
+	)
 
 	sprintResp, err := c.ExecuteQuery(sprintQuery, map[string]interface{}{
 		"sprintId": sprintID,
@@ -569,33 +740,21 @@ func (c *Client) GetSprintItems(sprintID string) ([]Task, []Item, error) {
 	}
 
 	if len(sprintResult.Sprints) == 0 {
-		return nil, nil, fmt.Errorf("sprint not found")
+		return nil, nil, fmt.Errorf("sprint %w", ErrNotFound)
 	}
 
 	sprint := sprintResult.Sprints[0]
-	fmt.Printf("🔍 Found sprint: %s (ID: %s)\n", sprint.Name, sprint.ID)
+	logger.Debug("found sprint", "name", sprint.Name, "id", sprint.ID)
 
 	// Get the board ID from config to fetch items
 	// For now, we'll use a simple approach and fetch all items from the sprint
 	// The Monday.com API doesn't support pagination on sprint items directly
-	query := `
-		query GetSprintItems($sprintId: ID!) {
-			sprints(ids: [$sprintId]) {
-				id
-				name
-				items {
-					id
-					name
-					column_values {
-						id
-						text
-						value
-					}
-					updated_at
-				}
-			}
-		}
-	`
+	query := buildQuery("GetSprintItems", "$sprintId: ID!",
+		newField("sprints",
+			scalars("id", "name"),
+			[]field{newField("items", itemFragment(columnValueFragment))},
+		).withArgs("ids: [$sprintId]"),
+	)
 
 	variables := map[string]interface{}{
 		"sprintId": sprintID,
@@ -610,12 +769,7 @@ func (c *Client) GetSprintItems(sprintID string) ([]Task, []Item, error) {
 		Sprints []struct {
 			ID    string `json:"id"`
 			Name  string `json:"name"`
-			Items []struct {
-				ID           string        `json:"id"`
-				Name         string        `json:"name"`
-				ColumnValues []ColumnValue `json:"column_values"`
-				UpdatedAt    time.Time     `json:"updated_at"`
-			} `json:"items"`
+			Items []Item `json:"items"`
 		} `json:"sprints"`
 	}
 
@@ -624,122 +778,26 @@ func (c *Client) GetSprintItems(sprintID string) ([]Task, []Item, error) {
 	}
 
 	if len(result.Sprints) == 0 {
-		return nil, nil, fmt.Errorf("sprint not found")
+		return nil, nil, fmt.Errorf("sprint %w", ErrNotFound)
 	}
 
-	allItems := result.Sprints[0].Items
-	fmt.Printf("🔍 Found %d total items in sprint\n", len(allItems))
+	items := result.Sprints[0].Items
+	logger.Info("fetched sprint items", "items", len(items))
 
-	// Convert sprint items to tasks and items
-	var allTasks []Task
-	var allItemsConverted []Item
-	localId := 1
-
-	for _, item := range allItems {
-		// Create task
-		task := Task{
-			ID:        item.ID,
-			LocalId:   localId,
-			Name:      item.Name,
-			Sprint:    Sprint(sprint.Name), // Set sprint name from the sprint data
-			UpdatedAt: item.UpdatedAt,
-		}
-		localId++
-
-		// Parse column values for task properties
-		for _, cv := range item.ColumnValues {
-			if strings.Contains(strings.ToLower(cv.ID), "status") && cv.Text != "" {
-				task.Status = Status(cv.Text)
-			}
-			if strings.Contains(strings.ToLower(cv.ID), "priority") && cv.Text != "" {
-				task.Priority = Priority(cv.Text)
-			}
-			if strings.Contains(strings.ToLower(cv.ID), "type") && cv.Text != "" {
-				task.Type = Type(cv.Text)
-			}
-			// Handle user assignments from task_owner column
-			if strings.Contains(strings.ToLower(cv.ID), "person") ||
-				strings.Contains(strings.ToLower(cv.ID), "user") ||
-				strings.Contains(strings.ToLower(cv.ID), "owner") ||
-				strings.Contains(strings.ToLower(cv.ID), "assign") {
-
-				// Parse the user assignment data
-				var personData struct {
-					PersonsAndTeams []struct {
-						ID   int    `json:"id"`
-						Kind string `json:"kind"`
-					} `json:"personsAndTeams"`
-				}
-
-				// First unmarshal the JSON string, then unmarshal the actual data
-				var jsonStr string
-				if err := json.Unmarshal(cv.Value, &jsonStr); err == nil {
-					if err := json.Unmarshal([]byte(jsonStr), &personData); err == nil {
-						// Extract unique user names from the text representation
-						userNames := strings.Split(cv.Text, ",")
-						seenUsers := make(map[string]bool)
-						var taskUserNames []string
-						var taskUserEmails []string
-
-						// Process each name in the text (this handles the actual user assignments)
-						for _, name := range userNames {
-							trimmedName := strings.TrimSpace(name)
-							if trimmedName != "" && !seenUsers[trimmedName] {
-								seenUsers[trimmedName] = true
-								taskUserNames = append(taskUserNames, trimmedName)
-								taskUserEmails = append(taskUserEmails, trimmedName)
-							}
-						}
-
-						// Join multiple users with comma
-						if len(taskUserNames) > 0 {
-							task.UserName = strings.Join(taskUserNames, ", ")
-							task.UserEmail = strings.Join(taskUserEmails, ", ")
-						}
-					}
-				}
-			}
-		}
-
-		allTasks = append(allTasks, task)
-
-		// Create item
-		itemConverted := Item{
-			ID:           item.ID,
-			Name:         item.Name,
-			ColumnValues: item.ColumnValues,
-			UpdatedAt:    item.UpdatedAt,
-		}
-		allItemsConverted = append(allItemsConverted, itemConverted)
+	// Items are parsed like board items, only the sprint comes from the sprint itself
+	tasks := make([]Task, 0, len(items))
+	for i, item := range items {
+		task := parseBoardItem(item)
+		task.LocalId = i + 1
+		task.Sprint = sprint.Name
+		tasks = append(tasks, task)
 	}
-
-	return allTasks, allItemsConverted, nil
+	return tasks, items, nil
 }
 
+// OrderTasks sorts tasks in the default status, priority and type order
 func OrderTasks(tasks []Task) []Task {
-	sort.Slice(tasks, func(i, j int) bool {
-		statusI := getSortableStatus(tasks[i])
-		statusJ := getSortableStatus(tasks[j])
-
-		// First sort by status
-		if statusI != statusJ {
-			return statusI < statusJ
-		}
-
-		// Then by priority
-		priorityI := getSortablePriority(tasks[i])
-		priorityJ := getSortablePriority(tasks[j])
-		if priorityI != priorityJ {
-			return priorityI < priorityJ
-		}
-
-		// Finally by type
-		typeI := getSortableType(tasks[i])
-		typeJ := getSortableType(tasks[j])
-		return typeI < typeJ
-
-	})
-	return tasks
+	return SortTasks(tasks, DefaultSortOrder, false)
 }
 
 func (c *Client) UpdateTaskStatus(boardID, ownerEmail string, task Item, newStatus string) error {
@@ -749,40 +807,43 @@ func (c *Client) UpdateTaskStatus(boardID, ownerEmail string, task Item, newStat
 		return fmt.Errorf("failed to get board: %w", err)
 	}
 
-	// Find the status column ID
-	var statusColumnID string
-	for _, column := range board.Columns {
+	// Find the status column
+	var statusColumn *Column
+	for i, column := range board.Columns {
 		if strings.Contains(strings.ToLower(column.Title), "status") {
-			statusColumnID = column.ID
+			statusColumn = &board.Columns[i]
 			break
 		}
 	}
-	if statusColumnID == "" {
+	if statusColumn == nil {
 		return fmt.Errorf("status column not found in board")
 	}
+	newStatus, err = ValidateLabel(*statusColumn, newStatus)
+	if err != nil {
+		return err
+	}
 
-	query := `
-		mutation UpdateTaskStatus($boardId: ID!, $itemId: ID!, $columnId: String!, $value: JSON!) {
-			change_column_value(board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value) {
-				id
-			}
-		}
-	`
+	query := buildOperation("mutation", "UpdateTaskStatus", "$boardId: ID!, $itemId: ID!, $columnId: String!, $value: JSON!",
+		newField("change_column_value", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value"),
+	)
 
 	// Use the task's actual ID
 	itemID := task.ID
 
 	// Create the JSON value for status column - Monday.com expects a JSON string
-	statusValue := fmt.Sprintf(`{"label": "%s"}`, newStatus)
+	statusValue, err := encodeColumnValue(LabelValue{Label: newStatus})
+	if err != nil {
+		return err
+	}
 
 	variables := map[string]interface{}{
 		"boardId":  boardID,
 		"itemId":   itemID,
-		"columnId": statusColumnID,
+		"columnId": statusColumn.ID,
 		"value":    statusValue,
 	}
 
-	fmt.Println(variables)
+	logger.Debug("updating task status", "variables", variables)
 	resp, err := c.ExecuteQuery(query, variables)
 	if err != nil {
 		return err
@@ -792,8 +853,6 @@ func (c *Client) UpdateTaskStatus(boardID, ownerEmail string, task Item, newStat
 		return fmt.Errorf("failed to update task status: %v", resp.Errors)
 	}
 
-	fmt.Printf("✅ Task %s status updated to %s\n", task.ID, newStatus)
-
 	return nil
 }
 
@@ -805,135 +864,137 @@ func (c *Client) UpdateTask(boardID, ownerEmail string, task Task, status, prior
 		return nil, fmt.Errorf("failed to get board: %w", err)
 	}
 
-	// Find column IDs
-	var statusColumnID, priorityColumnID, typeColumnID string
-	for _, column := range board.Columns {
-		title := strings.ToLower(column.Title)
-		if strings.Contains(title, "status") {
-			statusColumnID = column.ID
-		} else if strings.Contains(title, "priority") {
-			priorityColumnID = column.ID
-		} else if strings.Contains(title, "type") {
-			typeColumnID = column.ID
-		}
-	}
+	statusColumn, priorityColumn, typeColumn := FindLabelColumns(board)
 
 	// Build column updates
-	columnUpdates := make(map[string]string)
-
-	if status != "" && statusColumnID != "" {
-		columnUpdates[statusColumnID] = fmt.Sprintf(`{"label": "%s"}`, status)
+	columnUpdates := ColumnValues{}
+	if err := setValidLabels(columnUpdates, statusColumn, priorityColumn, typeColumn, status, priority, taskType); err != nil {
+		return nil, err
 	}
 
-	if priority != "" && priorityColumnID != "" {
-		columnUpdates[priorityColumnID] = fmt.Sprintf(`{"label": "%s"}`, priority)
+	// If no fields to update, return the original task
+	if len(columnUpdates) == 0 {
+		return &task, nil
 	}
 
-	if taskType != "" && typeColumnID != "" {
-		columnUpdates[typeColumnID] = fmt.Sprintf(`{"label": "%s"}`, taskType)
+	if err := c.changeColumnValues(boardID, task.ID, columnUpdates); err != nil {
+		return nil, err
 	}
 
-	// If no fields to update, return the original task
-	if len(columnUpdates) == 0 {
-		return &task, nil
+	// Fetch the updated task to return the latest data
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
 	}
 
-	// Create the mutation query
-	query := `
-		mutation UpdateTask($boardId: ID!, $itemId: ID!, $columnValues: JSON!) {
-			change_multiple_column_values(board_id: $boardId, item_id: $itemId, column_values: $columnValues) {
-				id
-			}
+	return updatedTask, nil
+}
+
+// AssignTask makes a user the owner of a task, an empty user ID unassigns it
+func (c *Client) AssignTask(boardID string, task Task, userID string) (*Task, error) {
+	owner := PeopleValue{PersonsAndTeams: []PersonOrTeam{}}
+	if userID != "" {
+		var err error
+		if owner, err = NewPersonValue(userID); err != nil {
+			return nil, err
 		}
-	`
+	}
+	return c.setOwner(boardID, task, owner)
+}
+
+// AssignTeam makes a team the owner of a task, replacing the assigned users
+func (c *Client) AssignTeam(boardID string, task Task, teamID string) (*Task, error) {
+	owner, err := NewTeamValue(teamID)
+	if err != nil {
+		return nil, err
+	}
+	return c.setOwner(boardID, task, owner)
+}
+
+// setOwner replaces the people of the owner column and returns the updated task
+func (c *Client) setOwner(boardID string, task Task, owner PeopleValue) (*Task, error) {
+	if err := c.changeColumnValues(boardID, task.ID, ColumnValues{"task_owner": owner}); err != nil {
+		return nil, err
+	}
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
+	}
+	return updatedTask, nil
+}
+
+// changeColumnValues sets several columns of an item in one mutation
+func (c *Client) changeColumnValues(boardID, itemID string, values ColumnValues) error {
+	query := buildOperation("mutation", "UpdateTask", "$boardId: ID!, $itemId: ID!, $columnValues: JSON!",
+		newField("change_multiple_column_values", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId, column_values: $columnValues"),
+	)
 
 	// Create column values JSON
-	columnValues := "{"
-	first := true
-	for columnID, value := range columnUpdates {
-		if !first {
-			columnValues += ","
-		}
-		columnValues += fmt.Sprintf(`"%s": %s`, columnID, value)
-		first = false
+	columnValues, err := values.JSON()
+	if err != nil {
+		return err
 	}
-	columnValues += "}"
 
 	variables := map[string]interface{}{
 		"boardId":      boardID,
-		"itemId":       task.ID,
+		"itemId":       itemID,
 		"columnValues": columnValues,
 	}
 
 	resp, err := c.ExecuteQuery(query, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update task: %w", err)
+		return fmt.Errorf("failed to update task: %w", err)
 	}
 
 	if len(resp.Errors) > 0 {
-		return nil, fmt.Errorf("failed to update task: %v", resp.Errors)
+		return fmt.Errorf("failed to update task: %v", resp.Errors)
 	}
+	return nil
+}
 
-	// Fetch the updated task to return the latest data
-	updatedTask, err := c.GetTaskByID(task.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
-	}
+func (c *Client) CreateTask(boardID, userID, taskName, status, priority, taskType string) (*Task, error) {
+	return c.CreateTaskWithDetails(boardID, taskName, TaskDetails{
+		Status:     status,
+		Priority:   priority,
+		Type:       taskType,
+		AssigneeID: userID,
+	})
+}
 
-	return updatedTask, nil
+// TaskDetails are the optional column values of a new task, empty fields are left unset
+type TaskDetails struct {
+	Status     string    `json:"status,omitempty"`
+	Priority   string    `json:"priority,omitempty"`
+	Type       string    `json:"type,omitempty"`
+	SprintID   string    `json:"sprint_id,omitempty"` // Item ID of the sprint on the sprint board
+	AssigneeID string    `json:"assignee_id,omitempty"`
+	DueDate    time.Time `json:"due_date,omitempty"`
 }
 
-func (c *Client) CreateTask(boardID, userID, taskName, status, priority, taskType string) (int, *Task, error) {
+// CreateTaskWithDetails creates a task with labels, sprint, assignee and due date and returns it
+// as fetched after the creation. Adding it to the cache is left to the caller.
+func (c *Client) CreateTaskWithDetails(boardID, taskName string, details TaskDetails) (*Task, error) {
 
 	// Get board to find column IDs
 	board, err := c.GetBoard(boardID)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to get board: %w", err)
-	}
-
-	// Find column IDs
-	var statusColumnID, priorityColumnID, typeColumnID string
-	for _, column := range board.Columns {
-		title := strings.ToLower(column.Title)
-		if strings.Contains(title, "status") {
-			statusColumnID = column.ID
-		} else if strings.Contains(title, "priority") {
-			priorityColumnID = column.ID
-		} else if strings.Contains(title, "type") {
-			typeColumnID = column.ID
-		}
+		return nil, fmt.Errorf("failed to get board: %w", err)
 	}
 
-	query := `
-		mutation CreateTask($boardId: ID!, $itemName: String!, $columnValues: JSON!) {
-			create_item(board_id: $boardId, item_name: $itemName, column_values: $columnValues) {
-				id
-			}
-		}
-	`
-
-	// Create column values JSON with all specified values
-	columnValues := fmt.Sprintf(`{"task_owner": {"personsAndTeams":[{"id":%s,"kind":"person"}],"changed_at":"%s"}`,
-		userID,
-		time.Now().Format(time.RFC3339))
-
-	// Add status if provided
-	if status != "" && statusColumnID != "" {
-		columnValues += fmt.Sprintf(`,"%s": {"label": "%s"}`, statusColumnID, status)
+	values, err := taskColumnValues(board, details)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add priority if provided
-	if priority != "" && priorityColumnID != "" {
-		columnValues += fmt.Sprintf(`,"%s": {"label": "%s"}`, priorityColumnID, priority)
-	}
+	query := buildOperation("mutation", "CreateTask", "$boardId: ID!, $itemName: String!, $columnValues: JSON!",
+		newField("create_item", scalars("id")).withArgs("board_id: $boardId, item_name: $itemName, column_values: $columnValues"),
+	)
 
-	// Add type if provided
-	if taskType != "" && typeColumnID != "" {
-		columnValues += fmt.Sprintf(`,"%s": {"label": "%s"}`, typeColumnID, taskType)
+	columnValues, err := values.JSON()
+	if err != nil {
+		return nil, err
 	}
 
-	columnValues += "}"
-
 	variables := map[string]interface{}{
 		"boardId":      boardID,
 		"itemName":     taskName,
@@ -942,14 +1003,14 @@ func (c *Client) CreateTask(boardID, userID, taskName, status, priority, taskTyp
 
 	resp, err := c.ExecuteQuery(query, variables)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to create task: %w", err)
+		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
 	if len(resp.Errors) > 0 {
-		return 0, nil, fmt.Errorf("failed to create task: %v", resp.Errors)
+		return nil, fmt.Errorf("failed to create task: %v", resp.Errors)
 	}
 
-	fmt.Printf("✅ Task %s created\n", resp.Data)
+	logger.Debug("task created", "response", string(resp.Data))
 
 	// Parse the response to get the task ID
 	var createResult struct {
@@ -959,38 +1020,115 @@ func (c *Client) CreateTask(boardID, userID, taskName, status, priority, taskTyp
 	}
 
 	if err := json.Unmarshal(resp.Data, &createResult); err != nil {
-		fmt.Printf("Warning: Could not parse created task ID: %v\n", err)
-		return 0, nil, fmt.Errorf("failed to parse created task ID: %v", err)
+		return nil, fmt.Errorf("failed to parse created task ID: %v", err)
 	}
 
-	// Fetch the newly created task and add it to cache
+	// Fetch the newly created task to return its column values as Monday stored them
 	if createResult.CreateItem.ID != "" {
-		localId, task, err := c.fetchAndCacheNewTask(boardID, createResult.CreateItem.ID)
+		task, err := c.GetTaskByID(createResult.CreateItem.ID)
 		if err != nil {
-			fmt.Printf("Warning: Could not fetch and cache new task: %v\n", err)
+			logger.Warn("could not fetch new task", "task", createResult.CreateItem.ID, "error", err)
+			return &Task{ID: createResult.CreateItem.ID, Name: taskName}, nil
 		}
-		return localId, task, nil
+		return task, nil
 	}
 
-	return 0, nil, fmt.Errorf("failed to create task: %v", resp.Errors)
+	return nil, fmt.Errorf("failed to create task: %v", resp.Errors)
 }
 
-// GetTaskByID retrieves a specific task by ID
-func (c *Client) GetTaskByID(taskID string) (*Task, error) {
-	query := `
-		query GetTask($itemId: ID!) {
-			items(ids: [$itemId]) {
-				id
-				name
-				column_values {
-					id
-					text
-					value
-				}
-				updated_at
+// taskColumnValues builds the column values of a new task, validating labels against the board
+func taskColumnValues(board *Board, details TaskDetails) (ColumnValues, error) {
+	statusColumn, priorityColumn, typeColumn := FindLabelColumns(board)
+
+	values := ColumnValues{}
+	if details.AssigneeID != "" {
+		owner, err := NewPersonValue(details.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		values["task_owner"] = owner
+	}
+	if err := setValidLabels(values, statusColumn, priorityColumn, typeColumn, details.Status, details.Priority, details.Type); err != nil {
+		return nil, err
+	}
+	if details.SprintID != "" {
+		column := findColumn(board, "board_relation", "sprint")
+		if column == nil {
+			return nil, fmt.Errorf("sprint column %w on board %s", ErrNotFound, board.ID)
+		}
+		sprint, err := NewRelationValue(details.SprintID)
+		if err != nil {
+			return nil, err
+		}
+		values[column.ID] = sprint
+	}
+	if !details.DueDate.IsZero() {
+		column := findColumn(board, "date", "due", "deadline")
+		if column == nil {
+			return nil, fmt.Errorf("due date column %w on board %s", ErrNotFound, board.ID)
+		}
+		values[column.ID] = NewDateValue(details.DueDate)
+	}
+	return values, nil
+}
+
+// FindOwnerColumn finds the column of the task owners by title, nil when the board has none
+func FindOwnerColumn(board *Board) *Column {
+	for i, column := range board.Columns {
+		if strings.Contains(strings.ToLower(column.Title), "owner") {
+			return &board.Columns[i]
+		}
+	}
+	return nil
+}
+
+// FindLabelColumns finds the status, priority and type columns by title, missing ones are nil
+func FindLabelColumns(board *Board) (status, priority, taskType *Column) {
+	for i, column := range board.Columns {
+		title := strings.ToLower(column.Title)
+		if strings.Contains(title, "status") {
+			status = &board.Columns[i]
+		} else if strings.Contains(title, "priority") {
+			priority = &board.Columns[i]
+		} else if strings.Contains(title, "type") {
+			taskType = &board.Columns[i]
+		}
+	}
+	return status, priority, taskType
+}
+
+// findColumn returns the first column of a type whose ID or title contains one of the words
+func findColumn(board *Board, columnType string, words ...string) *Column {
+	for i, column := range board.Columns {
+		if column.Type != columnType {
+			continue
+		}
+		id, title := strings.ToLower(column.ID), strings.ToLower(column.Title)
+		for _, word := range words {
+			if strings.Contains(id, word) || strings.Contains(title, word) {
+				return &board.Columns[i]
 			}
 		}
-	`
+	}
+	return nil
+}
+
+// setValidLabels validates and sets the status, priority and type labels, empty ones are skipped
+func setValidLabels(values ColumnValues, statusColumn, priorityColumn, typeColumn *Column, status, priority, taskType string) error {
+	if err := values.SetValidLabel(statusColumn, status); err != nil {
+		return err
+	}
+	if err := values.SetValidLabel(priorityColumn, priority); err != nil {
+		return err
+	}
+	return values.SetValidLabel(typeColumn, taskType)
+}
+
+// GetTaskByID retrieves a specific task by ID
+func (c *Client) GetTaskByID(taskID string) (*Task, error) {
+	query := buildQuery("GetTask", "$itemId: ID!",
+		newField("items", itemFragment(columnValueFragment)).withArgs("ids: [$itemId]"),
+	)
 
 	variables := map[string]interface{}{
 		"itemId": taskID,
@@ -1010,71 +1148,16 @@ func (c *Client) GetTaskByID(taskID string) (*Task, error) {
 	}
 
 	if len(result.Items) == 0 {
-		return nil, fmt.Errorf("task not found")
-	}
-
-	task := Task{
-		ID:        result.Items[0].ID,
-		Name:      result.Items[0].Name,
-		UpdatedAt: result.Items[0].UpdatedAt,
-	}
-	for _, cv := range result.Items[0].ColumnValues {
-		if strings.Contains(strings.ToLower(cv.ID), "status") && cv.Text != "" {
-			task.Status = Status(cv.Text)
-		}
-		if strings.Contains(strings.ToLower(cv.ID), "priority") && cv.Text != "" {
-			task.Priority = Priority(cv.Text)
-		}
-		if strings.Contains(strings.ToLower(cv.ID), "type") && cv.Text != "" {
-			task.Type = Type(cv.Text)
-		}
-		if strings.Contains(strings.ToLower(cv.ID), "sprint") && cv.Text != "" {
-			task.Sprint = Sprint(cv.Text)
-		}
-		if strings.Contains(strings.ToLower(cv.ID), "user_name") && cv.Text != "" {
-			task.UserName = cv.Text
-		}
-		if strings.Contains(strings.ToLower(cv.ID), "user_email") && cv.Text != "" {
-			task.UserEmail = cv.Text
-		}
+		return nil, fmt.Errorf("task %w", ErrNotFound)
 	}
 
+	task := parseBoardItem(result.Items[0])
 	return &task, nil
 }
 
-// fetchAndCacheNewTask fetches a newly created task and adds it to the cache
-func (c *Client) fetchAndCacheNewTask(boardID, taskID string) (int, *Task, error) {
-	// Get the task details
-	task, err := c.GetTaskByID(taskID)
-	if err != nil {
-		return 0, nil, fmt.Errorf("failed to fetch task: %w", err)
-	}
-
-	// Load existing cache
-	dataStore := NewDataStore()
-	localId, err := dataStore.StoreTaskRequest(boardID, *task)
-	if err != nil {
-		return 0, nil, fmt.Errorf("failed to store task: %w", err)
-	}
-
-	fmt.Printf("📝 Task %s added to local cache with ID %d\n", task.Name, localId)
-	return localId, task, nil
-}
-
 // GetUserInfo retrieves the current user's information
 func (c *Client) GetUserInfo() (*User, error) {
-	query := `
-		query GetUserInfo {
-			me {
-				id
-				name
-				email
-				title
-				photo_small
-				enabled
-			}
-		}
-	`
+	query := buildQuery("GetUserInfo", "", newField("me", userFragment))
 
 	resp, err := c.ExecuteQuery(query, nil)
 	if err != nil {
@@ -1092,6 +1175,125 @@ func (c *Client) GetUserInfo() (*User, error) {
 	return &result.Me, nil
 }
 
+// isDueDateColumn reports whether a column ID looks like a due date or deadline column
+func isDueDateColumn(columnID string) bool {
+	columnID = strings.ToLower(columnID)
+	return strings.Contains(columnID, "due") || strings.Contains(columnID, "deadline") || strings.HasPrefix(columnID, "date")
+}
+
+// parseDateColumn returns the day of a date column, e.g. "{\"date\":\"2024-01-31\"}", or the zero time
+func parseDateColumn(cv ColumnValue) time.Time {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return time.Time{}
+	}
+	var date struct {
+		Date string `json:"date"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &date); err != nil {
+		return time.Time{}
+	}
+	parsed, err := time.ParseInLocation("2006-01-02", date.Date, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// isEstimateColumn reports whether a column ID looks like a story points or estimate column
+func isEstimateColumn(columnID string) bool {
+	columnID = strings.ToLower(columnID)
+	return strings.Contains(columnID, "estimate") ||
+		strings.Contains(columnID, "point") ||
+		strings.Contains(columnID, "effort") ||
+		strings.HasPrefix(columnID, "numbers") ||
+		strings.HasPrefix(columnID, "numeric")
+}
+
+// parseNumberColumn returns the value of a numbers column, the text holds the plain number
+func parseNumberColumn(cv ColumnValue) (float64, bool) {
+	text := strings.TrimSpace(cv.Text)
+	if text == "" {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		logger.Debug("ignoring non-numeric estimate", "column", cv.ID, "text", cv.Text)
+		return 0, false
+	}
+	return value, true
+}
+
+// isPersonColumn reports whether a column ID looks like a people/owner column
+func isPersonColumn(columnID string) bool {
+	columnID = strings.ToLower(columnID)
+	return strings.Contains(columnID, "person") ||
+		strings.Contains(columnID, "user") ||
+		strings.Contains(columnID, "owner") ||
+		strings.Contains(columnID, "assign")
+}
+
+// parsePersonColumn returns the unique user and team names assigned in a people column. The text
+// lists the names in the order of personsAndTeams, when the counts differ all names are taken as users.
+func parsePersonColumn(cv ColumnValue) (userNames, teamNames []string) {
+	// First unmarshal the JSON string, then unmarshal the actual data
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return nil, nil
+	}
+	var value PeopleValue
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return nil, nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(cv.Text, ",") {
+		if trimmedName := strings.TrimSpace(name); trimmedName != "" {
+			names = append(names, trimmedName)
+		}
+	}
+	kinds := make([]string, len(names))
+	if len(names) == len(value.PersonsAndTeams) {
+		for i, entry := range value.PersonsAndTeams {
+			kinds[i] = entry.Kind
+		}
+	}
+
+	// Extract unique names, each goes to the list of its kind
+	seen := make(map[string]bool)
+	for i, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if kinds[i] == "team" {
+			teamNames = append(teamNames, name)
+		} else {
+			userNames = append(userNames, name)
+		}
+	}
+	return userNames, teamNames
+}
+
+// parsePersonIDs returns the IDs of the users assigned in a people column
+func parsePersonIDs(cv ColumnValue) []string {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return nil
+	}
+	var value PeopleValue
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return nil
+	}
+	var ids []string
+	for _, person := range value.PersonsAndTeams {
+		if person.Kind == "person" {
+			ids = append(ids, strconv.FormatInt(person.ID, 10))
+		}
+	}
+	return ids
+}
+
 // Helper functions for sorting
 func getSortableStatus(task Task) int {
 	status := strings.ToLower(string(task.Status))
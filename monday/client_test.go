@@ -0,0 +1,141 @@
+package monday_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"monday-cli/monday"
+	"monday-cli/monday/mondaytest"
+)
+
+// benchmarkServer returns a fake API with a board of n items
+func benchmarkServer(n int) *mondaytest.Server {
+	server := mondaytest.NewServer()
+	server.AddBoard(monday.Board{ID: "1", Name: "Board", Columns: mondaytest.DefaultColumns(), ItemsCount: n})
+	labels := []string{"In Progress", "Done", "Stuck"}
+	for i := 0; i < n; i++ {
+		server.AddItem("1", mondaytest.NewItem(fmt.Sprint(100+i), fmt.Sprintf("Task %d", i), map[string]string{
+			"status":   labels[i%len(labels)],
+			"priority": "High",
+		}))
+	}
+	return server
+}
+
+// BenchmarkExecute measures encoding a request, sending it and decoding the response
+func BenchmarkExecute(b *testing.B) {
+	client := benchmarkServer(0).Client()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.GetBoard("1"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGetBoardItems measures fetching every page of boards of several sizes
+func BenchmarkGetBoardItems(b *testing.B) {
+	for _, n := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("items=%d", n), func(b *testing.B) {
+			server := benchmarkServer(n)
+			client := server.Client()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				server.Requests = nil
+				if _, _, err := client.GetBoardItems("1"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkGetBoardItemsColumns measures a board fetch limited to a few columns
+func BenchmarkGetBoardItemsColumns(b *testing.B) {
+	server := benchmarkServer(1000)
+	client := monday.NewClient(monday.WithAPIKey("test-token"), monday.WithTransport(server), monday.WithColumnIDs([]string{"status"}))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		server.Requests = nil
+		if _, _, err := client.GetBoardItems("1"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// lateReader answers at once and keeps the request bodies to read them later, like net/http
+// writing a body after the server replied early
+type lateReader struct {
+	bodies []io.ReadCloser
+}
+
+func (t *lateReader) Do(req *http.Request) (*http.Response, error) {
+	t.bodies = append(t.bodies, req.Body)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data":{}}`))}, nil
+}
+
+func TestExecuteKeepsBodyUntilClosed(t *testing.T) {
+	transport := &lateReader{}
+	client := monday.NewClient(monday.WithAPIKey("test-token"), monday.WithTransport(transport))
+	for _, name := range []string{"first", "second"} {
+		if _, err := client.ExecuteQuery("query { "+name+" }", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, name := range []string{"first", "second"} {
+		var request monday.GraphQLRequest
+		if err := json.NewDecoder(transport.bodies[i]).Decode(&request); err != nil {
+			t.Fatalf("body %d: %v", i, err)
+		}
+		transport.bodies[i].Close()
+		if want := "query { " + name + " }"; request.Query != want {
+			t.Errorf("body %d = %q, want %q", i, request.Query, want)
+		}
+	}
+}
+
+func TestGetSprintItemsParsesLikeBoardItems(t *testing.T) {
+	server := mondaytest.NewServer()
+	server.Handle("GetSprintInfo", func(monday.GraphQLRequest) (interface{}, []monday.GraphQLError) {
+		return map[string]interface{}{"sprints": []map[string]string{{"id": "7", "name": "Sprint 7"}}}, nil
+	})
+	server.Handle("GetSprintItems", func(monday.GraphQLRequest) (interface{}, []monday.GraphQLError) {
+		item := map[string]interface{}{
+			"id": "10", "name": "Fix login",
+			"creator": map[string]string{"id": "3", "name": "Ann"},
+			"column_values": []map[string]interface{}{
+				{"id": "status", "type": "status", "text": "Stuck"},
+				// A mirror of the epic's status must not become the status of the task
+				{"id": "epic_status", "type": "mirror", "text": "Done", "display_value": "Done", "column": map[string]string{"title": "Epic status"}},
+			},
+		}
+		return map[string]interface{}{"sprints": []map[string]interface{}{{"id": "7", "name": "Sprint 7", "items": []interface{}{item}}}}, nil
+	})
+
+	tasks, items, err := server.Client().GetSprintItems("7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || len(items) != 1 {
+		t.Fatalf("got %d tasks and %d items, want 1", len(tasks), len(items))
+	}
+	task := tasks[0]
+	if task.Status != "Stuck" {
+		t.Errorf("status = %q, want Stuck", task.Status)
+	}
+	if task.Sprint != "Sprint 7" || task.LocalId != 1 {
+		t.Errorf("sprint = %q, local ID = %d", task.Sprint, task.LocalId)
+	}
+	if task.CreatorName != "Ann" {
+		t.Errorf("creator = %q, want Ann", task.CreatorName)
+	}
+	if len(task.Mirrors) != 1 || task.Mirrors[0].Text != "Done" {
+		t.Errorf("mirrors = %+v, want the epic status", task.Mirrors)
+	}
+}
@@ -0,0 +1,179 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CloneOptions control how CloneItem copies an item
+type CloneOptions struct {
+	Name      string            // Name of the copy, the name of the item when empty
+	BoardID   string            // Board to copy to, the board of the item when empty
+	ColumnMap map[string]string // Column IDs of the item's board to column IDs of the target board
+}
+
+// CloneResult is the item created by CloneItem
+type CloneResult struct {
+	ItemID         string
+	BoardID        string
+	Name           string
+	SkippedColumns []string // Columns with a value that was not copied, with the reason
+}
+
+// CloneItem copies an item with its column values. Within the same board the item is duplicated
+// by Monday. Copies to another board are created with the values of the columns matched by ID,
+// then by title and type, unless opts.ColumnMap names the target column. Status labels are
+// copied by text and skipped when the target column does not have them.
+func (c *Client) CloneItem(itemID string, opts CloneOptions) (*CloneResult, error) {
+	item, sourceBoardID, err := c.getItemWithBoard(itemID)
+	if err != nil {
+		return nil, err
+	}
+	result := &CloneResult{BoardID: opts.BoardID, Name: opts.Name}
+	if result.BoardID == "" {
+		result.BoardID = sourceBoardID
+	}
+	if result.Name == "" {
+		result.Name = item.Name
+	}
+
+	if result.BoardID == sourceBoardID && len(opts.ColumnMap) == 0 {
+		if result.ItemID, err = c.duplicateItem(sourceBoardID, itemID); err != nil {
+			return nil, err
+		}
+		if result.Name != item.Name {
+			if err := c.changeColumnValues(result.BoardID, result.ItemID, ColumnValues{"name": result.Name}); err != nil {
+				return result, fmt.Errorf("failed to rename the copy: %w", err)
+			}
+		}
+		logger.Info("duplicated item", "item", itemID, "copy", result.ItemID)
+		return result, nil
+	}
+
+	source, err := c.GetBoard(sourceBoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board of the item: %w", err)
+	}
+	target := source
+	if result.BoardID != sourceBoardID {
+		if target, err = c.GetBoard(result.BoardID); err != nil {
+			return nil, fmt.Errorf("failed to get target board: %w", err)
+		}
+	}
+	values, skipped, err := cloneColumnValues(*item, source, target, opts.ColumnMap)
+	if err != nil {
+		return nil, err
+	}
+	result.SkippedColumns = skipped
+
+	ids, err := c.CreateItems(result.BoardID, []ItemInput{{Name: result.Name, Values: values}}, 1)
+	if err != nil {
+		return nil, err
+	}
+	result.ItemID = ids[0]
+	logger.Info("copied item", "item", itemID, "board", result.BoardID, "copy", result.ItemID, "skipped", len(skipped))
+	return result, nil
+}
+
+// cloneColumnValues translates the values of an item to the columns of the target board
+func cloneColumnValues(item Item, source, target *Board, columnMap map[string]string) (ColumnValues, []string, error) {
+	sourceColumns := make(map[string]Column, len(source.Columns))
+	for _, column := range source.Columns {
+		sourceColumns[column.ID] = column
+	}
+	targetColumns := make(map[string]Column, len(target.Columns))
+	for _, column := range target.Columns {
+		targetColumns[column.ID] = column
+	}
+	for from, to := range columnMap {
+		if _, ok := sourceColumns[from]; !ok {
+			return nil, nil, fmt.Errorf("column %s of board %s %w", from, source.ID, ErrNotFound)
+		}
+		if _, ok := targetColumns[to]; !ok {
+			return nil, nil, fmt.Errorf("column %s of board %s %w", to, target.ID, ErrNotFound)
+		}
+	}
+
+	values := ColumnValues{}
+	var skipped []string
+	for _, cv := range item.ColumnValues {
+		column, ok := sourceColumns[cv.ID]
+		if !ok || readOnlyColumnTypes[column.Type] {
+			continue
+		}
+		value, set := writableValue(column, cv)
+		if !set {
+			continue
+		}
+
+		var targetColumn Column
+		if to, mapped := columnMap[cv.ID]; mapped {
+			targetColumn = targetColumns[to]
+		} else if targetColumn, ok = matchColumn(column, target.Columns); !ok {
+			skipped = append(skipped, fmt.Sprintf("%s: no matching column", column.Title))
+			continue
+		}
+
+		switch {
+		case targetColumn.Type == column.Type && (column.Type == "status" || column.Type == "color"):
+			label, err := ValidateLabel(targetColumn, cv.Text)
+			if err != nil {
+				skipped = append(skipped, fmt.Sprintf("%s: no label %q", column.Title, cv.Text))
+				continue
+			}
+			values.SetLabel(targetColumn.ID, label)
+		case targetColumn.Type == column.Type:
+			values[targetColumn.ID] = value
+		case targetColumn.Type == "text" && cv.Text != "":
+			values[targetColumn.ID] = cv.Text
+		case targetColumn.Type == "long_text" && cv.Text != "":
+			values[targetColumn.ID] = map[string]string{"text": cv.Text}
+		default:
+			skipped = append(skipped, fmt.Sprintf("%s: %s cannot be copied to %s column %s", column.Title, column.Type, targetColumn.Type, targetColumn.Title))
+		}
+	}
+	return values, skipped, nil
+}
+
+// getItemWithBoard returns an item with its column values and the ID of its board
+func (c *Client) getItemWithBoard(itemID string) (*Item, string, error) {
+	query := buildQuery("GetItemWithBoard", "$itemId: ID!",
+		newField("items", append(itemFragment(columnValueFragment), newField("board", scalars("id")))).
+			withArgs("ids: [$itemId]"),
+	)
+	var result struct {
+		Items []struct {
+			Item
+			Board struct {
+				ID string `json:"id"`
+			} `json:"board"`
+		} `json:"items"`
+	}
+	if err := c.execute(c.ctx, query, map[string]interface{}{"itemId": itemID}, &result); err != nil {
+		return nil, "", err
+	}
+	if len(result.Items) == 0 {
+		return nil, "", fmt.Errorf("item %s %w", itemID, ErrNotFound)
+	}
+	return &result.Items[0].Item, result.Items[0].Board.ID, nil
+}
+
+// duplicateItem duplicates an item within its board and returns the ID of the copy
+func (c *Client) duplicateItem(boardID, itemID string) (string, error) {
+	query := buildOperation("mutation", "DuplicateItem", "$boardId: ID!, $itemId: ID!",
+		newField("duplicate_item", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"boardId": boardID, "itemId": itemID})
+	if err != nil {
+		return "", fmt.Errorf("failed to duplicate item: %w", err)
+	}
+	var result struct {
+		DuplicateItem struct {
+			ID string `json:"id"`
+		} `json:"duplicate_item"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return "", fmt.Errorf("failed to parse duplicated item: %w", err)
+	}
+	return result.DuplicateItem.ID, nil
+}
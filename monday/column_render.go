@@ -0,0 +1,183 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnField is a column of a task with its value rendered for display
+type ColumnField struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+}
+
+// RenderColumnValue returns the value of a column as text for display with the handler of
+// its type, columns without one show their text
+func RenderColumnValue(column Column, cv ColumnValue) string {
+	if handler, ok := columnTypeHandlers[column.Type]; ok {
+		return strings.TrimSpace(handler.Render(column, cv))
+	}
+	return strings.TrimSpace(cv.Text)
+}
+
+// decodeColumnValue unmarshals a column value, which the API sends as a JSON encoded string,
+// e.g. "{\"rating\":4}". It reports false for empty values.
+func decodeColumnValue(cv ColumnValue, v interface{}) bool {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return false
+	}
+	return jsonStr != "" && json.Unmarshal([]byte(jsonStr), v) == nil
+}
+
+// renderDisplayValue renders formula and mirror columns, which only have a display_value
+func renderDisplayValue(_ Column, cv ColumnValue) string {
+	if cv.DisplayValue != "" {
+		return cv.DisplayValue
+	}
+	return cv.Text
+}
+
+// renderRating renders a rating as stars out of the maximum in the column settings, e.g. "★★★☆☆ 3/5"
+func renderRating(column Column, cv ColumnValue) string {
+	var value struct {
+		Rating int `json:"rating"`
+	}
+	if !decodeColumnValue(cv, &value) || value.Rating <= 0 {
+		return ""
+	}
+	limit := 5
+	if settings, err := ParseColumnSettings(column); err == nil {
+		if raw, ok := settings.Raw["max"]; ok {
+			json.Unmarshal(raw, &limit)
+		}
+	}
+	limit = max(limit, value.Rating)
+	return fmt.Sprintf("%s%s %d/%d", strings.Repeat("★", value.Rating), strings.Repeat("☆", limit-value.Rating), value.Rating, limit)
+}
+
+// renderCheckbox renders a checkbox as ☑ or ☐, the API sends {"checked":"true"} or no value
+func renderCheckbox(_ Column, cv ColumnValue) string {
+	var value struct {
+		Checked interface{} `json:"checked"`
+	}
+	if decodeColumnValue(cv, &value) {
+		if checked, _ := strconv.ParseBool(fmt.Sprint(value.Checked)); checked {
+			return "☑"
+		}
+	}
+	return "☐"
+}
+
+// renderPhone renders a phone number with its country, e.g. "+4915112345678 (DE)"
+func renderPhone(_ Column, cv ColumnValue) string {
+	var value struct {
+		Phone   string `json:"phone"`
+		Country string `json:"countryShortName"`
+	}
+	if !decodeColumnValue(cv, &value) || value.Phone == "" {
+		return cv.Text
+	}
+	if value.Country != "" {
+		return fmt.Sprintf("%s (%s)", value.Phone, value.Country)
+	}
+	return value.Phone
+}
+
+// renderEmail renders an email with its label, e.g. "Alice <alice@example.com>"
+func renderEmail(_ Column, cv ColumnValue) string {
+	var value struct {
+		Email string `json:"email"`
+		Text  string `json:"text"`
+	}
+	if !decodeColumnValue(cv, &value) || value.Email == "" {
+		return cv.Text
+	}
+	if value.Text != "" && value.Text != value.Email {
+		return fmt.Sprintf("%s <%s>", value.Text, value.Email)
+	}
+	return value.Email
+}
+
+// renderCountry renders a country with its flag, e.g. "🇩🇪 Germany"
+func renderCountry(_ Column, cv ColumnValue) string {
+	var value struct {
+		Code string `json:"countryCode"`
+		Name string `json:"countryName"`
+	}
+	if !decodeColumnValue(cv, &value) || value.Name == "" {
+		return cv.Text
+	}
+	code := strings.ToUpper(value.Code)
+	if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		return value.Name
+	}
+	// Flags are the country code in regional indicator symbols
+	flag := string(rune(0x1F1E6+int(code[0]-'A'))) + string(rune(0x1F1E6+int(code[1]-'A')))
+	return flag + " " + value.Name
+}
+
+// renderWorldClock renders a time zone with its current time, e.g. "Europe/Berlin 14:05"
+func renderWorldClock(_ Column, cv ColumnValue) string {
+	var value struct {
+		Timezone string `json:"timezone"`
+	}
+	if !decodeColumnValue(cv, &value) || value.Timezone == "" {
+		return cv.Text
+	}
+	location, err := time.LoadLocation(value.Timezone)
+	if err != nil {
+		return value.Timezone
+	}
+	return fmt.Sprintf("%s %s", value.Timezone, time.Now().In(location).Format("15:04"))
+}
+
+// columnFieldsFragment selects the column values rendered by GetTaskColumns, formula values
+// are only computed when display_value is selected
+var columnFieldsFragment = append(scalars("id", "type", "text", "value"),
+	newField("... on MirrorValue", scalars("display_value")),
+	newField("... on FormulaValue", scalars("display_value")),
+)
+
+// GetTaskColumns fetches every column of a task and renders the values, in board column order
+func (c *Client) GetTaskColumns(boardID, taskID string) ([]ColumnField, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	query := buildQuery("GetTaskColumns", "$itemId: ID!",
+		newField("items", scalars("id"), []field{newField("column_values", columnFieldsFragment)}).withArgs("ids: [$itemId]"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"itemId": taskID})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Items []Item `json:"items"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task columns: %w", err)
+	}
+	if len(result.Items) == 0 {
+		return nil, fmt.Errorf("task %w", ErrNotFound)
+	}
+
+	values := make(map[string]ColumnValue, len(result.Items[0].ColumnValues))
+	for _, cv := range result.Items[0].ColumnValues {
+		values[cv.ID] = cv
+	}
+	var fields []ColumnField
+	for _, column := range board.Columns {
+		cv, ok := values[column.ID]
+		if !ok {
+			continue // The name column has no column value
+		}
+		fields = append(fields, ColumnField{ID: column.ID, Title: column.Title, Type: column.Type, Text: RenderColumnValue(column, cv)})
+	}
+	return fields, nil
+}
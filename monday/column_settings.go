@@ -0,0 +1,112 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ColumnLabel is a status label or dropdown option of a column
+type ColumnLabel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ColumnSettings is the parsed settings_str of a column
+type ColumnSettings struct {
+	Labels   []ColumnLabel // Status labels and dropdown options, ordered by ID
+	BoardIDs []string      // Boards linked by board_relation columns
+	Raw      map[string]json.RawMessage
+}
+
+// ParseColumnSettings parses the settings_str of a column. Status columns store labels as
+// {"labels": {"0": "Working on it", ...}}, dropdown columns as {"labels": [{"id": 1, "name": "..."}]}.
+// Deactivated status labels are left out.
+func ParseColumnSettings(column Column) (ColumnSettings, error) {
+	var settings ColumnSettings
+	if strings.TrimSpace(column.SettingsStr) == "" {
+		return settings, nil
+	}
+	if err := json.Unmarshal([]byte(column.SettingsStr), &settings.Raw); err != nil {
+		return settings, fmt.Errorf("failed to parse settings of column %s: %w", column.ID, err)
+	}
+
+	if raw, ok := settings.Raw["labels"]; ok {
+		labels, err := parseColumnLabels(raw)
+		if err != nil {
+			return settings, fmt.Errorf("failed to parse labels of column %s: %w", column.ID, err)
+		}
+		var deactivated []int
+		if raw, ok := settings.Raw["deactivated_labels"]; ok {
+			json.Unmarshal(raw, &deactivated)
+		}
+		for _, label := range labels {
+			if label.Name == "" || slices.Contains(deactivated, label.ID) {
+				continue
+			}
+			settings.Labels = append(settings.Labels, label)
+		}
+	}
+
+	if raw, ok := settings.Raw["boardIds"]; ok {
+		var ids []json.Number
+		if err := json.Unmarshal(raw, &ids); err == nil {
+			for _, id := range ids {
+				settings.BoardIDs = append(settings.BoardIDs, id.String())
+			}
+		}
+	}
+	return settings, nil
+}
+
+// parseColumnLabels reads both the status map and the dropdown list format
+func parseColumnLabels(raw json.RawMessage) ([]ColumnLabel, error) {
+	var list []ColumnLabel
+	if err := json.Unmarshal(raw, &list); err == nil {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+		return list, nil
+	}
+
+	var byIndex map[string]string
+	if err := json.Unmarshal(raw, &byIndex); err != nil {
+		return nil, err
+	}
+	labels := make([]ColumnLabel, 0, len(byIndex))
+	for index, name := range byIndex {
+		id, err := strconv.Atoi(index)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label index %q", index)
+		}
+		labels = append(labels, ColumnLabel{ID: id, Name: name})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].ID < labels[j].ID })
+	return labels, nil
+}
+
+// LabelNames returns the names of all labels
+func (s ColumnSettings) LabelNames() []string {
+	names := make([]string, 0, len(s.Labels))
+	for _, label := range s.Labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+// ValidateLabel checks a label against the labels of a column, ignoring case, and returns
+// the label as spelled on the board. Columns without parsable labels accept any label.
+func ValidateLabel(column Column, label string) (string, error) {
+	settings, err := ParseColumnSettings(column)
+	if err != nil || len(settings.Labels) == 0 {
+		logger.Debug("skipping label validation", "column", column.ID, "error", err)
+		return label, nil
+	}
+	for _, valid := range settings.Labels {
+		if strings.EqualFold(valid.Name, label) {
+			return valid.Name, nil
+		}
+	}
+	return "", &InvalidLabelError{Column: column.Title, Label: label, Valid: settings.LabelNames()}
+}
@@ -0,0 +1,253 @@
+package monday
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ColumnTypeHandler reads, renders and writes the columns of one column type. Handlers are
+// keyed by column type, see RegisterColumnType, so a new type only needs a handler.
+type ColumnTypeHandler interface {
+	// Parse sets the task fields a column value holds
+	Parse(cv ColumnValue, task *Task)
+	// Render returns a column value as text for display, empty for no value
+	Render(column Column, cv ColumnValue) string
+	// Mutate returns the value setting the column to text in change_multiple_column_values,
+	// an empty text clears the column
+	Mutate(column Column, text string) (interface{}, error)
+}
+
+// columnType is a ColumnTypeHandler made of functions, nil functions parse nothing, render
+// the text and reject mutations
+type columnType struct {
+	parse  func(cv ColumnValue, task *Task)
+	render func(column Column, cv ColumnValue) string
+	mutate func(column Column, text string) (interface{}, error)
+}
+
+func (t columnType) Parse(cv ColumnValue, task *Task) {
+	if t.parse != nil {
+		t.parse(cv, task)
+	}
+}
+
+func (t columnType) Render(column Column, cv ColumnValue) string {
+	if t.render == nil {
+		return cv.Text
+	}
+	return t.render(column, cv)
+}
+
+func (t columnType) Mutate(column Column, text string) (interface{}, error) {
+	if t.mutate == nil {
+		return nil, fmt.Errorf("%s column %s cannot be set", column.Type, column.Title)
+	}
+	return t.mutate(column, text)
+}
+
+// columnTypeHandlers holds the handler of each column type
+var columnTypeHandlers = map[string]ColumnTypeHandler{
+	"status":      columnType{parse: parseLabelColumn, mutate: mutateLabel},
+	"people":      columnType{parse: parseOwnerColumn, mutate: mutatePeople},
+	"mirror":      columnType{parse: parseMirrorValue, render: renderDisplayValue},
+	"formula":     columnType{render: renderDisplayValue},
+	"long_text":   columnType{parse: parseDescription, render: func(_ Column, cv ColumnValue) string { return parseLongTextColumn(cv) }, mutate: mutateLongText},
+	"rating":      columnType{render: renderRating, mutate: mutateRating},
+	"checkbox":    columnType{render: renderCheckbox, mutate: mutateCheckbox},
+	"phone":       columnType{render: renderPhone, mutate: mutatePhone},
+	"email":       columnType{render: renderEmail, mutate: mutateEmail},
+	"country":     columnType{render: renderCountry},
+	"world_clock": columnType{render: renderWorldClock, mutate: mutateWorldClock},
+}
+
+// RegisterColumnType adds or replaces the handler of a column type, e.g. "dropdown"
+func RegisterColumnType(columnType string, handler ColumnTypeHandler) {
+	columnTypeHandlers[columnType] = handler
+}
+
+// columnTypeHandler returns the handler of a column value. Values without a type, from older
+// caches, or of a type without a handler are matched by their column ID.
+func columnTypeHandler(cv ColumnValue) (ColumnTypeHandler, bool) {
+	if handler, ok := columnTypeHandlers[cv.Type]; ok {
+		return handler, true
+	}
+	handler, ok := columnTypeHandlers[guessColumnType(cv)]
+	return handler, ok
+}
+
+// guessColumnType returns the type of the column a value looks like it belongs to by its ID,
+// the way the columns of tasks were matched before the type was fetched
+func guessColumnType(cv ColumnValue) string {
+	columnID := strings.ToLower(cv.ID)
+	switch {
+	case isMirrorColumn(cv):
+		return "mirror"
+	case strings.Contains(columnID, "status") || strings.Contains(columnID, "priority") || strings.Contains(columnID, "type"):
+		return "status"
+	case isPersonColumn(columnID):
+		return "people"
+	}
+	return ""
+}
+
+// SetColumn sets a column to text with the handler of its type, an empty text clears it
+func (cv ColumnValues) SetColumn(column Column, text string) error {
+	handler, ok := columnTypeHandlers[column.Type]
+	if !ok {
+		return fmt.Errorf("%s columns cannot be set", column.Type)
+	}
+	value, err := handler.Mutate(column, text)
+	if err != nil {
+		return err
+	}
+	cv[column.ID] = value
+	return nil
+}
+
+// parseLabelColumn sets the status, priority or type of a task from a label column, told
+// apart by the column ID
+func parseLabelColumn(cv ColumnValue, task *Task) {
+	columnID := strings.ToLower(cv.ID)
+	if cv.Text == "" {
+		return
+	}
+	if strings.Contains(columnID, "status") {
+		task.Status = Status(cv.Text)
+	}
+	if strings.Contains(columnID, "priority") {
+		task.Priority = Priority(cv.Text)
+	}
+	if strings.Contains(columnID, "type") {
+		task.Type = Type(cv.Text)
+	}
+}
+
+// mutateLabel sets a label checked against the column settings
+func mutateLabel(column Column, text string) (interface{}, error) {
+	if text == "" {
+		return clearedValue, nil
+	}
+	valid, err := ValidateLabel(column, text)
+	if err != nil {
+		return nil, err
+	}
+	return LabelValue{Label: valid}, nil
+}
+
+// parseOwnerColumn sets the assignees of a task from the owner column, other people columns
+// such as reviewers are left alone
+func parseOwnerColumn(cv ColumnValue, task *Task) {
+	if !isPersonColumn(cv.ID) {
+		return
+	}
+	userNames, teamNames := parsePersonColumn(cv)
+	task.Teams = teamNames
+	if len(userNames) > 0 {
+		// Emails are not part of the column value, so the names are used for both
+		task.UserName = strings.Join(userNames, ", ")
+		task.UserEmail = strings.Join(userNames, ", ")
+	}
+}
+
+// mutatePeople assigns the users of a comma separated list of user IDs
+func mutatePeople(_ Column, text string) (interface{}, error) {
+	value := PeopleValue{PersonsAndTeams: []PersonOrTeam{}}
+	for _, userID := range strings.Split(text, ",") {
+		if userID = strings.TrimSpace(userID); userID == "" {
+			continue
+		}
+		person, err := NewPersonValue(userID)
+		if err != nil {
+			return nil, err
+		}
+		value.PersonsAndTeams = append(value.PersonsAndTeams, person.PersonsAndTeams...)
+		value.ChangedAt = person.ChangedAt
+	}
+	return value, nil
+}
+
+// parseMirrorValue adds the reflected values of a mirror column to a task
+func parseMirrorValue(cv ColumnValue, task *Task) {
+	if mirror := parseMirrorColumn(cv); mirror.Text != "" {
+		task.Mirrors = append(task.Mirrors, mirror)
+	}
+}
+
+// parseDescription sets the description of a task from its long text column
+func parseDescription(cv ColumnValue, task *Task) {
+	if isLongTextColumn(cv.ID) {
+		task.Description = parseLongTextColumn(cv)
+	}
+}
+
+func mutateLongText(_ Column, text string) (interface{}, error) {
+	return LongTextValue{Text: text}, nil
+}
+
+// mutateRating takes the number of stars, 0 clears the rating
+func mutateRating(_ Column, text string) (interface{}, error) {
+	if text == "" {
+		return clearedValue, nil
+	}
+	rating, err := strconv.Atoi(text)
+	if err != nil || rating < 0 {
+		return nil, fmt.Errorf("invalid rating %q, use a number of stars", text)
+	}
+	if rating == 0 {
+		return clearedValue, nil
+	}
+	return map[string]int{"rating": rating}, nil
+}
+
+// mutateCheckbox takes true or false, yes or no
+func mutateCheckbox(_ Column, text string) (interface{}, error) {
+	switch strings.ToLower(text) {
+	case "true", "yes", "y", "1", "x":
+		return map[string]string{"checked": "true"}, nil
+	case "", "false", "no", "n", "0":
+		return clearedValue, nil
+	}
+	return nil, fmt.Errorf("invalid checkbox value %q, use true or false", text)
+}
+
+// mutatePhone takes a number and optionally its country code, e.g. "+4915112345678 DE"
+func mutatePhone(_ Column, text string) (interface{}, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return clearedValue, nil
+	}
+	value := map[string]string{"phone": fields[0]}
+	if len(fields) > 1 {
+		value["countryShortName"] = strings.ToUpper(strings.Trim(fields[1], "()"))
+	}
+	return value, nil
+}
+
+// mutateEmail takes an address, optionally with a label: "Alice <alice@example.com>"
+func mutateEmail(_ Column, text string) (interface{}, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return clearedValue, nil
+	}
+	label, email := text, text
+	if open := strings.LastIndex(text, "<"); open >= 0 && strings.HasSuffix(text, ">") {
+		label, email = strings.TrimSpace(text[:open]), text[open+1:len(text)-1]
+	}
+	if !strings.Contains(email, "@") {
+		return nil, fmt.Errorf("invalid email %q", email)
+	}
+	return map[string]string{"email": email, "text": label}, nil
+}
+
+// mutateWorldClock takes an IANA time zone, e.g. "Europe/Berlin"
+func mutateWorldClock(_ Column, text string) (interface{}, error) {
+	if text == "" {
+		return clearedValue, nil
+	}
+	if _, err := time.LoadLocation(text); err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", text, err)
+	}
+	return map[string]string{"timezone": text}, nil
+}
@@ -0,0 +1,120 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// LabelValue is the value of a status or dropdown label column
+type LabelValue struct {
+	Label string `json:"label"`
+}
+
+// PersonOrTeam references a user or team in a people column
+type PersonOrTeam struct {
+	ID   int64  `json:"id"`
+	Kind string `json:"kind"`
+}
+
+// PeopleValue is the value of a people column
+type PeopleValue struct {
+	PersonsAndTeams []PersonOrTeam `json:"personsAndTeams"`
+	ChangedAt       string         `json:"changed_at,omitempty"`
+}
+
+// NewPersonValue assigns a single user, the ID must be numeric
+func NewPersonValue(userID string) (PeopleValue, error) {
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return PeopleValue{}, fmt.Errorf("invalid user ID %q: %w", userID, err)
+	}
+	return PeopleValue{
+		PersonsAndTeams: []PersonOrTeam{{ID: id, Kind: "person"}},
+		ChangedAt:       time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// NewTeamValue assigns a single team, the ID must be numeric
+func NewTeamValue(teamID string) (PeopleValue, error) {
+	id, err := strconv.ParseInt(teamID, 10, 64)
+	if err != nil {
+		return PeopleValue{}, fmt.Errorf("invalid team ID %q: %w", teamID, err)
+	}
+	return PeopleValue{
+		PersonsAndTeams: []PersonOrTeam{{ID: id, Kind: "team"}},
+		ChangedAt:       time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// DateValue is the value of a date column
+type DateValue struct {
+	Date string `json:"date"`
+}
+
+// NewDateValue sets a date column to the day of t
+func NewDateValue(t time.Time) DateValue {
+	return DateValue{Date: t.Format("2006-01-02")}
+}
+
+// RelationValue is the value of a board_relation column, e.g. the sprint of a task
+type RelationValue struct {
+	ItemIDs []int64 `json:"item_ids"`
+}
+
+// NewRelationValue links the given items, the IDs must be numeric
+func NewRelationValue(itemIDs ...string) (RelationValue, error) {
+	value := RelationValue{ItemIDs: make([]int64, 0, len(itemIDs))}
+	for _, itemID := range itemIDs {
+		id, err := strconv.ParseInt(itemID, 10, 64)
+		if err != nil {
+			return RelationValue{}, fmt.Errorf("invalid item ID %q: %w", itemID, err)
+		}
+		value.ItemIDs = append(value.ItemIDs, id)
+	}
+	return value, nil
+}
+
+// ColumnValues maps column IDs to their new values for create_item and change_multiple_column_values
+type ColumnValues map[string]interface{}
+
+// SetLabel sets a label column, empty column IDs or labels are skipped
+func (cv ColumnValues) SetLabel(columnID, label string) {
+	if columnID == "" || label == "" {
+		return
+	}
+	cv[columnID] = LabelValue{Label: label}
+}
+
+// SetValidLabel validates a label against the column settings before setting it,
+// nil columns or empty labels are skipped
+func (cv ColumnValues) SetValidLabel(column *Column, label string) error {
+	if column == nil || label == "" {
+		return nil
+	}
+	valid, err := ValidateLabel(*column, label)
+	if err != nil {
+		return err
+	}
+	cv.SetLabel(column.ID, valid)
+	return nil
+}
+
+// JSON encodes the values as the JSON string the API expects in a JSON! variable
+func (cv ColumnValues) JSON() (string, error) {
+	data, err := json.Marshal(cv)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode column values: %w", err)
+	}
+	return string(data), nil
+}
+
+// encodeColumnValue encodes a single column value for change_column_value
+func encodeColumnValue(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode column value: %w", err)
+	}
+	return string(data), nil
+}
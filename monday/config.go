@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 )
 
 type Filters struct {
@@ -22,21 +23,63 @@ type Filters struct {
 	TypeBlacklist      []string `json:"type_blacklist"`
 	SprintWhitelist    []string `json:"sprint_whitelist"`
 	SprintBlacklist    []string `json:"sprint_blacklist"`
+	UpdatedWhitelist   []string `json:"updated_whitelist,omitempty"`
+	UpdatedBlacklist   []string `json:"updated_blacklist,omitempty"`
+	DueWhitelist       []string `json:"due_whitelist,omitempty"`
+	DueBlacklist       []string `json:"due_blacklist,omitempty"`
+	TagWhitelist       []string `json:"tag_whitelist,omitempty"`
+	TagBlacklist       []string `json:"tag_blacklist,omitempty"`
+	TeamWhitelist      []string `json:"team_whitelist,omitempty"`
+	TeamBlacklist      []string `json:"team_blacklist,omitempty"`
+	CreatorWhitelist   []string `json:"creator_whitelist,omitempty"` // Creator names or user IDs
+	CreatorBlacklist   []string `json:"creator_blacklist,omitempty"`
+	CreatedWhitelist   []string `json:"created_whitelist,omitempty"`
+	CreatedBlacklist   []string `json:"created_blacklist,omitempty"`
+	TouchedByWhitelist []string `json:"touched_by_whitelist,omitempty"` // Only set by the -touched-by flag
 }
 
 // Config represents Monday.com configuration
 type Config struct {
-	APIKey        string  `json:"api_key"`
-	BaseURL       string  `json:"base_url"`
-	Timeout       int     `json:"timeout_seconds"`
-	BoardID       string  `json:"board_id"`
-	SprintID      string  `json:"sprint_id"`
-	SprintBoardId string  `json:"sprint_board_id"`
-	UserID        string  `json:"user_id"`
-	UserName      string  `json:"user_name"`
-	UserEmail     string  `json:"user_email"`
-	UserTitle     string  `json:"user_title"`
-	Filters       Filters `json:"filters"`
+	APIKey          string          `json:"api_key"`
+	AuthProvider    string          `json:"auth_provider,omitempty"`
+	AuthCommand     string          `json:"auth_command,omitempty"`
+	OAuth           *OAuthApp       `json:"oauth,omitempty"`
+	BaseURL         string          `json:"base_url"`
+	Timeout         int             `json:"timeout_seconds"`
+	Proxy           string          `json:"proxy,omitempty"`      // HTTP(S) proxy URL, HTTPS_PROXY from the environment when empty
+	CABundle        string          `json:"ca_bundle,omitempty"`  // PEM file with extra CA certificates, e.g. of a corporate proxy
+	ColumnIDs       []string        `json:"column_ids,omitempty"` // Column values fetched with tasks, all when empty
+	BoardID         string          `json:"board_id"`
+	SprintID        string          `json:"sprint_id"`
+	SprintBoardId   string          `json:"sprint_board_id"`
+	EpicsBoardID    string          `json:"epics_board_id,omitempty"` // Board of epics linking tasks of other boards
+	UserID          string          `json:"user_id"`
+	UserName        string          `json:"user_name"`
+	UserEmail       string          `json:"user_email"`
+	UserTitle       string          `json:"user_title"`
+	Filters         Filters         `json:"filters"`
+	Views           map[string]View `json:"views,omitempty"`
+	BranchPattern   string          `json:"branch_pattern,omitempty"`
+	PRColumnID      string          `json:"pr_column_id,omitempty"`
+	Shortcuts       StatusShortcuts `json:"status_shortcuts,omitempty"` // Status labels of task done, start and block
+	Recurring       RecurringTasks  `json:"recurring,omitempty"`        // Tasks created by recur run, by name
+	AccountSlug     string          `json:"account_slug,omitempty"`
+	Boards          []BoardRef      `json:"boards,omitempty"`
+	CacheBackend    string          `json:"cache_backend,omitempty"`     // Store backend of the cache, "file" when empty
+	CacheLocation   string          `json:"cache_location,omitempty"`    // Backend specific, e.g. the directory of the file backend
+	CheckDuplicates bool            `json:"check_duplicates,omitempty"`  // Look for tasks with a similar name before creating one
+	StatusAgeLimits StatusAgeLimits `json:"status_age_limits,omitempty"` // Days in a status until listings warn, by status
+	SlackWebhook    string          `json:"slack_webhook,omitempty"`     // Incoming webhook of 'report slack'
+	SlackTemplates  SlackTemplates  `json:"slack_templates,omitempty"`
+	Hooks           Hooks           `json:"hooks,omitempty"` // Commands and URLs run on changes made by the CLI, by name
+
+	boardOverride string // Board selected with --board for a single run, never saved
+}
+
+// BoardRef is a configured board, the name is a short alias for --board and board:index IDs
+type BoardRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // DefaultConfig returns the default configuration
@@ -60,10 +103,20 @@ func DefaultConfig() *Config {
 			TypeBlacklist:      []string{},
 			SprintWhitelist:    []string{},
 			SprintBlacklist:    []string{},
+			UpdatedWhitelist:   []string{},
+			UpdatedBlacklist:   []string{},
+			DueWhitelist:       []string{},
+			DueBlacklist:       []string{},
 		},
 	}
 }
 
+// ConfigExists checks if a config file has been written before
+func ConfigExists(configPath string) bool {
+	_, err := os.Stat(configPath)
+	return err == nil
+}
+
 // LoadConfig loads configuration from file
 func LoadConfig(configPath string) (*Config, error) {
 	// Check if config file exists
@@ -131,19 +184,247 @@ func (c *Config) GetAPIKey() string {
 	return c.APIKey
 }
 
+// SetAuthCommand makes the API token come from the output of a command
+func (c *Config) SetAuthCommand(command string) {
+	c.AuthProvider = string(AuthCommand)
+	c.AuthCommand = command
+}
+
+// SetStaticAuth makes the API token come from the configured API key
+func (c *Config) SetStaticAuth() {
+	c.AuthProvider = string(AuthStatic)
+	c.AuthCommand = ""
+}
+
+// SetOAuth makes the API token come from the OAuth app, once logged in with 'auth login'
+func (c *Config) SetOAuth(app OAuthApp) {
+	c.AuthProvider = string(AuthOAuth)
+	c.OAuth = &app
+}
+
+// GetAuthProviderType returns the configured auth provider, defaulting to the static API key
+func (c *Config) GetAuthProviderType() AuthProviderType {
+	if c.AuthProvider == "" {
+		return AuthStatic
+	}
+	return AuthProviderType(c.AuthProvider)
+}
+
+// NewAuthProvider creates the auth provider selected in the configuration
+func (c *Config) NewAuthProvider() (AuthProvider, error) {
+	switch c.GetAuthProviderType() {
+	case AuthStatic:
+		return NewStaticTokenProvider(c.APIKey), nil
+	case AuthCommand:
+		if c.AuthCommand == "" {
+			return nil, fmt.Errorf("auth provider 'command' needs a command - run 'config set-auth-command <command>'")
+		}
+		return NewCommandTokenProvider(c.AuthCommand), nil
+	case AuthOAuth:
+		if c.OAuth == nil || c.OAuth.ClientID == "" {
+			return nil, fmt.Errorf("auth provider 'oauth' needs an OAuth app - run 'auth login -client-id <id> -client-secret <secret>'")
+		}
+		opts, err := c.HTTPOptions()
+		if err != nil {
+			return nil, err
+		}
+		return NewOAuthTokenProvider(*c.OAuth, GetOAuthTokenPath(), NewHTTPClient(opts...)), nil
+	default:
+		return nil, fmt.Errorf("unknown auth provider: %s", c.AuthProvider)
+	}
+}
+
+// HasCredentials checks if the selected auth provider has what it needs
+func (c *Config) HasCredentials() bool {
+	switch c.GetAuthProviderType() {
+	case AuthStatic:
+		return c.APIKey != ""
+	case AuthCommand:
+		return c.AuthCommand != ""
+	case AuthOAuth:
+		_, err := os.Stat(GetOAuthTokenPath())
+		return c.OAuth != nil && c.OAuth.ClientID != "" && err == nil
+	default:
+		return false
+	}
+}
+
 // SetBoardID sets the board ID in the configuration
 func (c *Config) SetBoardID(boardID string) {
 	c.BoardID = boardID
 }
 
-// GetBoardID returns the board ID
+// GetBoardID returns the board selected for this run, or the configured board ID
 func (c *Config) GetBoardID() string {
+	if c.boardOverride != "" {
+		return c.boardOverride
+	}
 	return c.BoardID
 }
 
+// SetBoardOverride selects another board for this run without changing the configured one
+func (c *Config) SetBoardOverride(boardID string) {
+	c.boardOverride = boardID
+}
+
+// AddBoard adds a board to the configured boards, replacing one with the same ID
+func (c *Config) AddBoard(ref BoardRef) {
+	for i, board := range c.Boards {
+		if board.ID == ref.ID {
+			c.Boards[i] = ref
+			return
+		}
+	}
+	c.Boards = append(c.Boards, ref)
+}
+
+// RemoveBoard removes a configured board by name or ID and reports whether it was found
+func (c *Config) RemoveBoard(nameOrID string) bool {
+	for i, board := range c.Boards {
+		if board.ID == nameOrID || strings.EqualFold(board.Name, nameOrID) {
+			c.Boards = append(c.Boards[:i], c.Boards[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// GetBoards returns the configured boards, the default board first. A default board that
+// was never added is named by its ID.
+func (c *Config) GetBoards() []BoardRef {
+	var boards []BoardRef
+	if c.BoardID != "" {
+		ref := BoardRef{ID: c.BoardID, Name: c.BoardID}
+		for _, board := range c.Boards {
+			if board.ID == c.BoardID {
+				ref = board
+			}
+		}
+		boards = append(boards, ref)
+	}
+	for _, board := range c.Boards {
+		if board.ID != c.BoardID {
+			boards = append(boards, board)
+		}
+	}
+	return boards
+}
+
+// FindBoard finds a configured board by name, ignoring case, or by ID
+func (c *Config) FindBoard(nameOrID string) (BoardRef, bool) {
+	for _, board := range c.GetBoards() {
+		if board.ID == nameOrID || strings.EqualFold(board.Name, nameOrID) {
+			return board, true
+		}
+	}
+	return BoardRef{}, false
+}
+
 // IsConfigured checks if the configuration is complete
 func (c *Config) IsConfigured() bool {
-	return c.APIKey != "" && c.HasUserInfo() && c.BoardID != ""
+	return c.HasCredentials() && c.HasUserInfo() && c.BoardID != ""
+}
+
+// SetBranchPattern sets the pattern used to name git branches for tasks
+func (c *Config) SetBranchPattern(pattern string) {
+	c.BranchPattern = pattern
+}
+
+// GetBranchPattern returns the branch pattern, or the default one
+func (c *Config) GetBranchPattern() string {
+	if c.BranchPattern == "" {
+		return DefaultBranchPattern
+	}
+	return c.BranchPattern
+}
+
+// SetPRColumnID sets the link column that holds pull request URLs
+func (c *Config) SetPRColumnID(columnID string) {
+	c.PRColumnID = columnID
+}
+
+// GetPRColumnID returns the pull request link column, empty to detect it by title
+func (c *Config) GetPRColumnID() string {
+	return c.PRColumnID
+}
+
+// SetAccountSlug sets the account subdomain used to build item URLs
+func (c *Config) SetAccountSlug(slug string) {
+	c.AccountSlug = slug
+}
+
+// SetCheckDuplicates turns the search for similar tasks before creating one on or off
+func (c *Config) SetCheckDuplicates(check bool) {
+	c.CheckDuplicates = check
+}
+
+// GetCheckDuplicates reports whether similar tasks are searched before creating one
+func (c *Config) GetCheckDuplicates() bool {
+	return c.CheckDuplicates
+}
+
+// SetTimeout sets the request timeout in seconds
+func (c *Config) SetTimeout(seconds int) {
+	c.Timeout = seconds
+}
+
+// SetProxy sets the proxy URL, empty to use the proxy from the environment
+func (c *Config) SetProxy(proxy string) {
+	c.Proxy = proxy
+}
+
+// SetCABundle sets the PEM file with extra CA certificates, empty for the system certificates only
+func (c *Config) SetCABundle(path string) {
+	c.CABundle = path
+}
+
+// SetColumnIDs sets the columns whose values are fetched with the tasks, nil for all columns
+func (c *Config) SetColumnIDs(ids []string) {
+	c.ColumnIDs = ids
+}
+
+// GetColumnIDs returns the columns whose values are fetched with the tasks, empty for all
+func (c *Config) GetColumnIDs() []string {
+	return c.ColumnIDs
+}
+
+// HTTPOptions returns the client options for the configured timeout, proxy and CA bundle
+func (c *Config) HTTPOptions() ([]ClientOption, error) {
+	var opts []ClientOption
+	if c.Timeout > 0 {
+		opts = append(opts, WithTimeout(time.Duration(c.Timeout)*time.Second))
+	}
+	if c.Proxy != "" {
+		proxy, err := ParseProxyURL(c.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithProxy(proxy))
+	}
+	if c.CABundle != "" {
+		pool, err := LoadCABundle(c.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithRootCAs(pool))
+	}
+	return opts, nil
+}
+
+// SetCache selects the store backend of the cache and its location
+func (c *Config) SetCache(backend, location string) {
+	c.CacheBackend = backend
+	c.CacheLocation = location
+}
+
+// OpenCacheStore opens the configured cache store
+func (c *Config) OpenCacheStore() (Store, error) {
+	return OpenStore(c.CacheBackend, c.CacheLocation)
+}
+
+// GetAccountSlug returns the account subdomain, empty to look item URLs up with the API
+func (c *Config) GetAccountSlug() string {
+	return c.AccountSlug
 }
 
 // SetSprintID sets the sprint ID in the configuration
@@ -156,6 +437,31 @@ func (c *Config) GetSprintID() string {
 	return c.SprintID
 }
 
+// SprintIDAuto as the sprint ID picks the sprint running today from the dates on the sprint board
+const SprintIDAuto = "auto"
+
+// IsSprintAuto reports whether the current sprint is detected from the sprint dates
+func (c *Config) IsSprintAuto() bool {
+	return strings.EqualFold(c.SprintID, SprintIDAuto)
+}
+
+// currentSprintFilter returns the current sprint as a sprint filter value. With auto it is the
+// name of the cached sprint running today.
+func (c *Config) currentSprintFilter() (string, error) {
+	if c.SprintID == "" {
+		return "", fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	}
+	if !c.IsSprintAuto() {
+		return strings.ToLower(c.SprintID), nil
+	}
+	sprints, _, _ := NewDataStore().GetCachedBoardSprints(c.GetSprintBoardID())
+	sprint, ok := CurrentSprint(sprints, c.SprintID, time.Now())
+	if !ok {
+		return "", fmt.Errorf("no cached sprint runs today - run 'tasks fetch' with a sprint board configured")
+	}
+	return strings.ToLower(sprint.Name), nil
+}
+
 // SetSprintBoardID sets the sprint board ID in the configuration
 func (c *Config) SetSprintBoardID(sprintBoardID string) {
 	c.SprintBoardId = sprintBoardID
@@ -166,6 +472,16 @@ func (c *Config) GetSprintBoardID() string {
 	return c.SprintBoardId
 }
 
+// SetEpicsBoardID sets the board whose items are epics
+func (c *Config) SetEpicsBoardID(epicsBoardID string) {
+	c.EpicsBoardID = epicsBoardID
+}
+
+// GetEpicsBoardID returns the board whose items are epics, empty when not configured
+func (c *Config) GetEpicsBoardID() string {
+	return c.EpicsBoardID
+}
+
 func (c *Config) AddStatusWhitelist(status string) {
 	c.Filters.StatusWhitelist = append(c.Filters.StatusWhitelist, status)
 }
@@ -313,6 +629,12 @@ const (
 	FilterSprint    FilterType = "sprint"
 	FilterUserName  FilterType = "user_name"
 	FilterUserEmail FilterType = "user_email"
+	FilterUpdated   FilterType = "updated" // Date filter on the last update, e.g. ">7d"
+	FilterDue       FilterType = "due"     // Date filter on the due date, e.g. "<3d"
+	FilterTag       FilterType = "tag"
+	FilterTeam      FilterType = "team"
+	FilterCreator   FilterType = "creator" // Name or user ID of who created the task
+	FilterCreated   FilterType = "created" // Date filter on the creation, e.g. "<14d"
 )
 
 // FilterListType represents whether it's a whitelist or blacklist
@@ -326,6 +648,11 @@ const (
 // AddFilter adds a value to the specified filter list
 func (c *Config) AddFilter(filterType FilterType, listType FilterListType, value string) error {
 	value = strings.ToLower(value)
+	if filterType == FilterUpdated || filterType == FilterDue {
+		if _, err := ParseDateCondition(value); err != nil {
+			return err
+		}
+	}
 	switch filterType {
 	case FilterStatus:
 		if listType == Whitelist {
@@ -363,6 +690,42 @@ func (c *Config) AddFilter(filterType FilterType, listType FilterListType, value
 		} else {
 			c.AddUserEmailBlacklist(value)
 		}
+	case FilterUpdated:
+		if listType == Whitelist {
+			c.Filters.UpdatedWhitelist = append(c.Filters.UpdatedWhitelist, value)
+		} else {
+			c.Filters.UpdatedBlacklist = append(c.Filters.UpdatedBlacklist, value)
+		}
+	case FilterDue:
+		if listType == Whitelist {
+			c.Filters.DueWhitelist = append(c.Filters.DueWhitelist, value)
+		} else {
+			c.Filters.DueBlacklist = append(c.Filters.DueBlacklist, value)
+		}
+	case FilterTag:
+		if listType == Whitelist {
+			c.Filters.TagWhitelist = append(c.Filters.TagWhitelist, value)
+		} else {
+			c.Filters.TagBlacklist = append(c.Filters.TagBlacklist, value)
+		}
+	case FilterTeam:
+		if listType == Whitelist {
+			c.Filters.TeamWhitelist = append(c.Filters.TeamWhitelist, value)
+		} else {
+			c.Filters.TeamBlacklist = append(c.Filters.TeamBlacklist, value)
+		}
+	case FilterCreator:
+		if listType == Whitelist {
+			c.Filters.CreatorWhitelist = append(c.Filters.CreatorWhitelist, value)
+		} else {
+			c.Filters.CreatorBlacklist = append(c.Filters.CreatorBlacklist, value)
+		}
+	case FilterCreated:
+		if listType == Whitelist {
+			c.Filters.CreatedWhitelist = append(c.Filters.CreatedWhitelist, value)
+		} else {
+			c.Filters.CreatedBlacklist = append(c.Filters.CreatedBlacklist, value)
+		}
 	default:
 		return fmt.Errorf("unknown filter type: %s", filterType)
 	}
@@ -409,6 +772,42 @@ func (c *Config) RemoveFilter(filterType FilterType, listType FilterListType, va
 		} else {
 			c.RemoveUserEmailBlacklist(value)
 		}
+	case FilterUpdated:
+		if listType == Whitelist {
+			c.Filters.UpdatedWhitelist = removeFromSlice(c.Filters.UpdatedWhitelist, value)
+		} else {
+			c.Filters.UpdatedBlacklist = removeFromSlice(c.Filters.UpdatedBlacklist, value)
+		}
+	case FilterDue:
+		if listType == Whitelist {
+			c.Filters.DueWhitelist = removeFromSlice(c.Filters.DueWhitelist, value)
+		} else {
+			c.Filters.DueBlacklist = removeFromSlice(c.Filters.DueBlacklist, value)
+		}
+	case FilterTag:
+		if listType == Whitelist {
+			c.Filters.TagWhitelist = removeFromSlice(c.Filters.TagWhitelist, value)
+		} else {
+			c.Filters.TagBlacklist = removeFromSlice(c.Filters.TagBlacklist, value)
+		}
+	case FilterTeam:
+		if listType == Whitelist {
+			c.Filters.TeamWhitelist = removeFromSlice(c.Filters.TeamWhitelist, value)
+		} else {
+			c.Filters.TeamBlacklist = removeFromSlice(c.Filters.TeamBlacklist, value)
+		}
+	case FilterCreator:
+		if listType == Whitelist {
+			c.Filters.CreatorWhitelist = removeFromSlice(c.Filters.CreatorWhitelist, value)
+		} else {
+			c.Filters.CreatorBlacklist = removeFromSlice(c.Filters.CreatorBlacklist, value)
+		}
+	case FilterCreated:
+		if listType == Whitelist {
+			c.Filters.CreatedWhitelist = removeFromSlice(c.Filters.CreatedWhitelist, value)
+		} else {
+			c.Filters.CreatedBlacklist = removeFromSlice(c.Filters.CreatedBlacklist, value)
+		}
 	default:
 		return fmt.Errorf("unknown filter type: %s", filterType)
 	}
@@ -454,6 +853,42 @@ func (c *Config) ClearFilter(filterType FilterType, listType FilterListType) err
 		} else {
 			c.Filters.UserEmailBlacklist = []string{}
 		}
+	case FilterUpdated:
+		if listType == Whitelist {
+			c.Filters.UpdatedWhitelist = []string{}
+		} else {
+			c.Filters.UpdatedBlacklist = []string{}
+		}
+	case FilterDue:
+		if listType == Whitelist {
+			c.Filters.DueWhitelist = []string{}
+		} else {
+			c.Filters.DueBlacklist = []string{}
+		}
+	case FilterTag:
+		if listType == Whitelist {
+			c.Filters.TagWhitelist = []string{}
+		} else {
+			c.Filters.TagBlacklist = []string{}
+		}
+	case FilterTeam:
+		if listType == Whitelist {
+			c.Filters.TeamWhitelist = []string{}
+		} else {
+			c.Filters.TeamBlacklist = []string{}
+		}
+	case FilterCreator:
+		if listType == Whitelist {
+			c.Filters.CreatorWhitelist = []string{}
+		} else {
+			c.Filters.CreatorBlacklist = []string{}
+		}
+	case FilterCreated:
+		if listType == Whitelist {
+			c.Filters.CreatedWhitelist = []string{}
+		} else {
+			c.Filters.CreatedBlacklist = []string{}
+		}
 	default:
 		return fmt.Errorf("unknown filter type: %s", filterType)
 	}
@@ -499,6 +934,42 @@ func (c *Config) GetFilterValues(filterType FilterType, listType FilterListType)
 		} else {
 			return c.Filters.UserEmailBlacklist
 		}
+	case FilterUpdated:
+		if listType == Whitelist {
+			return c.Filters.UpdatedWhitelist
+		} else {
+			return c.Filters.UpdatedBlacklist
+		}
+	case FilterDue:
+		if listType == Whitelist {
+			return c.Filters.DueWhitelist
+		} else {
+			return c.Filters.DueBlacklist
+		}
+	case FilterTag:
+		if listType == Whitelist {
+			return c.Filters.TagWhitelist
+		} else {
+			return c.Filters.TagBlacklist
+		}
+	case FilterTeam:
+		if listType == Whitelist {
+			return c.Filters.TeamWhitelist
+		} else {
+			return c.Filters.TeamBlacklist
+		}
+	case FilterCreator:
+		if listType == Whitelist {
+			return c.Filters.CreatorWhitelist
+		} else {
+			return c.Filters.CreatorBlacklist
+		}
+	case FilterCreated:
+		if listType == Whitelist {
+			return c.Filters.CreatedWhitelist
+		} else {
+			return c.Filters.CreatedBlacklist
+		}
 	default:
 		return []string{}
 	}
@@ -519,6 +990,10 @@ func (c *Config) ClearAllFilters() {
 		TypeBlacklist:      []string{},
 		SprintWhitelist:    []string{},
 		SprintBlacklist:    []string{},
+		UpdatedWhitelist:   []string{},
+		UpdatedBlacklist:   []string{},
+		DueWhitelist:       []string{},
+		DueBlacklist:       []string{},
 	}
 }
 
@@ -614,8 +1089,9 @@ func (c *Config) RemoveCurrentUserFromBlacklist() error {
 // Convenience methods for current sprint filtering
 // FilterToCurrentSprint sets filters to show only tasks from the current sprint
 func (c *Config) FilterToCurrentSprint() error {
-	if c.SprintID == "" {
-		return fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	sprintID, err := c.currentSprintFilter()
+	if err != nil {
+		return err
 	}
 
 	// Clear existing sprint filters
@@ -623,19 +1099,18 @@ func (c *Config) FilterToCurrentSprint() error {
 	c.Filters.SprintBlacklist = []string{}
 
 	// Add current sprint to whitelist
-	c.Filters.SprintWhitelist = append(c.Filters.SprintWhitelist, strings.ToLower(c.SprintID))
+	c.Filters.SprintWhitelist = append(c.Filters.SprintWhitelist, sprintID)
 
 	return nil
 }
 
 // AddCurrentSprintToWhitelist adds the current sprint to the sprint whitelist
 func (c *Config) AddCurrentSprintToWhitelist() error {
-	if c.SprintID == "" {
-		return fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	sprintID, err := c.currentSprintFilter()
+	if err != nil {
+		return err
 	}
 
-	sprintID := strings.ToLower(c.SprintID)
-
 	if !slices.Contains(c.Filters.SprintWhitelist, sprintID) {
 		c.Filters.SprintWhitelist = append(c.Filters.SprintWhitelist, sprintID)
 	}
@@ -645,11 +1120,10 @@ func (c *Config) AddCurrentSprintToWhitelist() error {
 
 // RemoveCurrentSprintFromWhitelist removes the current sprint from the sprint whitelist
 func (c *Config) RemoveCurrentSprintFromWhitelist() error {
-	if c.SprintID == "" {
-		return fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	sprintID, err := c.currentSprintFilter()
+	if err != nil {
+		return err
 	}
-
-	sprintID := strings.ToLower(c.SprintID)
 	c.Filters.SprintWhitelist = removeFromSlice(c.Filters.SprintWhitelist, sprintID)
 
 	return nil
@@ -657,12 +1131,11 @@ func (c *Config) RemoveCurrentSprintFromWhitelist() error {
 
 // AddCurrentSprintToBlacklist adds the current sprint to the sprint blacklist
 func (c *Config) AddCurrentSprintToBlacklist() error {
-	if c.SprintID == "" {
-		return fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	sprintID, err := c.currentSprintFilter()
+	if err != nil {
+		return err
 	}
 
-	sprintID := strings.ToLower(c.SprintID)
-
 	if !slices.Contains(c.Filters.SprintBlacklist, sprintID) {
 		c.Filters.SprintBlacklist = append(c.Filters.SprintBlacklist, sprintID)
 	}
@@ -672,11 +1145,10 @@ func (c *Config) AddCurrentSprintToBlacklist() error {
 
 // RemoveCurrentSprintFromBlacklist removes the current sprint from the sprint blacklist
 func (c *Config) RemoveCurrentSprintFromBlacklist() error {
-	if c.SprintID == "" {
-		return fmt.Errorf("current sprint not set - run 'config set-sprint-id <sprint-id>' first")
+	sprintID, err := c.currentSprintFilter()
+	if err != nil {
+		return err
 	}
-
-	sprintID := strings.ToLower(c.SprintID)
 	c.Filters.SprintBlacklist = removeFromSlice(c.Filters.SprintBlacklist, sprintID)
 
 	return nil
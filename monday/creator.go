@@ -0,0 +1,17 @@
+package monday
+
+import "strings"
+
+// CreatedByAny reports whether the task was created by one of the given users, matched by
+// name ignoring case or by user ID
+func (t Task) CreatedByAny(users []string) bool {
+	if t.CreatorID == "" && t.CreatorName == "" {
+		return false
+	}
+	for _, user := range users {
+		if user == t.CreatorID || strings.EqualFold(user, t.CreatorName) {
+			return true
+		}
+	}
+	return false
+}
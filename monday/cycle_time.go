@@ -0,0 +1,176 @@
+package monday
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StatusChange is a change of an item's status column, taken from the activity log
+type StatusChange struct {
+	ItemID   string
+	ItemName string
+	From     Status
+	To       Status
+	At       time.Time
+}
+
+// ParseStatusChanges extracts the status column changes from activity log entries, oldest first.
+// The status column is found by its ID like when parsing board items.
+func ParseStatusChanges(logs []ActivityLog) []StatusChange {
+	var changes []StatusChange
+	for _, event := range DecodeActivityLogs(logs) {
+		change, ok := event.(ColumnValueChanged)
+		if !ok || !strings.Contains(strings.ToLower(change.ColumnID), "status") {
+			continue
+		}
+		if change.ItemID == "" || change.At.IsZero() || change.From == change.To {
+			continue
+		}
+		changes = append(changes, StatusChange{
+			ItemID:   change.ItemID,
+			ItemName: change.ItemName,
+			From:     Status(change.From),
+			To:       Status(change.To),
+			At:       change.At,
+		})
+	}
+	return changes
+}
+
+// TaskCycle is the time a task spent in each status, measured between the observed status changes
+type TaskCycle struct {
+	Task      Task
+	InStatus  map[Status]time.Duration
+	Changes   int
+	CycleTime time.Duration // From entering an active status until done, zero when not finished
+}
+
+// Done reports whether the task was finished within the observed changes
+func (tc TaskCycle) Done() bool {
+	return tc.CycleTime > 0
+}
+
+// CycleTimes computes the time in status per task from status changes sorted oldest first.
+// The time before a task's first change is unknown and not counted. The current status counts
+// until now unless it is a done status. Tasks are taken from the given map when known.
+func CycleTimes(changes []StatusChange, tasks map[string]Task, now time.Time) []TaskCycle {
+	byItem := make(map[string][]StatusChange)
+	var order []string
+	for _, change := range changes {
+		if _, ok := byItem[change.ItemID]; !ok {
+			order = append(order, change.ItemID)
+		}
+		byItem[change.ItemID] = append(byItem[change.ItemID], change)
+	}
+
+	cycles := make([]TaskCycle, 0, len(order))
+	for _, itemID := range order {
+		itemChanges := byItem[itemID]
+		task, ok := tasks[itemID]
+		if !ok {
+			last := itemChanges[len(itemChanges)-1]
+			task = Task{ID: itemID, Name: last.ItemName, Status: last.To}
+		}
+		cycle := TaskCycle{Task: task, InStatus: make(map[Status]time.Duration), Changes: len(itemChanges)}
+
+		var started time.Time
+		for i, change := range itemChanges {
+			end := now
+			if i+1 < len(itemChanges) {
+				end = itemChanges[i+1].At
+			} else if IsDoneStatus(change.To) {
+				end = change.At
+			}
+			cycle.InStatus[change.To] += end.Sub(change.At)
+
+			if started.IsZero() && IsActiveStatus(change.To) {
+				started = change.At
+			}
+			if !started.IsZero() && cycle.CycleTime == 0 && IsDoneStatus(change.To) {
+				cycle.CycleTime = change.At.Sub(started)
+			}
+		}
+		for status, duration := range cycle.InStatus {
+			if duration <= 0 {
+				delete(cycle.InStatus, status)
+			}
+		}
+		cycles = append(cycles, cycle)
+	}
+
+	// Cached tasks by local ID, then the ones missing from the cache
+	sort.SliceStable(cycles, func(i, j int) bool {
+		localI, localJ := cycles[i].Task.LocalId, cycles[j].Task.LocalId
+		if (localI == 0) != (localJ == 0) {
+			return localJ == 0
+		}
+		if localI != localJ {
+			return localI < localJ
+		}
+		idI, _ := strconv.Atoi(cycles[i].Task.ID)
+		idJ, _ := strconv.Atoi(cycles[j].Task.ID)
+		return idI < idJ
+	})
+	return cycles
+}
+
+// StatusTime is the time all tasks together spent in one status
+type StatusTime struct {
+	Status Status
+	Total  time.Duration
+	Tasks  int
+}
+
+// Average returns the mean time a task spent in the status
+func (st StatusTime) Average() time.Duration {
+	if st.Tasks == 0 {
+		return 0
+	}
+	return st.Total / time.Duration(st.Tasks)
+}
+
+// SummarizeStatusTimes adds up the time in status of all tasks, longest average first
+func SummarizeStatusTimes(cycles []TaskCycle) []StatusTime {
+	byStatus := make(map[Status]*StatusTime)
+	for _, cycle := range cycles {
+		for status, duration := range cycle.InStatus {
+			statusTime, ok := byStatus[status]
+			if !ok {
+				statusTime = &StatusTime{Status: status}
+				byStatus[status] = statusTime
+			}
+			statusTime.Total += duration
+			statusTime.Tasks++
+		}
+	}
+
+	summary := make([]StatusTime, 0, len(byStatus))
+	for _, statusTime := range byStatus {
+		summary = append(summary, *statusTime)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Average() != summary[j].Average() {
+			return summary[i].Average() > summary[j].Average()
+		}
+		return summary[i].Status < summary[j].Status
+	})
+	return summary
+}
+
+// AverageCycleTime returns the mean cycle time of the finished tasks and how many there are
+func AverageCycleTime(cycles []TaskCycle) (time.Duration, int) {
+	var total time.Duration
+	finished := 0
+	for _, cycle := range cycles {
+		if cycle.Done() {
+			total += cycle.CycleTime
+			finished++
+		}
+	}
+	if finished == 0 {
+		return 0, 0
+	}
+	return total / time.Duration(finished), finished
+}
@@ -1,10 +1,9 @@
 package monday
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sync"
 	"time"
 )
 
@@ -16,21 +15,41 @@ type TaskCache struct {
 	Users      map[string]User // Maps user ID to User
 	Sprints    []Sprint        // List of sprints found on the board
 	Timestamp  time.Time
+
+	PreviousTasks     map[string]Task // Tasks from the sync before the last one
+	PreviousTimestamp time.Time
+
+	Branches map[string]string  `json:",omitempty"` // Maps git branch names to task IDs
+	Pending  []PendingOperation `json:",omitempty"` // Mutations queued while offline
+	Pinned   []string           `json:",omitempty"` // IDs of the tasks pinned with 'task pin', in pin order
 }
 
 // DataStore manages caching of task requests
 type DataStore struct {
-	cache map[string]TaskCache
+	mu       sync.Mutex // Guards cache while it is loaded and saved
+	cache    map[string]TaskCache
+	store    Store
+	readOnly error // Set when saving would overwrite a cache this build cannot read
 }
 
-// NewDataStore creates a new DataStore instance
+// NewDataStore creates a new DataStore instance on the default store, see SetDefaultStore
 func NewDataStore() *DataStore {
+	return NewDataStoreWith(getDefaultStore())
+}
+
+// NewDataStoreWith creates a DataStore persisting its cache to store
+func NewDataStoreWith(store Store) *DataStore {
 	ds := &DataStore{
 		cache: make(map[string]TaskCache),
+		store: store,
 	}
 	if err := ds.Load(); err != nil {
 		// Initialize empty cache if load fails
 		ds.cache = make(map[string]TaskCache)
+		if errors.Is(err, ErrCacheTooNew) {
+			logger.Warn("cache is read-only, upgrade monday-cli to update it", "error", err)
+			ds.readOnly = err
+		}
 	}
 	return ds
 }
@@ -49,7 +68,7 @@ func (ds *DataStore) StoreRawItems(boardID string, items []Item) {
 		ds.cache[boardID].RawItems[item.ID] = item
 	}
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
 }
 
@@ -68,7 +87,7 @@ func (ds *DataStore) StoreBoardUsers(boardID string, users []User) {
 		ds.cache[boardID].Users[user.ID] = user
 	}
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
 }
 
@@ -91,7 +110,7 @@ func (ds *DataStore) GetCachedBoardUsers(boardID string) ([]User, time.Time, boo
 // StoreBoardSprints stores a slice of Sprint objects in the cache
 func (ds *DataStore) StoreBoardSprints(boardID string, sprints []Sprint) {
 	if err := ds.Load(); err != nil {
-		fmt.Printf("Failed to load cache: %v\n", err)
+		logger.Warn("failed to load cache", "error", err)
 		return
 	}
 
@@ -112,7 +131,7 @@ func (ds *DataStore) StoreBoardSprints(boardID string, sprints []Sprint) {
 	ds.cache[boardID] = cache
 
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
 }
 
@@ -140,7 +159,7 @@ func (ds *DataStore) StoreSprintItems(sprintID string, tasks []Task, items []Ite
 // MergeSprintTasksIntoBoard merges sprint tasks into the board cache
 func (ds *DataStore) MergeSprintTasksIntoBoard(boardID string, sprintTasks []Task, sprintItems []Item) {
 	if err := ds.Load(); err != nil {
-		fmt.Printf("Failed to load cache: %v\n", err)
+		logger.Warn("failed to load cache", "error", err)
 		return
 	}
 
@@ -194,7 +213,7 @@ func (ds *DataStore) MergeSprintTasksIntoBoard(boardID string, sprintTasks []Tas
 	ds.cache[boardID] = cache
 
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
 }
 
@@ -217,10 +236,16 @@ func (ds *DataStore) GetCachedSprintItems(sprintID string) ([]Task, time.Time, b
 func (ds *DataStore) StoreTasksRequest(boardID string, tasks []Task, rawItems []Item) {
 	localIdMap := make(map[int]string)
 	tasksMap := make(map[string]Task)
+	now := time.Now()
 	for _, task := range tasks {
+		if existing, exists := ds.cache[boardID]; exists {
+			if cached, ok := existing.Tasks[task.ID]; ok {
+				task = carryStatusSince(cached, task, now)
+			}
+		}
 		tasksMap[task.ID] = task
 		if _, exists := localIdMap[task.LocalId]; exists {
-			fmt.Printf("Local ID %d already exists for task %s\n", task.LocalId, task.ID)
+			logger.Warn("local ID already exists", "local_id", task.LocalId, "task", task.ID)
 		}
 		localIdMap[task.LocalId] = task.ID
 	}
@@ -229,17 +254,36 @@ func (ds *DataStore) StoreTasksRequest(boardID string, tasks []Task, rawItems []
 		rawItemsMap[item.ID] = item
 	}
 
+	// Keep the previous snapshot so changes between the last two syncs can be shown
+	var previousTasks map[string]Task
+	var previousTimestamp time.Time
+	var branches map[string]string
+	var pending []PendingOperation
+	var pinned []string
+	if existing, exists := ds.cache[boardID]; exists {
+		previousTasks = existing.Tasks
+		previousTimestamp = existing.Timestamp
+		branches = existing.Branches
+		pending = existing.Pending
+		pinned = existing.Pinned
+	}
+
 	ds.cache[boardID] = TaskCache{
-		Tasks:      tasksMap,
-		LocalIdMap: localIdMap,
-		RawItems:   rawItemsMap,
-		Users:      make(map[string]User),
-		Sprints:    []Sprint{},
-		Timestamp:  time.Now(),
+		Tasks:             tasksMap,
+		LocalIdMap:        localIdMap,
+		RawItems:          rawItemsMap,
+		Users:             make(map[string]User),
+		Sprints:           []Sprint{},
+		Timestamp:         time.Now(),
+		PreviousTasks:     previousTasks,
+		PreviousTimestamp: previousTimestamp,
+		Branches:          branches,
+		Pending:           pending,
+		Pinned:            pinned,
 	}
 
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
 }
 
@@ -252,18 +296,18 @@ func (ds *DataStore) StoreTaskRequest(boardID string, task Task) (int, error) {
 			Timestamp:  time.Now(),
 		}
 	}
-	ds.cache[boardID].Tasks[task.ID] = task
 	localId, err := ds.GetTaskLocalIdByID(boardID, task.ID)
 	if err != nil {
-		fmt.Printf("Failed to get task local ID: %v\n", err)
+		logger.Warn("failed to get task local ID", "error", err)
 		localId = len(ds.cache[boardID].LocalIdMap) + 1
 	}
 	task.LocalId = localId
+	ds.cache[boardID].Tasks[task.ID] = task
 	ds.cache[boardID].LocalIdMap[localId] = task.ID
 
 	// Save cache to disk after update
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
+		logger.Warn("failed to save cache", "error", err)
 		return 0, fmt.Errorf("failed to save cache: %v", err)
 	}
 	return localId, nil
@@ -281,6 +325,18 @@ func (ds *DataStore) GetCachedTasks(boardID string) (map[string]Task, time.Time,
 	return nil, time.Time{}, false
 }
 
+// GetPreviousTasks retrieves the task snapshot from the sync before the last one
+func (ds *DataStore) GetPreviousTasks(boardID string) (map[string]Task, time.Time, bool) {
+	if err := ds.Load(); err != nil {
+		return make(map[string]Task), time.Time{}, false
+	}
+
+	if cached, exists := ds.cache[boardID]; exists && cached.PreviousTasks != nil {
+		return cached.PreviousTasks, cached.PreviousTimestamp, true
+	}
+	return nil, time.Time{}, false
+}
+
 func (ds *DataStore) GetCachedTask(boardID string, taskID string) (Task, time.Time, bool) {
 	if err := ds.Load(); err != nil {
 		return Task{}, time.Time{}, false
@@ -316,9 +372,9 @@ func (ds *DataStore) GetLocalIdMap(boardID string) (map[int]string, error) {
 }
 
 func (ds *DataStore) UpdateCachedTask(boardID string, taskID string, task Task) {
-	ds.cache[boardID].Tasks[taskID] = task
+	ds.cache[boardID].Tasks[taskID] = carryStatusSince(ds.cache[boardID].Tasks[taskID], task, time.Now())
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to update cached task: %v\n", err)
+		logger.Warn("failed to update cached task", "error", err)
 	}
 }
 
@@ -326,89 +382,86 @@ func (ds *DataStore) UpdateCachedTask(boardID string, taskID string, task Task)
 func (ds *DataStore) UpdateCachedTaskByLocalId(boardID string, localId int, task Task) {
 	if cached, exists := ds.cache[boardID]; exists {
 		if taskID, exists := cached.LocalIdMap[localId]; exists {
-			cached.Tasks[taskID] = task
+			// Tasks fetched from the API have no local ID yet
+			task.LocalId = localId
+			cached.Tasks[taskID] = carryStatusSince(cached.Tasks[taskID], task, time.Now())
 			if err := ds.Save(); err != nil {
-				fmt.Printf("Failed to update cached task: %v\n", err)
+				logger.Warn("failed to update cached task", "error", err)
 			}
 		}
 	}
 }
 
+// RemoveCachedTask removes a single task from the board cache
+func (ds *DataStore) RemoveCachedTask(boardID string, taskID string) bool {
+	cached, exists := ds.cache[boardID]
+	if !exists {
+		return false
+	}
+	if _, exists := cached.Tasks[taskID]; !exists {
+		return false
+	}
+	delete(cached.Tasks, taskID)
+	delete(cached.RawItems, taskID)
+	for localId, id := range cached.LocalIdMap {
+		if id == taskID {
+			delete(cached.LocalIdMap, localId)
+		}
+	}
+	if err := ds.Save(); err != nil {
+		logger.Warn("failed to save cache", "error", err)
+	}
+	return true
+}
+
 // ClearCache removes all cached entries
 func (ds *DataStore) ClearCache(boardID string) {
 	delete(ds.cache, boardID)
 
 	// Save cache to disk after update
 	if err := ds.Save(); err != nil {
-		fmt.Printf("Failed to save cache: %v\n", err)
-	}
-}
-
-// getCachePath returns the path to the cache file
-func getCachePath() (string, error) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		logger.Warn("failed to save cache", "error", err)
 	}
-	return filepath.Join(homeDir, ".cache", "monday-cli", "tasks.json"), nil
 }
 
-// Save persists the cache to disk
+// Save persists the cache to the store
 func (ds *DataStore) Save() error {
-	cachePath, err := getCachePath()
-	if err != nil {
-		return err
-	}
-
-	// Ensure cache directory exists
-	cacheDir := filepath.Dir(cachePath)
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
-		return fmt.Errorf("failed to create cache directory: %w", err)
-	}
-
-	data, err := json.Marshal(ds.cache)
-	if err != nil {
-		return fmt.Errorf("failed to marshal cache: %w", err)
-	}
-
-	if err := os.WriteFile(cachePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write cache file: %w", err)
+	if ds.readOnly != nil {
+		return ds.readOnly
 	}
-
-	return nil
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	return ds.store.SaveBoards(ds.cache)
 }
 
-// Load reads the cache from disk
+// Load reads the cache from the store, boards only held in memory are kept
 func (ds *DataStore) Load() error {
-	cachePath, err := getCachePath()
+	boards, err := ds.store.LoadBoards()
 	if err != nil {
 		return err
 	}
-
-	data, err := os.ReadFile(cachePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil // Not an error if cache doesn't exist yet
-		}
-		return fmt.Errorf("failed to read cache file: %w", err)
-	}
-
-	if err := json.Unmarshal(data, &ds.cache); err != nil {
-		return fmt.Errorf("failed to unmarshal cache: %w", err)
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	for boardID, cache := range boards {
+		ds.cache[boardID] = cache
 	}
-
 	return nil
 }
 
 func (ds *DataStore) GetTaskLocalIdByID(boardID string, taskID string) (int, error) {
 	if cached, exists := ds.cache[boardID]; exists {
+		maxLocalId := 0
 		for localId, id := range cached.LocalIdMap {
 			if id == taskID {
 				return localId, nil
 			}
+			if localId > maxLocalId {
+				maxLocalId = localId
+			}
 		}
-		ds.cache[boardID].LocalIdMap[len(cached.LocalIdMap)+1] = taskID
-		return len(cached.LocalIdMap) + 1, nil
+		// Use the next free local ID so removed tasks never cause collisions
+		cached.LocalIdMap[maxLocalId+1] = taskID
+		return maxLocalId + 1, nil
 	}
 	return -1, fmt.Errorf("board %s not found", boardID)
 }
@@ -0,0 +1,117 @@
+package monday
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateCondition is a parsed date filter expression.
+//
+// Relative expressions compare the distance from now: for the last update ">7d" means
+// more than 7 days ago and "<3d" within the last 3 days, for due dates ">7d" means due
+// in more than 7 days and "<3d" due within 3 days, including overdue tasks.
+// Absolute expressions like ">2024-01-31" or "<2024-01-31" compare against that day.
+type DateCondition struct {
+	Expression string
+	Greater    bool          // The operator is ">"
+	Relative   time.Duration // Set for relative expressions
+	Date       time.Time     // Set for absolute expressions
+}
+
+// IsRelative reports whether the condition is relative to now
+func (d DateCondition) IsRelative() bool {
+	return d.Date.IsZero()
+}
+
+// ParseDateCondition parses ">7d", "<3d", ">2024-01-31" or "<2024-01-31"
+func ParseDateCondition(expr string) (DateCondition, error) {
+	expr = strings.TrimSpace(expr)
+	condition := DateCondition{Expression: expr}
+	if expr == "" {
+		return condition, fmt.Errorf("empty date filter")
+	}
+	switch expr[0] {
+	case '>':
+		condition.Greater = true
+	case '<':
+	default:
+		return condition, fmt.Errorf("invalid date filter %q: start with > or <, e.g. \">7d\" or \"<2024-01-31\"", expr)
+	}
+
+	value := strings.TrimSpace(expr[1:])
+	if duration, err := ParseRelativeDuration(value); err == nil {
+		condition.Relative = duration
+		return condition, nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return condition, fmt.Errorf("invalid date filter %q: use a duration like 7d, 2w, 12h or a date like 2024-01-31", expr)
+	}
+	condition.Date = date
+	return condition, nil
+}
+
+// ParseRelativeDuration parses durations with day and week units like "3d" or "2w",
+// in addition to everything time.ParseDuration accepts
+func ParseRelativeDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	unit := value[len(value)-1]
+	if unit == 'd' || unit == 'w' {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+		days := n
+		if unit == 'w' {
+			days = n * 7
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	return duration, nil
+}
+
+// MatchesPast checks a date in the past, like the last update. Zero dates never match.
+func (d DateCondition) MatchesPast(t, now time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+	if d.IsRelative() {
+		age := now.Sub(t)
+		if d.Greater {
+			return age > d.Relative
+		}
+		return age < d.Relative
+	}
+	return d.matchesDate(t)
+}
+
+// MatchesFuture checks a date in the future, like a due date. Zero dates never match.
+func (d DateCondition) MatchesFuture(t, now time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+	if d.IsRelative() {
+		remaining := t.Sub(now)
+		if d.Greater {
+			return remaining > d.Relative
+		}
+		return remaining < d.Relative
+	}
+	return d.matchesDate(t)
+}
+
+// matchesDate compares against an absolute day, ">" means after the end of that day
+func (d DateCondition) matchesDate(t time.Time) bool {
+	if d.Greater {
+		return !t.Before(d.Date.AddDate(0, 0, 1))
+	}
+	return t.Before(d.Date)
+}
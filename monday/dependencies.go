@@ -0,0 +1,82 @@
+package monday
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// isDependencyColumn reports whether a column ID belongs to a dependency column, e.g. "dependency"
+func isDependencyColumn(columnID string) bool {
+	return strings.Contains(strings.ToLower(columnID), "depend")
+}
+
+// parseDependencyColumn returns the item IDs a dependency column links to
+func parseDependencyColumn(cv ColumnValue) []string {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return nil
+	}
+	var value struct {
+		LinkedPulseIDs []struct {
+			LinkedPulseID int64 `json:"linkedPulseId"`
+		} `json:"linkedPulseIds"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return nil
+	}
+	var ids []string
+	for _, linked := range value.LinkedPulseIDs {
+		ids = append(ids, strconv.FormatInt(linked.LinkedPulseID, 10))
+	}
+	return ids
+}
+
+// BlockedTask is a task with the dependencies that are not done yet
+type BlockedTask struct {
+	Task     Task
+	Blockers []Task
+}
+
+// Blockers returns the dependencies of a task that are not done. Dependencies missing from
+// tasks, e.g. items of other boards, are not known and left out.
+func Blockers(task Task, tasks map[string]Task) []Task {
+	var blockers []Task
+	for _, id := range task.DependsOn {
+		if dependency, ok := tasks[id]; ok && !IsDoneStatus(dependency.Status) {
+			blockers = append(blockers, dependency)
+		}
+	}
+	return blockers
+}
+
+// Dependents returns the tasks that depend on the task with the given ID, ordered by local ID
+func Dependents(taskID string, tasks map[string]Task) []Task {
+	var dependents []Task
+	for _, task := range tasks {
+		for _, id := range task.DependsOn {
+			if id == taskID {
+				dependents = append(dependents, task)
+				break
+			}
+		}
+	}
+	sort.Slice(dependents, func(i, j int) bool { return dependents[i].LocalId < dependents[j].LocalId })
+	return dependents
+}
+
+// BlockedTasks returns the unfinished tasks with unfinished dependencies, ordered by local ID
+func BlockedTasks(tasks map[string]Task) []BlockedTask {
+	var blocked []BlockedTask
+	for _, task := range tasks {
+		if IsDoneStatus(task.Status) {
+			continue
+		}
+		if blockers := Blockers(task, tasks); len(blockers) > 0 {
+			blocked = append(blocked, BlockedTask{Task: task, Blockers: blockers})
+		}
+	}
+	sort.Slice(blocked, func(i, j int) bool { return blocked[i].Task.LocalId < blocked[j].Task.LocalId })
+	return blocked
+}
@@ -0,0 +1,82 @@
+package monday
+
+import (
+	"sort"
+	"strings"
+)
+
+// ChangeKind describes how a task differs between two snapshots
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// FieldChange represents a single field that changed on a task
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// TaskChange represents the difference of a single task between two snapshots
+type TaskChange struct {
+	Kind   ChangeKind
+	Task   Task
+	Fields []FieldChange
+}
+
+// DiffTasks compares two task snapshots keyed by task ID and returns the changes
+func DiffTasks(oldTasks, newTasks map[string]Task) []TaskChange {
+	var changes []TaskChange
+
+	for id, newTask := range newTasks {
+		oldTask, exists := oldTasks[id]
+		if !exists {
+			changes = append(changes, TaskChange{Kind: ChangeAdded, Task: newTask})
+			continue
+		}
+		fields := diffTaskFields(oldTask, newTask)
+		if len(fields) > 0 {
+			changes = append(changes, TaskChange{Kind: ChangeModified, Task: newTask, Fields: fields})
+		}
+	}
+
+	for id, oldTask := range oldTasks {
+		if _, exists := newTasks[id]; !exists {
+			changes = append(changes, TaskChange{Kind: ChangeRemoved, Task: oldTask})
+		}
+	}
+
+	// Keep output stable: added first, then modified, then removed, each by local ID
+	kindOrder := map[ChangeKind]int{ChangeAdded: 1, ChangeModified: 2, ChangeRemoved: 3}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return kindOrder[changes[i].Kind] < kindOrder[changes[j].Kind]
+		}
+		return changes[i].Task.LocalId < changes[j].Task.LocalId
+	})
+
+	return changes
+}
+
+// diffTaskFields returns the tracked fields that differ between two versions of a task
+func diffTaskFields(oldTask, newTask Task) []FieldChange {
+	var fields []FieldChange
+	compare := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			fields = append(fields, FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+	compare("name", oldTask.Name, newTask.Name)
+	compare("status", string(oldTask.Status), string(newTask.Status))
+	compare("priority", string(oldTask.Priority), string(newTask.Priority))
+	compare("type", string(oldTask.Type), string(newTask.Type))
+	compare("sprint", string(oldTask.Sprint), string(newTask.Sprint))
+	compare("assignee", oldTask.UserName, newTask.UserName)
+	compare("teams", strings.Join(oldTask.Teams, ", "), strings.Join(newTask.Teams, ", "))
+	compare("tags", strings.Join(oldTask.Tags, ", "), strings.Join(newTask.Tags, ", "))
+	return fields
+}
@@ -0,0 +1,232 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// docsPageSize is the number of docs or doc blocks requested per page
+const docsPageSize = 100
+
+// Doc is a Monday workdoc. ID is the doc ID of the API, ObjectID the ID in the doc's URL.
+type Doc struct {
+	ID          string     `json:"id"`
+	ObjectID    string     `json:"object_id"`
+	Name        string     `json:"name"`
+	URL         string     `json:"url"`
+	WorkspaceID string     `json:"workspace_id"`
+	DocKind     string     `json:"doc_kind"` // public, private or share
+	CreatedAt   time.Time  `json:"created_at"`
+	CreatedBy   *User      `json:"created_by"`
+	Blocks      []DocBlock `json:"blocks,omitempty"`
+}
+
+// DocBlock is a block of a doc, e.g. a title, paragraph or list item. Content is the JSON of
+// the block, with the text in Quill's delta format.
+type DocBlock struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	Content       string `json:"content"`
+	ParentBlockID string `json:"parent_block_id"`
+}
+
+// docFragment selects the fields of a doc shown in listings
+var docFragment = append(scalars("id", "object_id", "name", "url", "workspace_id", "doc_kind", "created_at"), newField("created_by", scalars("id", "name")))
+
+// GetDocs retrieves the docs of a workspace using pagination, of all workspaces when
+// workspaceID is empty
+func (c *Client) GetDocs(workspaceID string) ([]Doc, error) {
+	vars, args := "$limit: Int!, $page: Int!", "limit: $limit, page: $page, order_by: created_at"
+	if workspaceID != "" {
+		vars += ", $workspaceId: ID!"
+		args += ", workspace_ids: [$workspaceId]"
+	}
+	var docs []Doc
+	for page := 1; ; page++ {
+		query := buildQuery("GetDocs", vars, newField("docs", docFragment).withArgs(args))
+		variables := map[string]interface{}{"limit": docsPageSize, "page": page}
+		if workspaceID != "" {
+			variables["workspaceId"] = workspaceID
+		}
+		resp, err := c.ExecuteQuery(query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get docs: %w", err)
+		}
+		var result struct {
+			Docs []Doc `json:"docs"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal docs: %w", err)
+		}
+		docs = append(docs, result.Docs...)
+		if len(result.Docs) < docsPageSize {
+			return docs, nil
+		}
+	}
+}
+
+// GetDoc retrieves a doc with all its blocks. The ID may be the doc ID or the object ID
+// from the doc's URL.
+func (c *Client) GetDoc(docID string) (*Doc, error) {
+	var doc *Doc
+	for _, idArg := range []string{"ids", "object_ids"} {
+		var err error
+		if doc, err = c.getDocPage(docID, idArg, 1); err != nil {
+			return nil, err
+		}
+		if doc != nil {
+			break
+		}
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("doc %s %w", docID, ErrNotFound)
+	}
+	for page := 2; len(doc.Blocks) == (page-1)*docsPageSize; page++ {
+		next, err := c.getDocPage(doc.ID, "ids", page)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil || len(next.Blocks) == 0 {
+			break
+		}
+		doc.Blocks = append(doc.Blocks, next.Blocks...)
+	}
+	return doc, nil
+}
+
+// getDocPage retrieves a doc with a page of its blocks, nil when no doc has the ID
+func (c *Client) getDocPage(docID, idArg string, page int) (*Doc, error) {
+	query := buildQuery("GetDoc", "$docId: ID!, $limit: Int!, $page: Int!",
+		newField("docs", docFragment, []field{
+			newField("blocks", scalars("id", "type", "content", "parent_block_id")).withArgs("limit: $limit, page: $page"),
+		}).withArgs(idArg+": [$docId]"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"docId": docID, "limit": docsPageSize, "page": page})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get doc %s: %w", docID, err)
+	}
+	var result struct {
+		Docs []Doc `json:"docs"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal doc: %w", err)
+	}
+	if len(result.Docs) == 0 {
+		return nil, nil
+	}
+	return &result.Docs[0], nil
+}
+
+// docBlockContent is the part of a block's content the markdown export reads
+type docBlockContent struct {
+	DeltaFormat []struct {
+		Insert     json.RawMessage `json:"insert"` // A string, or an object for mentions and embeds
+		Attributes struct {
+			Bold   bool   `json:"bold"`
+			Italic bool   `json:"italic"`
+			Strike bool   `json:"strike"`
+			Code   bool   `json:"code"`
+			Link   string `json:"link"`
+		} `json:"attributes"`
+	} `json:"deltaFormat"`
+	Checked bool   `json:"checked"`
+	URL     string `json:"url"` // Images, videos and embeds
+}
+
+// markdown returns the text of the block with bold, italic, strikethrough, code and links
+func (b docBlockContent) markdown() string {
+	var text strings.Builder
+	for _, op := range b.DeltaFormat {
+		var insert string
+		if json.Unmarshal(op.Insert, &insert) != nil {
+			continue // Mentions and embeds have no text
+		}
+		// Markers go around the text, not its line breaks or surrounding spaces
+		trimmed := strings.TrimSpace(insert)
+		if trimmed == "" {
+			text.WriteString(insert)
+			continue
+		}
+		styled := trimmed
+		attrs := op.Attributes
+		if attrs.Code {
+			styled = "`" + styled + "`"
+		}
+		if attrs.Bold {
+			styled = "**" + styled + "**"
+		}
+		if attrs.Italic {
+			styled = "_" + styled + "_"
+		}
+		if attrs.Strike {
+			styled = "~~" + styled + "~~"
+		}
+		if attrs.Link != "" {
+			styled = "[" + styled + "](" + attrs.Link + ")"
+		}
+		start := strings.Index(insert, trimmed)
+		text.WriteString(insert[:start] + styled + insert[start+len(trimmed):])
+	}
+	return strings.TrimRight(text.String(), "\n")
+}
+
+// Markdown renders the doc as markdown, with its name as the title. Blocks without a
+// markdown equivalent, such as tables and layouts, contribute the text of their cells.
+func (d *Doc) Markdown() string {
+	var md strings.Builder
+	md.WriteString("# " + d.Name + "\n")
+	previous := ""
+	for _, block := range d.Blocks {
+		var content docBlockContent
+		if block.Content != "" {
+			if err := json.Unmarshal([]byte(block.Content), &content); err != nil {
+				logger.Debug("skipping doc block with unknown content", "block", block.ID, "type", block.Type, "error", err)
+				continue
+			}
+		}
+		text := content.markdown()
+		var line string
+		switch block.Type {
+		case "large title":
+			line = "## " + text
+		case "medium title":
+			line = "### " + text
+		case "small title":
+			line = "#### " + text
+		case "bulleted list":
+			line = "- " + text
+		case "numbered list":
+			line = "1. " + text
+		case "check list":
+			box := "[ ]"
+			if content.Checked {
+				box = "[x]"
+			}
+			line = "- " + box + " " + text
+		case "quote", "notice box":
+			line = "> " + strings.ReplaceAll(text, "\n", "\n> ")
+		case "code":
+			line = "```\n" + text + "\n```"
+		case "divider":
+			line = "---"
+		case "image":
+			line = "![" + text + "](" + content.URL + ")"
+		case "video", "giphy":
+			line = "[" + block.Type + "](" + content.URL + ")"
+		default:
+			line = text
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		// List items stay together, everything else is separated by a blank line
+		if !strings.HasSuffix(block.Type, "list") || previous != block.Type {
+			md.WriteString("\n")
+		}
+		previous = block.Type
+		md.WriteString(line + "\n")
+	}
+	return md.String()
+}
@@ -0,0 +1,23 @@
+package monday
+
+// ColumnCheck is a column the CLI looks for on a board, Column is nil when it was not found
+type ColumnCheck struct {
+	Name     string
+	Required bool   // Tasks cannot be fetched or updated without the column
+	Purpose  string // What the column is used for, shown when it is missing
+	Column   *Column
+}
+
+// CheckBoardColumns finds the columns the CLI relies on. The owner and status columns are
+// required, the others only enable features such as priorities, sprints and due dates.
+func CheckBoardColumns(board *Board) []ColumnCheck {
+	status, priority, taskType := FindLabelColumns(board)
+	return []ColumnCheck{
+		{Name: "Owner", Required: true, Purpose: "assign tasks and fetch the board", Column: FindOwnerColumn(board)},
+		{Name: "Status", Required: true, Purpose: "set and filter task statuses", Column: status},
+		{Name: "Priority", Purpose: "set and sort by priority", Column: priority},
+		{Name: "Type", Purpose: "set and filter task types", Column: taskType},
+		{Name: "Sprint", Purpose: "link tasks to sprints", Column: findColumn(board, "board_relation", "sprint")},
+		{Name: "Due date", Purpose: "due date filters and reminders", Column: findColumn(board, "date", "due", "deadline")},
+	}
+}
@@ -0,0 +1,114 @@
+package monday
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// similarNameThreshold is the NameSimilarity of similar names
+const similarNameThreshold = 0.6
+
+// maxSimilarTasks is the number of similar tasks returned by SimilarTasks
+const maxSimilarTasks = 5
+
+// nameStopWords are left out when comparing task names
+var nameStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "when": true, "from": true,
+	"into": true, "not": true, "after": true, "while": true, "should": true, "does": true,
+}
+
+// significantWords returns the lower case words of a name without stop words and words
+// shorter than 3 letters, each once
+func significantWords(name string) []string {
+	var words []string
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) < 3 || nameStopWords[word] || seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+	return words
+}
+
+// sameWord reports whether two words are equal or one extends the other, e.g. crash and crashes
+func sameWord(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || len(a) >= 4 && strings.HasPrefix(b, a)
+}
+
+// NameSimilarity returns how alike two task names are from 0 to 1, the share of the
+// significant words of the shorter name found in the other one. A single word in common only
+// counts when a name has no other word, so short names do not match everything.
+func NameSimilarity(a, b string) float64 {
+	wordsA, wordsB := significantWords(a), significantWords(b)
+	shorter := min(len(wordsA), len(wordsB))
+	if shorter == 0 {
+		return 0
+	}
+	common := 0
+	for _, wordA := range wordsA {
+		for _, wordB := range wordsB {
+			if sameWord(wordA, wordB) {
+				common++
+				break
+			}
+		}
+	}
+	if common < min(2, shorter) {
+		return 0
+	}
+	return float64(common) / float64(shorter)
+}
+
+// SimilarTasks returns the tasks whose name is similar to the given name, most similar first,
+// at most maxSimilarTasks. Tasks are told apart by ID, so the same task from the cache and the
+// server is returned once.
+func SimilarTasks(tasks []Task, name string) []Task {
+	type scored struct {
+		task  Task
+		score float64
+	}
+	var similar []scored
+	seen := make(map[string]bool)
+	for _, task := range tasks {
+		if seen[task.ID] {
+			continue
+		}
+		seen[task.ID] = true
+		if score := NameSimilarity(name, task.Name); score >= similarNameThreshold {
+			similar = append(similar, scored{task, score})
+		}
+	}
+	sort.SliceStable(similar, func(i, j int) bool { return similar[i].score > similar[j].score })
+	result := make([]Task, 0, min(len(similar), maxSimilarTasks))
+	for _, s := range similar[:min(len(similar), maxSimilarTasks)] {
+		result = append(result, s.task)
+	}
+	return result
+}
+
+// SearchSimilarItems searches the board on the server for items sharing a significant word
+// with the name, to be ranked with SimilarTasks
+func (c *Client) SearchSimilarItems(boardID, name string) ([]Task, error) {
+	words := significantWords(name)
+	if len(words) == 0 {
+		return nil, nil
+	}
+	rules := make([]map[string]interface{}, 0, len(words))
+	for _, word := range words {
+		rules = append(rules, map[string]interface{}{
+			"column_id":     "name",
+			"compare_value": []string{word},
+			"operator":      "contains_text",
+		})
+	}
+	tasks, _, err := c.queryBoardItems("SearchSimilarItems", boardID, map[string]interface{}{"rules": rules, "operator": "or"})
+	return tasks, err
+}
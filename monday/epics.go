@@ -0,0 +1,162 @@
+package monday
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Epic is an item of the epics board with the tasks linked to it through its connect boards
+// columns. The tasks may be on any board.
+type Epic struct {
+	Task   Task        // The epic item, with the status, owner and dates of the epics board
+	Boards []EpicBoard // Linked tasks still on their board, by board in the order first linked
+}
+
+// EpicBoard is a board with the tasks of an epic on it
+type EpicBoard struct {
+	Board BoardRef
+	Tasks []Task
+}
+
+// Tasks returns the linked tasks of all boards
+func (e Epic) Tasks() []Task {
+	var tasks []Task
+	for _, board := range e.Boards {
+		tasks = append(tasks, board.Tasks...)
+	}
+	return tasks
+}
+
+// EpicProgress counts the done and open tasks of an epic and their points, like SprintProgress
+type EpicProgress struct {
+	Done       int
+	Open       int // Tasks neither done nor removed
+	DonePoints float64
+	Points     float64 // Points of the done and open tasks
+}
+
+// Progress counts the done and open tasks of the epic, removed ones do not count
+func (e Epic) Progress() EpicProgress {
+	var progress EpicProgress
+	for _, task := range e.Tasks() {
+		switch {
+		case IsDoneStatus(task.Status):
+			progress.Done++
+			progress.DonePoints += task.Estimate
+		case strings.Contains(strings.ToLower(string(task.Status)), "removed"):
+			continue
+		default:
+			progress.Open++
+		}
+		progress.Points += task.Estimate
+	}
+	return progress
+}
+
+// DoneShare returns the share of the work done from 0 to 1, by points when the tasks are
+// estimated and by task count otherwise
+func (p EpicProgress) DoneShare() float64 {
+	if p.Points > 0 {
+		return p.DonePoints / p.Points
+	}
+	if total := p.Done + p.Open; total > 0 {
+		return float64(p.Done) / float64(total)
+	}
+	return 0
+}
+
+// epicLinkedItemIDs returns the IDs of the items an epic links to through its connect boards
+// columns, each once
+func epicLinkedItemIDs(item Item) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, cv := range item.ColumnValues {
+		if cv.Type != "board_relation" {
+			continue
+		}
+		// Connect boards values hold linkedPulseIds like dependency columns
+		for _, id := range parseDependencyColumn(cv) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// GetEpics returns the items of the epics board with their linked tasks, in board order. The
+// linked items are read by ID, so tasks of boards not configured in the CLI count too, and
+// archived or deleted ones are left out.
+func (c *Client) GetEpics(epicsBoardID string) ([]Epic, error) {
+	_, items, err := c.newItemsPaginator("GetEpicsBoardItems", epicsBoardID, DefaultPageSize, itemFragment(columnValueFragment)).fetch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epics board items: %w", err)
+	}
+	return c.epicsFromItems(items)
+}
+
+// GetEpic returns an epic of the epics board by item ID with its linked tasks
+func (c *Client) GetEpic(epicsBoardID, epicID string) (*Epic, error) {
+	items, err := c.getItemsWithState([]string{epicID})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 || items[0].State == "archived" || items[0].State == "deleted" {
+		return nil, fmt.Errorf("epic %s %w", epicID, ErrNotFound)
+	}
+	if items[0].Board != nil && items[0].Board.ID != epicsBoardID {
+		return nil, fmt.Errorf("item %s is on board %s, not on the epics board %s", epicID, items[0].Board.Name, epicsBoardID)
+	}
+	epics, err := c.epicsFromItems(items)
+	if err != nil {
+		return nil, err
+	}
+	return &epics[0], nil
+}
+
+// epicsFromItems reads the items linked by epic items with one query per page of IDs
+func (c *Client) epicsFromItems(items []Item) ([]Epic, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, item := range items {
+		for _, id := range epicLinkedItemIDs(item) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	linked, err := c.getItemsWithState(ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the tasks of the epics: %w", err)
+	}
+	linkedItems := make(map[string]Item, len(linked))
+	for _, item := range linked {
+		if item.State != "archived" && item.State != "deleted" && item.Board != nil {
+			linkedItems[item.ID] = item
+		}
+	}
+	logger.Info("fetched epic tasks", "epics", len(items), "linked", len(ids), "tasks", len(linkedItems))
+
+	epics := make([]Epic, 0, len(items))
+	for _, item := range items {
+		epic := Epic{Task: parseBoardItem(item)}
+		boards := make(map[string]int)
+		for _, id := range epicLinkedItemIDs(item) {
+			linkedItem, ok := linkedItems[id]
+			if !ok {
+				continue
+			}
+			i, seen := boards[linkedItem.Board.ID]
+			if !seen {
+				i = len(epic.Boards)
+				boards[linkedItem.Board.ID] = i
+				epic.Boards = append(epic.Boards, EpicBoard{Board: *linkedItem.Board})
+			}
+			epic.Boards[i].Tasks = append(epic.Boards[i].Tasks, parseBoardItem(linkedItem))
+		}
+		epics = append(epics, epic)
+	}
+	return epics, nil
+}
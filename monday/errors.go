@@ -0,0 +1,155 @@
+package monday
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Error classes returned by the client, check them with errors.Is
+var (
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrNotFound         = errors.New("not found")
+	ErrComplexityBudget = errors.New("complexity budget exhausted")
+	ErrInvalidLabel     = errors.New("invalid label")
+	ErrConflict         = errors.New("changed since it was cached")
+	ErrCacheTooNew      = errors.New("cache written by a newer version")
+
+	// ErrDryRun is returned instead of sending a mutation when dry-run mode is enabled
+	ErrDryRun = errors.New("dry run, mutation not sent")
+)
+
+// IsNetworkError reports whether the API could not be reached, e.g. when offline or on a timeout
+func IsNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// InvalidLabelError is returned when a label does not exist in a status or dropdown column
+type InvalidLabelError struct {
+	Column string
+	Label  string
+	Valid  []string
+}
+
+func (e *InvalidLabelError) Error() string {
+	return fmt.Sprintf("invalid label %q for column %s, valid labels: %s", e.Label, e.Column, strings.Join(e.Valid, ", "))
+}
+
+// Unwrap returns ErrInvalidLabel so errors.Is works
+func (e *InvalidLabelError) Unwrap() error {
+	return ErrInvalidLabel
+}
+
+// ErrorLocation represents the position in the query an error refers to
+type ErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLError represents a single error returned by the Monday.com API
+type GraphQLError struct {
+	Message    string          `json:"message"`
+	Locations  []ErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}   `json:"path,omitempty"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions,omitempty"`
+}
+
+// String formats the error with its code and locations
+func (e GraphQLError) String() string {
+	s := e.Message
+	if e.Extensions.Code != "" {
+		s = fmt.Sprintf("%s [%s]", s, e.Extensions.Code)
+	}
+	for _, location := range e.Locations {
+		s += fmt.Sprintf(" (line %d, column %d)", location.Line, location.Column)
+	}
+	return s
+}
+
+// APIError is returned when the Monday.com API rejects a request
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Errors     []GraphQLError
+	kind       error
+}
+
+func (e *APIError) Error() string {
+	var parts []string
+	for _, graphqlErr := range e.Errors {
+		parts = append(parts, graphqlErr.String())
+	}
+	if len(parts) == 0 {
+		message := e.Message
+		if message == "" {
+			message = http.StatusText(e.StatusCode)
+		}
+		if e.Code != "" {
+			message = fmt.Sprintf("%s [%s]", message, e.Code)
+		}
+		parts = append(parts, message)
+	}
+
+	prefix := "GraphQL errors"
+	if e.kind != nil {
+		prefix = e.kind.Error()
+	}
+	return fmt.Sprintf("%s: %s", prefix, strings.Join(parts, "; "))
+}
+
+// Unwrap returns the error class so errors.Is works with ErrUnauthorized etc.
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+// newAPIError builds an APIError and classifies it by status code and error codes
+func newAPIError(statusCode int, code, message string, graphqlErrors []GraphQLError) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    message,
+		Errors:     graphqlErrors,
+	}
+
+	codes := []string{code}
+	for _, graphqlErr := range graphqlErrors {
+		codes = append(codes, graphqlErr.Extensions.Code, graphqlErr.Message)
+	}
+	apiErr.kind = classifyError(statusCode, codes)
+	return apiErr
+}
+
+// classifyError maps an HTTP status and Monday.com error codes to an error class
+func classifyError(statusCode int, codes []string) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+
+	for _, code := range codes {
+		code = strings.ToLower(code)
+		switch {
+		case strings.Contains(code, "complexity"):
+			return ErrComplexityBudget
+		case strings.Contains(code, "ratelimit") || strings.Contains(code, "rate_limit") || strings.Contains(code, "rate limit"):
+			return ErrRateLimited
+		case strings.Contains(code, "unauthorized") || strings.Contains(code, "not authenticated") || strings.Contains(code, "invalid token"):
+			return ErrUnauthorized
+		case strings.Contains(code, "notfound") || strings.Contains(code, "not_found") || strings.Contains(code, "not found") ||
+			strings.Contains(code, "invalidboardid") || strings.Contains(code, "invaliditemid"):
+			return ErrNotFound
+		}
+	}
+	return nil
+}
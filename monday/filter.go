@@ -3,9 +3,18 @@ package monday
 import (
 	"slices"
 	"strings"
+	"time"
 )
 
 func FilterTasks(tasks []Task, filters Filters) []Task {
+	now := time.Now()
+	updatedWhitelist := parseDateConditions(filters.UpdatedWhitelist)
+	updatedBlacklist := parseDateConditions(filters.UpdatedBlacklist)
+	dueWhitelist := parseDateConditions(filters.DueWhitelist)
+	dueBlacklist := parseDateConditions(filters.DueBlacklist)
+	createdWhitelist := parseDateConditions(filters.CreatedWhitelist)
+	createdBlacklist := parseDateConditions(filters.CreatedBlacklist)
+
 	var filteredTasks []Task
 	for _, task := range tasks {
 		status := strings.ToLower(string(task.Status))
@@ -50,7 +59,112 @@ func FilterTasks(tasks []Task, filters Filters) []Task {
 		if len(filters.UserEmailBlacklist) > 0 && slices.Contains(filters.UserEmailBlacklist, userEmail) {
 			continue
 		}
+		if len(filters.TagWhitelist) > 0 && !task.HasAnyTag(filters.TagWhitelist) {
+			continue
+		}
+		if len(filters.TagBlacklist) > 0 && task.HasAnyTag(filters.TagBlacklist) {
+			continue
+		}
+		if len(filters.TeamWhitelist) > 0 && !task.HasAnyTeam(filters.TeamWhitelist) {
+			continue
+		}
+		if len(filters.TeamBlacklist) > 0 && task.HasAnyTeam(filters.TeamBlacklist) {
+			continue
+		}
+		if len(filters.CreatorWhitelist) > 0 && !task.CreatedByAny(filters.CreatorWhitelist) {
+			continue
+		}
+		if len(filters.CreatorBlacklist) > 0 && task.CreatedByAny(filters.CreatorBlacklist) {
+			continue
+		}
+		if len(filters.TouchedByWhitelist) > 0 && !task.TouchedByAny(filters.TouchedByWhitelist) {
+			continue
+		}
+		// Date filters must all match when whitelisted, any match excludes when blacklisted
+		if !matchesAllDates(updatedWhitelist, task.UpdatedAt, now, DateCondition.MatchesPast) {
+			continue
+		}
+		if matchesAnyDate(updatedBlacklist, task.UpdatedAt, now, DateCondition.MatchesPast) {
+			continue
+		}
+		if !matchesAllDates(dueWhitelist, task.DueDate, now, DateCondition.MatchesFuture) {
+			continue
+		}
+		if matchesAnyDate(dueBlacklist, task.DueDate, now, DateCondition.MatchesFuture) {
+			continue
+		}
+		if !matchesAllDates(createdWhitelist, task.CreatedAt, now, DateCondition.MatchesPast) {
+			continue
+		}
+		if matchesAnyDate(createdBlacklist, task.CreatedAt, now, DateCondition.MatchesPast) {
+			continue
+		}
 		filteredTasks = append(filteredTasks, task)
 	}
 	return filteredTasks
 }
+
+// parseDateConditions parses date filter expressions, invalid ones are logged and skipped
+func parseDateConditions(expressions []string) []DateCondition {
+	var conditions []DateCondition
+	for _, expr := range expressions {
+		condition, err := ParseDateCondition(expr)
+		if err != nil {
+			logger.Warn("ignoring date filter", "error", err)
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// matchesAllDates reports whether the date matches every condition
+func matchesAllDates(conditions []DateCondition, t, now time.Time, match func(DateCondition, time.Time, time.Time) bool) bool {
+	for _, condition := range conditions {
+		if !match(condition, t, now) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAnyDate reports whether the date matches at least one condition
+func matchesAnyDate(conditions []DateCondition, t, now time.Time, match func(DateCondition, time.Time, time.Time) bool) bool {
+	for _, condition := range conditions {
+		if match(condition, t, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clone returns a copy of the filters that shares no slices with the original
+func (f Filters) Clone() Filters {
+	return Filters{
+		UserNameWhitelist:  slices.Clone(f.UserNameWhitelist),
+		UserNameBlacklist:  slices.Clone(f.UserNameBlacklist),
+		UserEmailWhitelist: slices.Clone(f.UserEmailWhitelist),
+		UserEmailBlacklist: slices.Clone(f.UserEmailBlacklist),
+		StatusWhitelist:    slices.Clone(f.StatusWhitelist),
+		StatusBlacklist:    slices.Clone(f.StatusBlacklist),
+		PriorityWhitelist:  slices.Clone(f.PriorityWhitelist),
+		PriorityBlacklist:  slices.Clone(f.PriorityBlacklist),
+		TypeWhitelist:      slices.Clone(f.TypeWhitelist),
+		TypeBlacklist:      slices.Clone(f.TypeBlacklist),
+		SprintWhitelist:    slices.Clone(f.SprintWhitelist),
+		SprintBlacklist:    slices.Clone(f.SprintBlacklist),
+		UpdatedWhitelist:   slices.Clone(f.UpdatedWhitelist),
+		UpdatedBlacklist:   slices.Clone(f.UpdatedBlacklist),
+		DueWhitelist:       slices.Clone(f.DueWhitelist),
+		DueBlacklist:       slices.Clone(f.DueBlacklist),
+		TagWhitelist:       slices.Clone(f.TagWhitelist),
+		TagBlacklist:       slices.Clone(f.TagBlacklist),
+		TeamWhitelist:      slices.Clone(f.TeamWhitelist),
+		TeamBlacklist:      slices.Clone(f.TeamBlacklist),
+		CreatorWhitelist:   slices.Clone(f.CreatorWhitelist),
+		CreatorBlacklist:   slices.Clone(f.CreatorBlacklist),
+		CreatedWhitelist:   slices.Clone(f.CreatedWhitelist),
+		CreatedBlacklist:   slices.Clone(f.CreatedBlacklist),
+		TouchedByWhitelist: slices.Clone(f.TouchedByWhitelist),
+	}
+}
@@ -0,0 +1,60 @@
+package monday
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// FuzzyMatch reports whether the runes of the query appear in order in the text, ignoring
+// case and spaces in the query. Runes following each other or starting a word score higher.
+func FuzzyMatch(query, text string) (int, bool) {
+	needle := []rune(strings.ToLower(strings.ReplaceAll(query, " ", "")))
+	haystack := []rune(strings.ToLower(text))
+	score, matched, previous := 0, 0, -2
+	for i, r := range haystack {
+		if matched == len(needle) {
+			break
+		}
+		if r != needle[matched] {
+			continue
+		}
+		score++
+		if i == previous+1 {
+			score += 2
+		}
+		if i == 0 || !unicode.IsLetter(haystack[i-1]) && !unicode.IsDigit(haystack[i-1]) {
+			score += 3
+		}
+		previous = i
+		matched++
+	}
+	return score, matched == len(needle)
+}
+
+// FuzzyFindTasks returns the tasks whose local ID, name, labels, assignee or tags match the
+// query, best matches first. Equal scores keep the order of the tasks.
+func FuzzyFindTasks(tasks []Task, query string) []Task {
+	type scoredTask struct {
+		task  Task
+		score int
+	}
+	var matches []scoredTask
+	for _, task := range tasks {
+		text := strings.Join(append([]string{
+			strconv.Itoa(task.LocalId), task.Name, string(task.Status), string(task.Type), task.UserName,
+		}, task.Tags...), " ")
+		if score, ok := FuzzyMatch(query, text); ok {
+			matches = append(matches, scoredTask{task: task, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	found := make([]Task, len(matches))
+	for i, match := range matches {
+		found[i] = match.task
+	}
+	return found
+}
@@ -0,0 +1,111 @@
+package monday
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// History actions
+const (
+	HistoryCreate  = "create"
+	HistoryUpdate  = "update"
+	HistoryComment = "comment"
+	HistoryLink    = "link"
+	HistoryMove    = "move"
+	HistoryRestore = "restore" // A trashed item created again, see RestoreTrashedItem
+)
+
+// HistoryEntry records a mutation performed by the CLI, independent of Monday's own activity log
+type HistoryEntry struct {
+	Time     time.Time     `json:"time"`
+	UserID   string        `json:"user_id,omitempty"`
+	BoardID  string        `json:"board_id"`
+	Action   string        `json:"action"`
+	TaskID   string        `json:"task_id"`
+	TaskName string        `json:"task_name"`
+	Changes  []FieldChange `json:"changes,omitempty"`
+	Detail   string        `json:"detail,omitempty"` // The comment, link or destination of the action
+}
+
+// NewHistoryEntry describes a change of a task, the fields are compared between before and after.
+// For created tasks before is the zero Task.
+func NewHistoryEntry(action, userID, boardID string, before, after Task) HistoryEntry {
+	return HistoryEntry{
+		Time:     time.Now(),
+		UserID:   userID,
+		BoardID:  boardID,
+		Action:   action,
+		TaskID:   after.ID,
+		TaskName: after.Name,
+		Changes:  diffTaskFields(before, after),
+	}
+}
+
+// GetHistoryPath returns the path to the history file
+func GetHistoryPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".cache", "monday-cli", "history.jsonl"), nil
+}
+
+// AppendHistory adds an entry to the history file, one JSON object per line
+func AppendHistory(entry HistoryEntry) error {
+	path, err := GetHistoryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal history entry: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// ReadHistory returns all history entries, oldest first. Lines that cannot be parsed are skipped.
+func ReadHistory() ([]HistoryEntry, error) {
+	path, err := GetHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil // No history yet
+		}
+		return nil, fmt.Errorf("failed to open history: %w", err)
+	}
+	defer file.Close()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			logger.Warn("skipping invalid history line", "error", err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return entries, fmt.Errorf("failed to read history: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,242 @@
+package monday
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HookEvent is a change made by the CLI that runs the hooks configured for it
+type HookEvent string
+
+const (
+	HookTaskCreated      HookEvent = "task.created"
+	HookStatusChanged    HookEvent = "task.status_changed"
+	HookSprintRolledOver HookEvent = "sprint.rolled_over"
+)
+
+// HookEvents are the events hooks can be added for
+var HookEvents = []HookEvent{HookTaskCreated, HookStatusChanged, HookSprintRolledOver}
+
+// ParseHookEvent checks an event name given on the command line
+func ParseHookEvent(name string) (HookEvent, error) {
+	for _, event := range HookEvents {
+		if string(event) == name {
+			return event, nil
+		}
+	}
+	names := make([]string, len(HookEvents))
+	for i, event := range HookEvents {
+		names[i] = string(event)
+	}
+	return "", fmt.Errorf("unknown event %q, use one of %s", name, strings.Join(names, ", "))
+}
+
+// Hook runs a shell command or posts to a URL when its event happens. The event is passed as
+// JSON, on stdin of the command and as the body of the POST.
+type Hook struct {
+	Event   HookEvent `json:"event"`
+	Command string    `json:"command,omitempty"`
+	URL     string    `json:"url,omitempty"`
+}
+
+// Validate checks that the hook has exactly one of a command and an HTTP(S) URL
+func (h Hook) Validate() error {
+	if _, err := ParseHookEvent(string(h.Event)); err != nil {
+		return err
+	}
+	switch {
+	case h.Command == "" && h.URL == "":
+		return fmt.Errorf("hook needs a command or a URL")
+	case h.Command != "" && h.URL != "":
+		return fmt.Errorf("hook has both a command and a URL, use one")
+	case h.URL != "":
+		parsed, err := url.Parse(h.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid hook URL %q, use http:// or https://", h.URL)
+		}
+	}
+	return nil
+}
+
+// Target describes what the hook runs, for listings
+func (h Hook) Target() string {
+	if h.URL != "" {
+		return "POST " + h.URL
+	}
+	return h.Command
+}
+
+// Hooks maps the names of hooks to hooks
+type Hooks map[string]Hook
+
+// Has reports whether any hook is configured for the event
+func (h Hooks) Has(event HookEvent) bool {
+	for _, hook := range h {
+		if hook.Event == event {
+			return true
+		}
+	}
+	return false
+}
+
+// SetHook adds or replaces a hook
+func (c *Config) SetHook(name string, hook Hook) {
+	if c.Hooks == nil {
+		c.Hooks = make(Hooks)
+	}
+	c.Hooks[name] = hook
+}
+
+// DeleteHook removes a hook
+func (c *Config) DeleteHook(name string) error {
+	if _, ok := c.Hooks[name]; !ok {
+		return fmt.Errorf("hook %s %w", name, ErrNotFound)
+	}
+	delete(c.Hooks, name)
+	return nil
+}
+
+// HookNames returns the names of all hooks in alphabetical order
+func (c *Config) HookNames() []string {
+	names := make([]string, 0, len(c.Hooks))
+	for name := range c.Hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HookPayload is the JSON a hook receives. Task and Changes are set for task events, the
+// sprints and tasks moved for rollovers.
+type HookPayload struct {
+	Event      HookEvent     `json:"event"`
+	Time       time.Time     `json:"time"`
+	BoardID    string        `json:"board_id"`
+	UserID     string        `json:"user_id,omitempty"`
+	Task       *Task         `json:"task,omitempty"`
+	Changes    []FieldChange `json:"changes,omitempty"`
+	FromSprint string        `json:"from_sprint,omitempty"`
+	ToSprint   string        `json:"to_sprint,omitempty"`
+	TaskIDs    []string      `json:"task_ids,omitempty"`
+}
+
+// HookResult is the outcome of running one hook
+type HookResult struct {
+	Name string
+	Hook Hook
+	Err  error
+}
+
+// defaultHookTimeout bounds each hook, so a hanging command does not block the CLI
+const defaultHookTimeout = 10 * time.Second
+
+// HookDispatcher runs the hooks of events one after the other, in the order of their names
+type HookDispatcher struct {
+	hooks     Hooks
+	transport Transport
+	timeout   time.Duration
+}
+
+// NewHookDispatcher creates a dispatcher of the given hooks, URLs are posted with transport
+func NewHookDispatcher(hooks Hooks, transport Transport) *HookDispatcher {
+	return &HookDispatcher{hooks: hooks, transport: transport, timeout: defaultHookTimeout}
+}
+
+// Dispatch runs the hooks of the payload's event. A failing hook does not stop the others,
+// the error of each hook is returned in its result.
+func (d *HookDispatcher) Dispatch(ctx context.Context, payload HookPayload) []HookResult {
+	if payload.Time.IsZero() {
+		payload.Time = time.Now()
+	}
+	names := make([]string, 0, len(d.hooks))
+	for name, hook := range d.hooks {
+		if hook.Event == payload.Event {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var results []HookResult
+	for _, name := range names {
+		hook := d.hooks[name]
+		Logger().Debug("running hook", "name", name, "event", payload.Event)
+		results = append(results, HookResult{Name: name, Hook: hook, Err: d.run(ctx, hook, payload)})
+	}
+	return results
+}
+
+// run runs a single hook within the dispatcher's timeout
+func (d *HookDispatcher) run(ctx context.Context, hook Hook, payload HookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode hook payload: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+	if hook.URL != "" {
+		return postHook(ctx, d.transport, hook.URL, body)
+	}
+	return runHookCommand(ctx, hook.Command, payload, body)
+}
+
+// runHookCommand runs a command with the shell, the payload on stdin and its main fields in
+// MONDAY_* environment variables for one-liners
+func runHookCommand(ctx context.Context, command string, payload HookPayload, body []byte) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(), "MONDAY_EVENT="+string(payload.Event), "MONDAY_BOARD_ID="+payload.BoardID)
+	if payload.Task != nil {
+		cmd.Env = append(cmd.Env,
+			"MONDAY_TASK_ID="+payload.Task.ID,
+			"MONDAY_TASK_NAME="+payload.Task.Name,
+			"MONDAY_TASK_STATUS="+string(payload.Task.Status),
+		)
+	}
+	if payload.ToSprint != "" {
+		cmd.Env = append(cmd.Env, "MONDAY_FROM_SPRINT="+payload.FromSprint, "MONDAY_TO_SPRINT="+payload.ToSprint)
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if text := strings.TrimSpace(string(output)); text != "" {
+			return fmt.Errorf("hook command %q failed: %w: %s", command, err, text)
+		}
+		return fmt.Errorf("hook command %q failed: %w", command, err)
+	}
+	return nil
+}
+
+// postHook posts the payload to a URL, any 2xx answer is a success
+func postHook(ctx context.Context, transport Transport, hookURL string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", hookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create hook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "monday-cli")
+	resp, err := transport.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post hook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		text, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("hook URL answered %d: %s", resp.StatusCode, strings.TrimSpace(string(text)))
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+package monday
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// importBatchSize is the default number of items created per request
+const importBatchSize = 10
+
+// Creating batches backs off when Monday rate limits the requests: up to batchRetries times,
+// waiting batchBackoff and twice as long after every further limit
+const (
+	batchRetries = 4
+	batchBackoff = 5 * time.Second
+)
+
+// ImportMapping maps the columns of a CSV file to task fields. Field values are CSV headers,
+// the *Values maps translate labels of the source tool to the labels of the board.
+type ImportMapping struct {
+	Name           string            `json:"name"`
+	Status         string            `json:"status,omitempty"`
+	Priority       string            `json:"priority,omitempty"`
+	Type           string            `json:"type,omitempty"`
+	Assignee       string            `json:"assignee,omitempty"`
+	DueDate        string            `json:"due_date,omitempty"`
+	DateFormat     string            `json:"date_format,omitempty"` // Go layout, common formats are tried without it
+	StatusValues   map[string]string `json:"status_values,omitempty"`
+	PriorityValues map[string]string `json:"priority_values,omitempty"`
+	TypeValues     map[string]string `json:"type_values,omitempty"`
+}
+
+// importHeaders are the headers recognized without a mapping file, as exported by Jira,
+// Trello and typical spreadsheets
+var importHeaders = map[string][]string{
+	"name":     {"name", "summary", "title", "card name", "task", "item"},
+	"status":   {"status", "state", "list name", "list"},
+	"priority": {"priority"},
+	"type":     {"type", "issue type", "task type"},
+	"assignee": {"assignee", "owner", "members", "assigned to"},
+	"due_date": {"due date", "due", "deadline", "due_date"},
+}
+
+// dateLayouts are tried in order when the mapping has no date format
+var dateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"02/Jan/06 3:04 PM",
+	"02/Jan/06",
+	"01/02/2006",
+	"1/2/2006",
+	"Jan 2, 2006",
+}
+
+// DetectImportMapping maps the CSV headers known from common exports
+func DetectImportMapping(headers []string) *ImportMapping {
+	find := func(field string) string {
+		for _, candidate := range importHeaders[field] {
+			for _, header := range headers {
+				if strings.EqualFold(strings.TrimSpace(header), candidate) {
+					return header
+				}
+			}
+		}
+		return ""
+	}
+	return &ImportMapping{
+		Name:     find("name"),
+		Status:   find("status"),
+		Priority: find("priority"),
+		Type:     find("type"),
+		Assignee: find("assignee"),
+		DueDate:  find("due_date"),
+	}
+}
+
+// LoadImportMapping reads a mapping file, JSON for .json files and a flat YAML subset otherwise:
+// "field: Header" lines and the *_values sections with indented "From: To" lines
+func LoadImportMapping(path string) (*ImportMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping: %w", err)
+	}
+	mapping := &ImportMapping{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(data, mapping); err != nil {
+			return nil, fmt.Errorf("failed to parse mapping: %w", err)
+		}
+		return mapping, nil
+	}
+
+	fields, sections, err := parseSimpleYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mapping: %w", err)
+	}
+	for key, value := range fields {
+		switch key {
+		case "name":
+			mapping.Name = value
+		case "status":
+			mapping.Status = value
+		case "priority":
+			mapping.Priority = value
+		case "type":
+			mapping.Type = value
+		case "assignee":
+			mapping.Assignee = value
+		case "due_date":
+			mapping.DueDate = value
+		case "date_format":
+			mapping.DateFormat = value
+		default:
+			return nil, fmt.Errorf("unknown mapping field: %s", key)
+		}
+	}
+	for key, values := range sections {
+		switch key {
+		case "status_values":
+			mapping.StatusValues = values
+		case "priority_values":
+			mapping.PriorityValues = values
+		case "type_values":
+			mapping.TypeValues = values
+		default:
+			return nil, fmt.Errorf("unknown mapping section: %s", key)
+		}
+	}
+	return mapping, nil
+}
+
+// parseSimpleYAML parses "key: value" lines and one level of indented sections
+func parseSimpleYAML(data []byte) (map[string]string, map[string]map[string]string, error) {
+	fields := make(map[string]string)
+	sections := make(map[string]map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, nil, fmt.Errorf("line %d: expected key: value", lineNumber)
+		}
+		key, value = unquoteYAML(key), unquoteYAML(value)
+		indented := line[0] == ' ' || line[0] == '\t'
+		switch {
+		case indented && section != "":
+			sections[section][key] = value
+		case indented:
+			return nil, nil, fmt.Errorf("line %d: indented line outside of a section", lineNumber)
+		case value == "":
+			section = key
+			sections[section] = make(map[string]string)
+		default:
+			section = ""
+			fields[key] = value
+		}
+	}
+	return fields, sections, scanner.Err()
+}
+
+// unquoteYAML trims a key or value and removes surrounding quotes
+func unquoteYAML(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) >= 2 && (text[0] == '"' || text[0] == '\'') && text[len(text)-1] == text[0] {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
+
+// ImportRow is a CSV record converted to a task
+type ImportRow struct {
+	Line     int // Record number in the CSV file, the header is record 1
+	Name     string
+	Details  TaskDetails
+	Assignee string // Assignee as written in the file
+	Skip     string // Why the row is not imported, empty when it is
+	Values   ColumnValues
+}
+
+// ReadImportCSV reads the records of a CSV file, the first record being the headers.
+// The mapping is detected from the headers when nil.
+func ReadImportCSV(r io.Reader, mapping *ImportMapping) ([]ImportRow, *ImportMapping, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("CSV file is empty")
+	}
+	headers := records[0]
+	if len(headers) > 0 {
+		headers[0] = strings.TrimPrefix(headers[0], "\ufeff") // Byte order mark of spreadsheet exports
+	}
+	if mapping == nil {
+		mapping = DetectImportMapping(headers)
+	}
+	if mapping.Name == "" {
+		return nil, nil, fmt.Errorf("no name column found in %s, add a mapping with name: <header>", strings.Join(headers, ", "))
+	}
+
+	index := make(map[string]int)
+	for i, header := range headers {
+		index[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	for _, header := range []string{mapping.Name, mapping.Status, mapping.Priority, mapping.Type, mapping.Assignee, mapping.DueDate} {
+		if _, ok := index[strings.ToLower(header)]; header != "" && !ok {
+			return nil, nil, fmt.Errorf("column %q %w in CSV", header, ErrNotFound)
+		}
+	}
+
+	var rows []ImportRow
+	for i, record := range records[1:] {
+		value := func(header string) string {
+			column, ok := index[strings.ToLower(strings.TrimSpace(header))]
+			if header == "" || !ok || column >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[column])
+		}
+		row := ImportRow{
+			Line:     i + 2,
+			Name:     value(mapping.Name),
+			Assignee: value(mapping.Assignee),
+			Details: TaskDetails{
+				Status:   translateLabel(value(mapping.Status), mapping.StatusValues),
+				Priority: translateLabel(value(mapping.Priority), mapping.PriorityValues),
+				Type:     translateLabel(value(mapping.Type), mapping.TypeValues),
+			},
+		}
+		if row.Name == "" {
+			row.Skip = "no name"
+		}
+		if due := value(mapping.DueDate); due != "" && row.Skip == "" {
+			date, err := parseImportDate(due, mapping.DateFormat)
+			if err != nil {
+				row.Skip = err.Error()
+			}
+			row.Details.DueDate = date
+		}
+		rows = append(rows, row)
+	}
+	return rows, mapping, nil
+}
+
+// translateLabel maps a label of the source tool, matching case-insensitively
+func translateLabel(label string, translations map[string]string) string {
+	for from, to := range translations {
+		if strings.EqualFold(from, label) {
+			return to
+		}
+	}
+	return label
+}
+
+// parseImportDate parses a due date with the given layout or the common export formats
+func parseImportDate(text, layout string) (time.Time, error) {
+	layouts := dateLayouts
+	if layout != "" {
+		layouts = []string{layout}
+	}
+	for _, layout := range layouts {
+		if date, err := time.ParseInLocation(layout, text, time.Local); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid due date %q", text)
+}
+
+// PlanImport validates the rows against the board and marks the ones to skip: names that exist
+// on the board or earlier in the file, and labels the board does not have. Assignees are resolved
+// with the given function, unknown ones leave the task unassigned.
+func PlanImport(board *Board, rows []ImportRow, existing map[string]Task, resolveAssignee func(name string) (string, bool)) {
+	seen := make(map[string]bool)
+	for _, task := range existing {
+		seen[strings.ToLower(strings.TrimSpace(task.Name))] = true
+	}
+	for i := range rows {
+		row := &rows[i]
+		if row.Skip != "" {
+			continue
+		}
+		key := strings.ToLower(row.Name)
+		if seen[key] {
+			row.Skip = "duplicate name"
+			continue
+		}
+		seen[key] = true
+
+		if row.Assignee != "" && resolveAssignee != nil {
+			row.Details.AssigneeID, _ = resolveAssignee(row.Assignee)
+		}
+		values, err := taskColumnValues(board, row.Details)
+		if err != nil {
+			row.Skip = err.Error()
+			continue
+		}
+		row.Values = values
+	}
+}
+
+// ItemInput is an item to create with its column values
+type ItemInput struct {
+	Name    string
+	Values  ColumnValues
+	GroupID string // Group to create the item in, the board's top group when empty
+}
+
+// CreateItems creates items on a board, sending the given number of create_item mutations per
+// request. It returns the IDs of the created items in order; on an error the IDs of the batches
+// created before are returned with it. Batches over the complexity budget are split, rate
+// limited ones are sent again after a wait; Monday rejects both before creating any item.
+func (c *Client) CreateItems(boardID string, items []ItemInput, batchSize int) ([]string, error) {
+	var ids []string
+	err := c.sendBatches("CreateItems", boardID, len(items), batchSize, func(start, end int) error {
+		batch, err := c.createItemBatch(boardID, items[start:end])
+		ids = append(ids, batch...)
+		return err
+	})
+	return ids, err
+}
+
+// sendBatches calls send for consecutive ranges of total items of at most batchSize items,
+// each range one request of aliased mutations. Ranges over the complexity budget are split,
+// rate limited ones are sent again after a wait, progress is reported per batch.
+func (c *Client) sendBatches(operation, boardID string, total, batchSize int, send func(start, end int) error) error {
+	if batchSize < 1 {
+		batchSize = importBatchSize
+	}
+	batches, retries := 0, 0
+	for start := 0; start < total; {
+		end := min(start+batchSize, total)
+		err := send(start, end)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrComplexityBudget) && batchSize > 1:
+			batchSize = max(batchSize/2, 1)
+			logger.Warn("batch too expensive, retrying with smaller batches", "operation", operation, "board", boardID, "batch_size", batchSize, "error", err)
+			continue
+		case errors.Is(err, ErrRateLimited) && retries < batchRetries:
+			wait := batchBackoff << retries
+			retries++
+			logger.Warn("rate limited, waiting before the next batch", "operation", operation, "board", boardID, "wait", wait)
+			select {
+			case <-time.After(wait):
+			case <-c.ctx.Done():
+				return c.ctx.Err()
+			}
+			continue
+		default:
+			return err
+		}
+		retries = 0
+		batches++
+		start = end
+		if c.progress != nil {
+			c.progress(PageProgress{
+				Operation: operation,
+				BoardID:   boardID,
+				Page:      batches,
+				Items:     end,
+				Total:     total,
+				PageSize:  batchSize,
+				Done:      end == total,
+			})
+		}
+	}
+	return nil
+}
+
+// createItemBatch creates several items in one request using aliased mutations
+func (c *Client) createItemBatch(boardID string, items []ItemInput) ([]string, error) {
+	var declarations, mutations strings.Builder
+	declarations.WriteString("$boardId: ID!")
+	variables := map[string]interface{}{"boardId": boardID}
+	for i, item := range items {
+		columnValues, err := item.Values.JSON()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&declarations, ", $itemName%d: String!, $columnValues%d: JSON!", i, i)
+		variables[fmt.Sprintf("itemName%d", i)] = item.Name
+		variables[fmt.Sprintf("columnValues%d", i)] = columnValues
+		groupArg := ""
+		if item.GroupID != "" {
+			fmt.Fprintf(&declarations, ", $groupId%d: String", i)
+			groupArg = fmt.Sprintf(", group_id: $groupId%d", i)
+			variables[fmt.Sprintf("groupId%d", i)] = item.GroupID
+		}
+		fmt.Fprintf(&mutations, "\t\t\titem%d: create_item(board_id: $boardId, item_name: $itemName%d, column_values: $columnValues%d%s) {\n\t\t\t\tid\n\t\t\t}\n", i, i, i, groupArg)
+	}
+	query := fmt.Sprintf("\n\t\tmutation CreateItems(%s) {\n%s\t\t}\n\t", declarations.String(), mutations.String())
+
+	resp, err := c.ExecuteQuery(query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create items: %w", err)
+	}
+	var result map[string]struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse created items: %w", err)
+	}
+	ids := make([]string, 0, len(items))
+	for i := range items {
+		ids = append(ids, result[fmt.Sprintf("item%d", i)].ID)
+	}
+	logger.Info("created items", "board", boardID, "items", len(ids))
+	return ids, nil
+}
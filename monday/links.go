@@ -0,0 +1,166 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// LinkValue is the value of a link column
+type LinkValue struct {
+	URL  string `json:"url"`
+	Text string `json:"text"`
+}
+
+// PullRequestLink builds the link column value for a pull request URL, the text is
+// "PR #12" for GitHub style URLs and the URL otherwise
+func PullRequestLink(rawURL string) (LinkValue, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return LinkValue{}, fmt.Errorf("invalid pull request URL %q", rawURL)
+	}
+	text := rawURL
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) >= 2 && (parts[len(parts)-2] == "pull" || parts[len(parts)-2] == "merge_requests") {
+		text = "PR #" + path.Base(parsed.Path)
+	}
+	return LinkValue{URL: rawURL, Text: text}, nil
+}
+
+// FindLinkColumn returns the ID of the first link column whose title contains one of the
+// words or phrases, words must match whole, so "pr" does not match "Project"
+func FindLinkColumn(board *Board, words ...string) (string, bool) {
+	for _, column := range board.Columns {
+		if column.Type != "link" {
+			continue
+		}
+		title := strings.ToLower(column.Title)
+		titleWords := strings.FieldsFunc(title, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if strings.Contains(word, " ") && strings.Contains(title, word) || slices.Contains(titleWords, word) {
+				return column.ID, true
+			}
+		}
+	}
+	return "", false
+}
+
+// CreateUpdate posts an update (a comment) on an item and returns its ID
+func (c *Client) CreateUpdate(itemID, body string) (string, error) {
+	query := buildOperation("mutation", "CreateUpdate", "$itemId: ID!, $body: String!",
+		newField("create_update", scalars("id")).withArgs("item_id: $itemId, body: $body"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"itemId": itemID, "body": body})
+	if err != nil {
+		return "", fmt.Errorf("failed to post update: %w", err)
+	}
+	var result struct {
+		CreateUpdate struct {
+			ID string `json:"id"`
+		} `json:"create_update"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal update: %w", err)
+	}
+	return result.CreateUpdate.ID, nil
+}
+
+// SetLinkColumn sets the URL and text of a link column
+func (c *Client) SetLinkColumn(boardID, itemID, columnID string, link LinkValue) error {
+	value, err := encodeColumnValue(link)
+	if err != nil {
+		return err
+	}
+	query := buildOperation("mutation", "SetLinkColumn", "$boardId: ID!, $itemId: ID!, $columnId: String!, $value: JSON!",
+		newField("change_column_value", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value"),
+	)
+	variables := map[string]interface{}{
+		"boardId":  boardID,
+		"itemId":   itemID,
+		"columnId": columnID,
+		"value":    value,
+	}
+	if _, err := c.ExecuteQuery(query, variables); err != nil {
+		return fmt.Errorf("failed to set link column: %w", err)
+	}
+	return nil
+}
+
+// GetLinkColumn reads a link column of an item, ErrNotFound when it is empty
+func (c *Client) GetLinkColumn(itemID, columnID string) (LinkValue, error) {
+	query := buildQuery("GetLinkColumn", "$itemId: ID!, $columnId: String!",
+		newField("items",
+			scalars("id"),
+			[]field{newField("column_values", columnValueFragment).withArgs("ids: [$columnId]")},
+		).withArgs("ids: [$itemId]"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"itemId": itemID, "columnId": columnID})
+	if err != nil {
+		return LinkValue{}, err
+	}
+	var result struct {
+		Items []Item `json:"items"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return LinkValue{}, fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+	if len(result.Items) == 0 {
+		return LinkValue{}, fmt.Errorf("task %w", ErrNotFound)
+	}
+	for _, cv := range result.Items[0].ColumnValues {
+		if cv.ID != columnID {
+			continue
+		}
+		var raw string
+		var link LinkValue
+		if json.Unmarshal(cv.Value, &raw) == nil && json.Unmarshal([]byte(raw), &link) == nil && link.URL != "" {
+			return link, nil
+		}
+	}
+	return LinkValue{}, fmt.Errorf("link %w", ErrNotFound)
+}
+
+// ItemURL builds the web URL of an item from the account subdomain
+func ItemURL(accountSlug, boardID, itemID string) string {
+	return fmt.Sprintf("https://%s.monday.com/boards/%s/pulses/%s", accountSlug, boardID, itemID)
+}
+
+// AccountSlugFromURL returns the account subdomain of a monday.com URL
+func AccountSlugFromURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	slug, found := strings.CutSuffix(parsed.Hostname(), ".monday.com")
+	if !found || strings.Contains(slug, ".") {
+		return ""
+	}
+	return slug
+}
+
+// GetItemURL fetches the web URL of an item
+func (c *Client) GetItemURL(itemID string) (string, error) {
+	query := buildQuery("GetItemURL", "$itemId: ID!",
+		newField("items", scalars("id", "url")).withArgs("ids: [$itemId]"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"itemId": itemID})
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		Items []Item `json:"items"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal item: %w", err)
+	}
+	if len(result.Items) == 0 || result.Items[0].URL == "" {
+		return "", fmt.Errorf("task %w", ErrNotFound)
+	}
+	return result.Items[0].URL, nil
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package monday
+
+import "os"
+
+// lockFile is a no-op where advisory locks are not available, writes stay atomic through
+// the rename in FileStore.write
+func lockFile(file *os.File, exclusive bool) error {
+	return nil
+}
+
+// unlockFile is a no-op where advisory locks are not available
+func unlockFile(file *os.File) error {
+	return nil
+}
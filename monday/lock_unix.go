@@ -0,0 +1,23 @@
+//go:build unix
+
+package monday
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an advisory lock on file, shared for readers and exclusive for writers.
+// It blocks until other processes release a conflicting lock.
+func lockFile(file *os.File, exclusive bool) error {
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+	return syscall.Flock(int(file.Fd()), how)
+}
+
+// unlockFile releases the advisory lock taken by lockFile
+func unlockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
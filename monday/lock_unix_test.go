@@ -0,0 +1,39 @@
+//go:build unix
+
+package monday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockRecurRuns(t *testing.T) {
+	ds := NewDataStoreWith(NewFileStore(t.TempDir()))
+	unlock, err := ds.LockRecurRuns()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locked := make(chan func())
+	go func() {
+		second, err := ds.LockRecurRuns()
+		if err != nil {
+			t.Error(err)
+			second = func() {}
+		}
+		locked <- second
+	}()
+	select {
+	case second := <-locked:
+		second()
+		t.Fatal("second run took the lock while the first held it")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case second := <-locked:
+		second()
+	case <-time.After(5 * time.Second):
+		t.Fatal("second run did not get the lock after the first released it")
+	}
+}
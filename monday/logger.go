@@ -0,0 +1,53 @@
+package monday
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LogEnvVar is the environment variable selecting the log level
+const LogEnvVar = "MONDAY_CLI_LOG"
+
+// logLevel is shared by the logger so the level can change after it is created
+var logLevel = new(slog.LevelVar)
+
+// logger is used by the client, services and datastore, it writes to stderr
+var logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
+
+func init() {
+	// Only warnings and errors are shown unless asked for more
+	logLevel.Set(slog.LevelWarn)
+}
+
+// Logger returns the package logger
+func Logger() *slog.Logger {
+	return logger
+}
+
+// SetLogger replaces the package logger, e.g. so programs using the package log to their own handler
+func SetLogger(l *slog.Logger) {
+	logger = l
+}
+
+// SetLogLevel changes the level of the package logger
+func SetLogLevel(level slog.Level) {
+	logLevel.Set(level)
+}
+
+// ParseLogLevel converts a level name like "debug" or "info" to a slog.Level
+func ParseLogLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "verbose":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelWarn, fmt.Errorf("unknown log level: %s", name)
+	}
+}
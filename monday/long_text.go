@@ -0,0 +1,82 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LongTextValue is the value of a long_text column
+type LongTextValue struct {
+	Text string `json:"text"`
+}
+
+// isLongTextColumn reports whether a column ID looks like the long text column holding the
+// description of a task
+func isLongTextColumn(columnID string) bool {
+	columnID = strings.ToLower(columnID)
+	return strings.HasPrefix(columnID, "long_text") || strings.Contains(columnID, "description") || strings.Contains(columnID, "notes")
+}
+
+// parseLongTextColumn returns the text of a long_text column, e.g. "{\"text\":\"Steps...\"}".
+// The text of the column value is used when the value cannot be read.
+func parseLongTextColumn(cv ColumnValue) string {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err == nil {
+		var value LongTextValue
+		if err := json.Unmarshal([]byte(jsonStr), &value); err == nil {
+			return value.Text
+		}
+	}
+	return cv.Text
+}
+
+// findLongTextColumn returns the long_text column holding task descriptions: the first one
+// named like a description or notes, otherwise the first long_text column, nil when none
+func findLongTextColumn(board *Board) *Column {
+	if column := findColumn(board, "long_text", "description", "notes"); column != nil {
+		return column
+	}
+	for i, column := range board.Columns {
+		if column.Type == "long_text" {
+			return &board.Columns[i]
+		}
+	}
+	return nil
+}
+
+// UpdateTaskDescription replaces the text of the long_text column of a task, an empty text
+// clears it
+func (c *Client) UpdateTaskDescription(boardID string, task Task, text string) (*Task, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	column := findLongTextColumn(board)
+	if column == nil {
+		return nil, fmt.Errorf("long text column %w in board", ErrNotFound)
+	}
+	encoded, err := encodeColumnValue(LongTextValue{Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	query := buildOperation("mutation", "UpdateTaskDescription", "$boardId: ID!, $itemId: ID!, $columnId: String!, $value: JSON!",
+		newField("change_column_value", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value"),
+	)
+	variables := map[string]interface{}{
+		"boardId":  boardID,
+		"itemId":   task.ID,
+		"columnId": column.ID,
+		"value":    encoded,
+	}
+	if _, err := c.ExecuteQuery(query, variables); err != nil {
+		return nil, fmt.Errorf("failed to update description: %w", err)
+	}
+
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
+	}
+	return updatedTask, nil
+}
@@ -0,0 +1,162 @@
+package monday
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BoardMetrics is a snapshot of the cached state of a board for the metrics exporter
+type BoardMetrics struct {
+	BoardID        string
+	BoardName      string
+	Tasks          int
+	OpenByStatus   map[string]int
+	OpenByAssignee map[string]int
+	Stale          int // Active tasks not updated for StaleDays
+	StaleDays      int
+	Sprint         string
+	SprintTasks    int
+	SprintDone     int
+	RefreshedAt    time.Time
+}
+
+// SprintCompletion returns the share of done tasks in the sprint between 0 and 1
+func (m BoardMetrics) SprintCompletion() float64 {
+	if m.SprintTasks == 0 {
+		return 0
+	}
+	return float64(m.SprintDone) / float64(m.SprintTasks)
+}
+
+// BoardMetrics computes the metrics of a board from the cache. Open tasks are the ones that
+// are neither done nor removed; the sprint is left out when the board has no tasks in it.
+func (as *AnalyticsService) BoardMetrics(boardID, sprintName string, staleDays int, now time.Time) (*BoardMetrics, error) {
+	tasks, err := as.BoardTasks(boardID)
+	if err != nil {
+		return nil, err
+	}
+	_, timestamp, _ := as.dataStore.GetCachedTasks(boardID)
+
+	metrics := &BoardMetrics{
+		BoardID:        boardID,
+		Tasks:          len(tasks),
+		OpenByStatus:   make(map[string]int),
+		OpenByAssignee: make(map[string]int),
+		StaleDays:      staleDays,
+		RefreshedAt:    timestamp,
+	}
+	for _, task := range tasks {
+		if IsDoneStatus(task.Status) || strings.Contains(strings.ToLower(string(task.Status)), "removed") {
+			continue
+		}
+		status := string(task.Status)
+		if status == "" {
+			status = "No status"
+		}
+		metrics.OpenByStatus[status]++
+		for _, assignee := range assigneeNames(task) {
+			metrics.OpenByAssignee[assignee]++
+		}
+	}
+
+	groups, err := as.StaleTasks(boardID, staleDays, now)
+	if err != nil {
+		return nil, err
+	}
+	staleIDs := make(map[string]bool)
+	for _, group := range groups {
+		for _, task := range group.Tasks {
+			staleIDs[task.ID] = true
+		}
+	}
+	metrics.Stale = len(staleIDs)
+
+	if sprintTasks := tasksInSprint(tasks, sprintName); sprintName != "" && len(sprintTasks) > 0 {
+		metrics.Sprint = sprintTasks[0].Sprint
+		metrics.SprintTasks = len(sprintTasks)
+		for _, task := range sprintTasks {
+			if IsDoneStatus(task.Status) {
+				metrics.SprintDone++
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// labelEscaper escapes label values for the text exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteMetrics writes board metrics in the Prometheus text exposition format
+func WriteMetrics(w io.Writer, boards []BoardMetrics) error {
+	var b strings.Builder
+	family := func(name, kind, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+	sample := func(name string, value float64, labels ...string) {
+		b.WriteString(name)
+		if len(labels) > 0 {
+			pairs := make([]string, 0, len(labels)/2)
+			for i := 0; i+1 < len(labels); i += 2 {
+				pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], labelEscaper.Replace(labels[i+1])))
+			}
+			b.WriteString("{" + strings.Join(pairs, ",") + "}")
+		}
+		b.WriteString(" " + strconv.FormatFloat(value, 'f', -1, 64) + "\n")
+	}
+
+	family("monday_tasks", "gauge", "Cached tasks of the board.")
+	for _, m := range boards {
+		sample("monday_tasks", float64(m.Tasks), "board", m.BoardID, "board_name", m.BoardName)
+	}
+	family("monday_open_tasks", "gauge", "Tasks that are neither done nor removed, by status.")
+	for _, m := range boards {
+		for _, status := range sortedKeys(m.OpenByStatus) {
+			sample("monday_open_tasks", float64(m.OpenByStatus[status]), "board", m.BoardID, "status", status)
+		}
+	}
+	family("monday_open_tasks_by_assignee", "gauge", "Open tasks by assignee, tasks with several people count for each.")
+	for _, m := range boards {
+		for _, assignee := range sortedKeys(m.OpenByAssignee) {
+			sample("monday_open_tasks_by_assignee", float64(m.OpenByAssignee[assignee]), "board", m.BoardID, "assignee", assignee)
+		}
+	}
+	family("monday_stale_tasks", "gauge", "Active tasks not updated for stale_days days.")
+	for _, m := range boards {
+		sample("monday_stale_tasks", float64(m.Stale), "board", m.BoardID, "stale_days", fmt.Sprint(m.StaleDays))
+	}
+	sprintFamily := func(name, help string, value func(BoardMetrics) float64) {
+		family(name, "gauge", help)
+		for _, m := range boards {
+			if m.Sprint != "" {
+				sample(name, value(m), "board", m.BoardID, "sprint", m.Sprint)
+			}
+		}
+	}
+	sprintFamily("monday_sprint_tasks", "Tasks in the current sprint.",
+		func(m BoardMetrics) float64 { return float64(m.SprintTasks) })
+	sprintFamily("monday_sprint_done_tasks", "Done tasks in the current sprint.",
+		func(m BoardMetrics) float64 { return float64(m.SprintDone) })
+	sprintFamily("monday_sprint_completion_ratio", "Share of done tasks in the current sprint.",
+		BoardMetrics.SprintCompletion)
+	family("monday_cache_refreshed_timestamp_seconds", "gauge", "Unix time the board was last fetched.")
+	for _, m := range boards {
+		sample("monday_cache_refreshed_timestamp_seconds", float64(m.RefreshedAt.Unix()), "board", m.BoardID)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// sortedKeys returns the keys of a count map in order, so the output is stable between scrapes
+func sortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
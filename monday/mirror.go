@@ -0,0 +1,29 @@
+package monday
+
+import "strings"
+
+// Mirror is the value of a mirror column, reflected from the items linked on another board
+type Mirror struct {
+	Column string `json:"column"` // Title of the mirror column, e.g. "Epic status"
+	Text   string `json:"text"`
+}
+
+// isMirrorColumn reports whether a column value belongs to a mirror column. Mirror values
+// carry their text in display_value and the column title, selected by mirrorValueFragment;
+// their value is null and their text empty.
+func isMirrorColumn(cv ColumnValue) bool {
+	return cv.Type == "mirror" || cv.Column != nil
+}
+
+// parseMirrorColumn returns the reflected values of a mirror column, e.g. "Done, Stuck" for
+// two linked items. The text is used by API versions without display_value.
+func parseMirrorColumn(cv ColumnValue) Mirror {
+	mirror := Mirror{Column: cv.ID, Text: strings.TrimSpace(cv.DisplayValue)}
+	if cv.Column != nil && cv.Column.Title != "" {
+		mirror.Column = cv.Column.Title
+	}
+	if mirror.Text == "" {
+		mirror.Text = strings.TrimSpace(cv.Text)
+	}
+	return mirror
+}
@@ -39,7 +39,118 @@ const (
 	TypeNone     Type = ""
 )
 
-type Sprint string
+type SprintState string
+
+const (
+	SprintStatePlanned   SprintState = "planned"
+	SprintStateActive    SprintState = "active"
+	SprintStateCompleted SprintState = "completed"
+	SprintStateNone      SprintState = ""
+)
+
+// Sprint represents a sprint item from the sprint board
+type Sprint struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	StartDate time.Time   `json:"start_date"`
+	EndDate   time.Time   `json:"end_date"`
+	State     SprintState `json:"state"`
+}
+
+// UnmarshalJSON also accepts the plain sprint name used by older caches
+func (s *Sprint) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*s = Sprint{Name: name}
+		return nil
+	}
+	type sprintAlias Sprint
+	var alias sprintAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+	*s = Sprint(alias)
+	return nil
+}
+
+// HasDates reports whether both start and end date are known
+func (s Sprint) HasDates() bool {
+	return !s.StartDate.IsZero() && !s.EndDate.IsZero()
+}
+
+// IsActiveAt reports whether the sprint runs at the given time.
+// An explicit state from the board wins over the dates.
+func (s Sprint) IsActiveAt(t time.Time) bool {
+	switch s.State {
+	case SprintStateActive:
+		return true
+	case SprintStateCompleted, SprintStatePlanned:
+		return false
+	}
+	if !s.HasDates() {
+		return false
+	}
+	// The end date is inclusive, so the sprint lasts until the end of that day
+	return !s.StartsAfter(t) && !s.EndedBefore(t)
+}
+
+// StartsAfter reports whether the sprint starts on a later day than the day of t. Days are
+// compared on the calendar of t, so a sprint starts at midnight wherever the user is.
+func (s Sprint) StartsAfter(t time.Time) bool {
+	return calendarDay(t).Before(calendarDay(s.StartDate))
+}
+
+// EndedBefore reports whether the sprint ended before the day of t
+func (s Sprint) EndedBefore(t time.Time) bool {
+	return calendarDay(t).After(calendarDay(s.EndDate))
+}
+
+// calendarDay returns the date of t as midnight UTC, to compare dates parsed in different
+// locations by their day only
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StateAt returns the explicit state, or derives it from the dates
+func (s Sprint) StateAt(t time.Time) SprintState {
+	if s.State != SprintStateNone || !s.HasDates() {
+		return s.State
+	}
+	switch {
+	case s.StartsAfter(t):
+		return SprintStatePlanned
+	case s.IsActiveAt(t):
+		return SprintStateActive
+	default:
+		return SprintStateCompleted
+	}
+}
+
+// DayAt returns the working day of the sprint t falls on and the number of working days,
+// Monday to Friday. Weekends count as the Friday before, before the start the day is 0.
+// Sprints without working days count calendar days, sprints without dates return 0, 0.
+func (s Sprint) DayAt(t time.Time) (day, days int) {
+	if !s.HasDates() {
+		return 0, 0
+	}
+	start, end, today := calendarDay(s.StartDate), calendarDay(s.EndDate), calendarDay(t)
+	for _, workdaysOnly := range []bool{true, false} {
+		day, days = 0, 0
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			if workdaysOnly && (d.Weekday() == time.Saturday || d.Weekday() == time.Sunday) {
+				continue
+			}
+			days++
+			if !d.After(today) {
+				day++
+			}
+		}
+		if days > 0 {
+			break
+		}
+	}
+	return day, days
+}
 
 // Board represents a Monday.com board
 type Board struct {
@@ -49,7 +160,26 @@ type Board struct {
 	State       string    `json:"state"`
 	UpdatedAt   time.Time `json:"updated_at"`
 	Columns     []Column  `json:"columns,omitempty"`
+	Groups      []Group   `json:"groups,omitempty"`
 	Items       []Item    `json:"items,omitempty"`
+	Type        string    `json:"type,omitempty"` // board, sub_items_board or document
+	WorkspaceID string    `json:"workspace_id,omitempty"`
+	ItemsCount  int       `json:"items_count,omitempty"`
+}
+
+// Workspace represents a Monday.com workspace
+type Workspace struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Kind        string `json:"kind"` // open or closed
+	Description string `json:"description"`
+	State       string `json:"state"`
+}
+
+// Group represents a group of items on a board
+type Group struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
 }
 
 // Column represents a Monday.com board column
@@ -63,16 +193,34 @@ type Column struct {
 
 // Item represents a Monday.com board item
 type Task struct {
-	LocalId   int       `json:"local_id"`
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Status    Status    `json:"status"`
-	Priority  Priority  `json:"priority"`
-	Type      Type      `json:"type"`
-	Sprint    Sprint    `json:"sprint"`
-	UserName  string    `json:"user_name"`
-	UserEmail string    `json:"user_email"`
-	UpdatedAt time.Time `json:"updated_at"`
+	LocalId     int       `json:"local_id"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Status      Status    `json:"status"`
+	Priority    Priority  `json:"priority"`
+	Type        Type      `json:"type"`
+	Sprint      string    `json:"sprint"`
+	UserName    string    `json:"user_name"`
+	UserEmail   string    `json:"user_email"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	DueDate     time.Time `json:"due_date,omitempty"`
+	StartDate   time.Time `json:"start_date,omitempty"` // First day of the timeline column
+	EndDate     time.Time `json:"end_date,omitempty"`   // Last day of the timeline column
+	Estimate    float64   `json:"estimate,omitempty"`   // Story points or another numeric estimate
+	Tags        []string  `json:"tags,omitempty"`
+	Description string    `json:"description,omitempty"` // Text of the long text column
+	Teams       []string  `json:"teams,omitempty"`       // Teams assigned in the people column
+	DependsOn   []string  `json:"depends_on,omitempty"`  // Item IDs of the dependency column
+	Mirrors     []Mirror  `json:"mirrors,omitempty"`     // Values reflected from linked boards, e.g. the epic status
+	CreatorID   string    `json:"creator_id,omitempty"`  // User who created the item
+	CreatorName string    `json:"creator,omitempty"`
+	UpdaterID   string    `json:"updater_id,omitempty"` // User of the newest activity log entry of the item
+	UpdaterName string    `json:"updater,omitempty"`
+	TouchedBy   []UserRef `json:"touched_by,omitempty"`   // Users with activity on the item within UpdaterWindow
+	StatusSince time.Time `json:"status_since,omitempty"` // When the task entered its status, zero when unknown
+	SinceBefore bool      `json:"since_before,omitempty"` // It entered the status before StatusSince, the start of the activity read
+	Board       string    `json:"board,omitempty"`        // Board name, only set when listing several boards
 }
 
 // Item represents a Monday.com board item
@@ -80,14 +228,28 @@ type Item struct {
 	ID           string        `json:"id"`
 	Name         string        `json:"name"`
 	ColumnValues []ColumnValue `json:"column_values"`
+	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
+	URL          string        `json:"url,omitempty"`
+	Group        *Group        `json:"group,omitempty"` // Only selected for snapshots
+	Creator      *User         `json:"creator,omitempty"`
+	State        string        `json:"state,omitempty"` // active, archived or deleted, only selected for the trash
+	Board        *BoardRef     `json:"board,omitempty"` // Only selected for items read by ID
 }
 
 // ColumnValue represents a column value for an item
 type ColumnValue struct {
-	ID    string          `json:"id"`
-	Text  string          `json:"text"`
-	Value json.RawMessage `json:"value"`
+	ID           string          `json:"id"`
+	Type         string          `json:"type,omitempty"`
+	Text         string          `json:"text"`
+	Value        json.RawMessage `json:"value"`
+	DisplayValue string          `json:"display_value,omitempty"` // Only sent for mirror columns
+	Column       *ColumnTitle    `json:"column,omitempty"`        // Only selected for mirror columns
+}
+
+// ColumnTitle is the column of a column value, selected where the title is shown
+type ColumnTitle struct {
+	Title string `json:"title"`
 }
 
 // User represents a Monday.com user
@@ -98,4 +260,22 @@ type User struct {
 	Title    string `json:"title"`
 	PhotoURL string `json:"photo_small"`
 	Enabled  bool   `json:"enabled"`
+	Teams    []Team `json:"teams,omitempty"`
+}
+
+// Team represents a Monday.com team
+type Team struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ActivityLog represents an entry of a board's activity log.
+// Data holds the event specific payload as a JSON string.
+type ActivityLog struct {
+	ID        string `json:"id"`
+	Event     string `json:"event"`
+	Entity    string `json:"entity"`
+	UserID    string `json:"user_id"`
+	Data      string `json:"data"`
+	CreatedAt string `json:"created_at"` // Unix time in units of 100 nanoseconds
 }
@@ -0,0 +1,35 @@
+package monday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSprintStateAtLocalDay(t *testing.T) {
+	// Dates of older caches were parsed as UTC, new ones in the local time zone
+	for _, location := range []*time.Location{time.UTC, time.FixedZone("UTC-8", -8*60*60), time.FixedZone("UTC+10", 10*60*60)} {
+		sprint := Sprint{
+			StartDate: time.Date(2026, 10, 5, 0, 0, 0, 0, location),
+			EndDate:   time.Date(2026, 10, 16, 0, 0, 0, 0, location),
+		}
+		for _, zone := range []*time.Location{time.FixedZone("UTC-8", -8*60*60), time.FixedZone("UTC+10", 10*60*60)} {
+			tests := []struct {
+				at   time.Time
+				want SprintState
+			}{
+				{time.Date(2026, 10, 4, 23, 59, 0, 0, zone), SprintStatePlanned},
+				{time.Date(2026, 10, 5, 0, 1, 0, 0, zone), SprintStateActive},
+				{time.Date(2026, 10, 16, 23, 59, 0, 0, zone), SprintStateActive},
+				{time.Date(2026, 10, 17, 0, 1, 0, 0, zone), SprintStateCompleted},
+			}
+			for _, tt := range tests {
+				if got := sprint.StateAt(tt.at); got != tt.want {
+					t.Errorf("sprint in %s, StateAt(%v) = %q, want %q", location, tt.at, got, tt.want)
+				}
+				if got := sprint.IsActiveAt(tt.at); got != (tt.want == SprintStateActive) {
+					t.Errorf("sprint in %s, IsActiveAt(%v) = %t", location, tt.at, got)
+				}
+			}
+		}
+	}
+}
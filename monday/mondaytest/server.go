@@ -0,0 +1,961 @@
+// Package mondaytest provides an in-memory fake of the Monday.com API, so
+// services and command handlers can be exercised without hitting the real API.
+//
+//	server := mondaytest.NewServer()
+//	server.AddBoard(monday.Board{ID: "1", Name: "Board", Columns: mondaytest.DefaultColumns()})
+//	server.AddItem("1", mondaytest.NewItem("10", "Fix login", map[string]string{"status": "Stuck"}))
+//	client := server.Client()
+package mondaytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"monday-cli/monday"
+)
+
+// HandlerFunc answers a GraphQL request with the value of the data field, or errors
+type HandlerFunc func(req monday.GraphQLRequest) (data interface{}, errs []monday.GraphQLError)
+
+// Server is an in-memory Monday.com API implementing monday.Transport
+type Server struct {
+	mu         sync.Mutex
+	boards     map[string]*board
+	workspaces []monday.Workspace
+	teams      []monday.Team
+	users      []monday.User
+	me         monday.User
+	handlers   map[string]HandlerFunc
+	tags       map[string]int                  // Tag names to IDs, shared by all boards like account tags
+	updates    map[string][]string             // Update bodies per item ID
+	activity   map[string][]monday.ActivityLog // Activity log per board ID, oldest first
+	nextID     int
+
+	// Requests records every request received, in order
+	Requests []monday.GraphQLRequest
+}
+
+// board holds a board and its items in insertion order
+type board struct {
+	monday.Board
+	items   []monday.Item
+	removed []monday.Item // Archived and deleted items, with their state set
+}
+
+// NewServer creates an empty fake server
+func NewServer() *Server {
+	return &Server{
+		boards:   make(map[string]*board),
+		handlers: make(map[string]HandlerFunc),
+		tags:     make(map[string]int),
+		updates:  make(map[string][]string),
+		activity: make(map[string][]monday.ActivityLog),
+		me:       monday.User{ID: "1", Name: "Test User", Email: "test@example.com", Enabled: true},
+		nextID:   1000,
+	}
+}
+
+// Client returns a client sending its requests to the fake server
+func (s *Server) Client() *monday.Client {
+	return monday.NewClient(monday.WithAPIKey("test-token"), monday.WithTransport(s))
+}
+
+// DefaultColumns returns the status, priority, type, owner, sprint and due date columns most boards use
+func DefaultColumns() []monday.Column {
+	return []monday.Column{
+		{ID: "status", Title: "Status", Type: "status", SettingsStr: statusSettings("In Progress", "Done", "Stuck", "Waiting for review", "Ready for testing", "Removed")},
+		{ID: "priority", Title: "Priority", Type: "status", SettingsStr: statusSettings("Critical", "High", "Medium", "Low")},
+		{ID: "task_type", Title: "Type", Type: "status", SettingsStr: statusSettings("Bug", "Feature", "Test", "Security", "Quality")},
+		{ID: "task_owner", Title: "Owner", Type: "people"},
+		{ID: "sprint", Title: "Sprint", Type: "board_relation"},
+		{ID: "due_date", Title: "Due date", Type: "date"},
+	}
+}
+
+// statusSettings builds the settings_str of a status column with the given labels
+func statusSettings(labels ...string) string {
+	byIndex := make(map[string]string, len(labels))
+	for i, label := range labels {
+		byIndex[strconv.Itoa(i)] = label
+	}
+	data, _ := json.Marshal(map[string]interface{}{"labels": byIndex})
+	return string(data)
+}
+
+// NewItem creates an item with label columns, the map goes from column ID to label
+func NewItem(id, name string, labels map[string]string) monday.Item {
+	now := time.Now().UTC().Truncate(time.Second)
+	item := monday.Item{ID: id, Name: name, CreatedAt: now, UpdatedAt: now}
+	columnIDs := make([]string, 0, len(labels))
+	for columnID := range labels {
+		columnIDs = append(columnIDs, columnID)
+	}
+	sort.Strings(columnIDs)
+	for _, columnID := range columnIDs {
+		raw, _ := json.Marshal(map[string]string{"label": labels[columnID]})
+		item.ColumnValues = append(item.ColumnValues, columnValue(columnID, raw))
+	}
+	return item
+}
+
+// AddBoard adds or replaces a board
+func (s *Server) AddBoard(b monday.Board) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.boards[b.ID] = &board{Board: b}
+}
+
+// AddWorkspace adds a workspace, boards belong to it through their WorkspaceID
+func (s *Server) AddWorkspace(w monday.Workspace) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workspaces = append(s.workspaces, w)
+}
+
+// AddTeam adds a team answered by the teams query
+func (s *Server) AddTeam(t monday.Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.teams = append(s.teams, t)
+}
+
+// AddItem appends an item to a board
+func (s *Server) AddItem(boardID string, item monday.Item) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b, ok := s.boards[boardID]; ok {
+		b.items = append(b.items, item)
+	}
+}
+
+// AddActivityLog appends an entry to the activity log of a board
+func (s *Server) AddActivityLog(boardID string, entry monday.ActivityLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	if entry.ID == "" {
+		entry.ID = strconv.Itoa(s.nextID)
+	}
+	s.activity[boardID] = append(s.activity[boardID], entry)
+}
+
+// RemoveItem archives or deletes an item and writes the activity log entry Monday writes for
+// it. Removed items are left out of the board's items but answered by items(ids: ...).
+func (s *Server) RemoveItem(boardID, itemID string, deleted bool, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.boards[boardID]
+	if !ok {
+		return
+	}
+	for i, item := range b.items {
+		if item.ID != itemID {
+			continue
+		}
+		event := "archive_pulse"
+		item.State = "archived"
+		if deleted {
+			event = "delete_pulse"
+			item.State = "deleted"
+		}
+		b.items = append(b.items[:i], b.items[i+1:]...)
+		b.removed = append(b.removed, item)
+		data, _ := json.Marshal(map[string]interface{}{"pulse_id": json.Number(item.ID), "pulse_name": item.Name})
+		s.nextID++
+		s.activity[boardID] = append(s.activity[boardID], monday.ActivityLog{
+			ID:        strconv.Itoa(s.nextID),
+			Event:     event,
+			Entity:    "pulse",
+			UserID:    s.me.ID,
+			Data:      string(data),
+			CreatedAt: strconv.FormatInt(at.UnixNano()/100, 10),
+		})
+		return
+	}
+}
+
+// NewStatusChangeLog creates the activity log entry Monday writes when an item's status changes
+func NewStatusChangeLog(itemID, itemName, from, to string, at time.Time) monday.ActivityLog {
+	label := func(text string) map[string]interface{} {
+		return map[string]interface{}{"label": map[string]interface{}{"text": text}}
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"pulse_id":       json.Number(itemID),
+		"pulse_name":     itemName,
+		"column_id":      "status",
+		"column_type":    "color",
+		"value":          label(to),
+		"previous_value": label(from),
+	})
+	return monday.ActivityLog{
+		Event:     "update_column_value",
+		Entity:    "pulse",
+		UserID:    "1",
+		Data:      string(data),
+		CreatedAt: strconv.FormatInt(at.UnixNano()/100, 10),
+	}
+}
+
+// Items returns a copy of the items on a board
+func (s *Server) Items(boardID string) []monday.Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.boards[boardID]
+	if !ok {
+		return nil
+	}
+	return append([]monday.Item(nil), b.items...)
+}
+
+// SetMe sets the user returned for the "me" query
+func (s *Server) SetMe(user monday.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.me = user
+}
+
+// AddUser adds a user to the account
+func (s *Server) AddUser(user monday.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = append(s.users, user)
+}
+
+// Handle overrides the answer for an operation, e.g. "GetBoard", to simulate errors or odd data
+func (s *Server) Handle(operation string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[operation] = fn
+}
+
+// Do answers a GraphQL request from the in-memory state
+func (s *Server) Do(req *http.Request) (*http.Response, error) {
+	var gqlReq monday.GraphQLRequest
+	if req.Body != nil {
+		defer req.Body.Close()
+		if err := json.NewDecoder(req.Body).Decode(&gqlReq); err != nil {
+			return respond(http.StatusBadRequest, map[string]interface{}{
+				"error_code":    "BadRequest",
+				"error_message": err.Error(),
+			}), nil
+		}
+	}
+	if req.Header.Get("Authorization") == "" {
+		return respond(http.StatusUnauthorized, map[string]interface{}{
+			"error_message": "Not Authenticated",
+		}), nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Requests = append(s.Requests, gqlReq)
+
+	data, errs := s.answer(gqlReq)
+	body := map[string]interface{}{"data": data}
+	if len(errs) > 0 {
+		body["errors"] = errs
+	}
+	return respond(http.StatusOK, body), nil
+}
+
+// answer dispatches a request to an override or the built-in implementation of the root field
+func (s *Server) answer(req monday.GraphQLRequest) (interface{}, []monday.GraphQLError) {
+	if fn, ok := s.handlers[operation(req.Query)]; ok {
+		return fn(req)
+	}
+
+	query := req.Query
+	vars := req.Variables
+	switch {
+	case strings.Contains(query, "create_item(") && vars["itemName0"] != nil:
+		return s.createItems(vars)
+	case strings.Contains(query, "create_item("):
+		return s.createItem(vars)
+	case strings.Contains(query, "change_multiple_column_values(") && vars["itemId0"] != nil:
+		return s.changeItems(vars)
+	case strings.Contains(query, "change_multiple_column_values("):
+		return s.changeColumnValues(vars)
+	case strings.Contains(query, "change_column_value("):
+		raw, _ := json.Marshal(map[string]interface{}{str(vars["columnId"]): json.RawMessage(str(vars["value"]))})
+		vars["columnValues"] = string(raw)
+		data, errs := s.changeColumnValues(vars)
+		if errs == nil {
+			data = map[string]interface{}{"change_column_value": data.(map[string]interface{})["change_multiple_column_values"]}
+		}
+		return data, errs
+	case strings.Contains(query, "move_item_to_board("):
+		return s.moveItemToBoard(vars)
+	case strings.Contains(query, "duplicate_item("):
+		return s.duplicateItem(vars)
+	case strings.Contains(query, "create_update("):
+		return s.createUpdate(vars)
+	case strings.Contains(query, "create_or_get_tag("):
+		return s.createOrGetTag(str(vars["tagName"])), nil
+	case strings.Contains(query, "create_webhook("):
+		s.nextID++
+		return map[string]interface{}{"create_webhook": map[string]string{"id": strconv.Itoa(s.nextID), "board_id": str(vars["boardId"])}}, nil
+	case strings.Contains(query, "delete_webhook("):
+		return map[string]interface{}{"delete_webhook": map[string]string{"id": str(vars["id"])}}, nil
+	case strings.Contains(query, "next_items_page("):
+		return s.queryNextItemsPage(vars), nil
+	case strings.Contains(query, "workspaces("):
+		return s.queryWorkspaces(vars), nil
+	case strings.Contains(query, "activity_logs("):
+		return s.queryActivityLogs(vars), nil
+	case strings.Contains(query, "boards(") && vars["workspaceId"] != nil:
+		return s.queryWorkspaceBoards(vars), nil
+	case strings.Contains(query, "boards(") && vars["boardId"] == nil:
+		return s.queryBoardList(vars), nil
+	case strings.Contains(query, "boards("):
+		return s.queryBoards(vars), nil
+	case strings.Contains(query, "items("):
+		return s.queryItems(vars), nil
+	case strings.Contains(query, "users("):
+		return s.queryUsers(vars), nil
+	case strings.Contains(query, "me {"):
+		return map[string]interface{}{"me": s.me}, nil
+	case strings.Contains(query, "teams {"):
+		return map[string]interface{}{"teams": append([]monday.Team{}, s.teams...)}, nil
+	}
+	return nil, []monday.GraphQLError{{Message: "mondaytest: unsupported query " + operation(query)}}
+}
+
+// queryBoards answers boards(ids: [$boardId]) including a page of items
+func (s *Server) queryBoards(vars map[string]interface{}) interface{} {
+	boards := []interface{}{}
+	b, ok := s.boards[str(vars["boardId"])]
+	if ok {
+		page := itemsPageCursor{boardID: b.ID, text: nameFilter(vars["queryParams"]), mine: assignedToMeFilter(vars["queryParams"])}
+		if cursor, ok := parseCursor(str(vars["cursor"])); ok {
+			page = cursor
+		}
+		boards = append(boards, map[string]interface{}{
+			"id":          b.ID,
+			"name":        b.Name,
+			"description": b.Description,
+			"state":       b.State,
+			"updated_at":  b.UpdatedAt,
+			"items_count": len(b.items),
+			"columns":     b.Columns,
+			"groups":      b.Groups,
+			"items_page":  s.itemsPage(page, limit(vars), vars["columnIds"]),
+		})
+	}
+	return map[string]interface{}{"boards": boards}
+}
+
+// queryActivityLogs answers boards(ids: [$boardId]) { activity_logs } with the entries since
+// $from, newest first like Monday, all on the first page
+func (s *Server) queryActivityLogs(vars map[string]interface{}) interface{} {
+	boards := []interface{}{}
+	if _, ok := s.boards[str(vars["boardId"])]; ok {
+		entries := []monday.ActivityLog{}
+		if page, _ := vars["page"].(float64); page <= 1 {
+			from, _ := time.Parse(time.RFC3339, str(vars["from"]))
+			logs := s.activity[str(vars["boardId"])]
+			for i := len(logs) - 1; i >= 0; i-- {
+				if !logs[i].Time().Before(from) {
+					entries = append(entries, logs[i])
+				}
+			}
+		}
+		boards = append(boards, map[string]interface{}{"activity_logs": entries})
+	}
+	return map[string]interface{}{"boards": boards}
+}
+
+// queryWorkspaces answers workspaces, all of them on the first page
+func (s *Server) queryWorkspaces(vars map[string]interface{}) interface{} {
+	workspaces := []monday.Workspace{}
+	if page, _ := vars["page"].(float64); page <= 1 {
+		workspaces = append(workspaces, s.workspaces...)
+	}
+	return map[string]interface{}{"workspaces": workspaces}
+}
+
+// queryWorkspaceBoards answers boards(workspace_ids: [$workspaceId]) sorted by ID, all on the first page
+func (s *Server) queryWorkspaceBoards(vars map[string]interface{}) interface{} {
+	boards := []monday.Board{}
+	if page, _ := vars["page"].(float64); page <= 1 {
+		for _, b := range s.boards {
+			if b.WorkspaceID == str(vars["workspaceId"]) {
+				found := b.Board
+				found.Columns = nil
+				found.ItemsCount = len(b.items)
+				boards = append(boards, found)
+			}
+		}
+	}
+	sort.Slice(boards, func(i, j int) bool { return boards[i].ID < boards[j].ID })
+	return map[string]interface{}{"boards": boards}
+}
+
+// queryBoardList answers boards(limit: $limit, page: $page) with all boards sorted by ID on the first page
+func (s *Server) queryBoardList(vars map[string]interface{}) interface{} {
+	boards := []monday.Board{}
+	if page, _ := vars["page"].(float64); page <= 1 {
+		for _, b := range s.boards {
+			boards = append(boards, b.Board)
+		}
+	}
+	sort.Slice(boards, func(i, j int) bool { return boards[i].ID < boards[j].ID })
+	return map[string]interface{}{"boards": boards}
+}
+
+// queryNextItemsPage answers next_items_page(cursor: $cursor)
+func (s *Server) queryNextItemsPage(vars map[string]interface{}) interface{} {
+	page, _ := parseCursor(str(vars["cursor"]))
+	return map[string]interface{}{"next_items_page": s.itemsPage(page, limit(vars), vars["columnIds"])}
+}
+
+// itemsPageCursor is the position in a, possibly filtered, list of board items
+type itemsPageCursor struct {
+	boardID string
+	offset  int
+	mine    bool // Only items assigned to the authenticated user
+	text    string
+}
+
+// String encodes the cursor as it is sent to the client
+func (c itemsPageCursor) String() string {
+	return fmt.Sprintf("%s:%d:%t:%s", c.boardID, c.offset, c.mine, c.text)
+}
+
+// parseCursor decodes a cursor created by itemsPageCursor.String
+func parseCursor(cursor string) (itemsPageCursor, bool) {
+	parts := strings.SplitN(cursor, ":", 4)
+	if len(parts) != 4 {
+		return itemsPageCursor{}, false
+	}
+	offset, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return itemsPageCursor{}, false
+	}
+	return itemsPageCursor{boardID: parts[0], offset: offset, mine: parts[2] == "true", text: parts[3]}, true
+}
+
+// itemsPage returns up to limit items from the cursor position and the cursor of the next page.
+// Like column_values(ids: $columnIds), only the listed column values are returned when set.
+func (s *Server) itemsPage(page itemsPageCursor, limit int, columnIDs interface{}) map[string]interface{} {
+	var items []monday.Item
+	if b, ok := s.boards[page.boardID]; ok {
+		for _, item := range b.items {
+			if page.mine && !s.assignedToMe(item) {
+				continue
+			}
+			if strings.Contains(strings.ToLower(item.Name), strings.ToLower(page.text)) {
+				items = append(items, item)
+			}
+		}
+	}
+
+	result := []monday.Item{}
+	cursor := ""
+	if page.offset < len(items) {
+		end := page.offset + limit
+		if end < len(items) {
+			next := page
+			next.offset = end
+			cursor = next.String()
+		} else {
+			end = len(items)
+		}
+		result = items[page.offset:end]
+	}
+	if ids, ok := columnIDs.([]interface{}); ok {
+		result = selectColumns(result, ids)
+	}
+	return map[string]interface{}{"items": result, "cursor": cursor}
+}
+
+// selectColumns returns copies of items with only the column values whose ID is in ids
+func selectColumns(items []monday.Item, ids []interface{}) []monday.Item {
+	selected := make([]monday.Item, len(items))
+	for i, item := range items {
+		item.ColumnValues = nil
+		for _, cv := range items[i].ColumnValues {
+			for _, id := range ids {
+				if str(id) == cv.ID {
+					item.ColumnValues = append(item.ColumnValues, cv)
+				}
+			}
+		}
+		selected[i] = item
+	}
+	return selected
+}
+
+// limit returns the $limit variable, defaulting to 100 like queries with a fixed limit
+func limit(vars map[string]interface{}) int {
+	if l, ok := vars["limit"].(float64); ok {
+		return int(l)
+	}
+	return 100
+}
+
+// nameFilter returns the text of a contains_text rule on the name column in query_params
+func nameFilter(queryParams interface{}) string {
+	params, _ := queryParams.(map[string]interface{})
+	rules, _ := params["rules"].([]interface{})
+	for _, r := range rules {
+		rule, _ := r.(map[string]interface{})
+		values, _ := rule["compare_value"].([]interface{})
+		if str(rule["column_id"]) == "name" && len(values) > 0 {
+			return str(values[0])
+		}
+	}
+	return ""
+}
+
+// assignedToMeFilter reports whether query_params has an assigned_to_me rule
+func assignedToMeFilter(queryParams interface{}) bool {
+	params, _ := queryParams.(map[string]interface{})
+	rules, _ := params["rules"].([]interface{})
+	for _, r := range rules {
+		rule, _ := r.(map[string]interface{})
+		values, _ := rule["compare_value"].([]interface{})
+		if len(values) > 0 && str(values[0]) == "assigned_to_me" {
+			return true
+		}
+	}
+	return false
+}
+
+// assignedToMe reports whether a people column of the item holds the user set with SetMe
+func (s *Server) assignedToMe(item monday.Item) bool {
+	for _, cv := range item.ColumnValues {
+		var raw string
+		if err := json.Unmarshal(cv.Value, &raw); err != nil {
+			continue
+		}
+		var people monday.PeopleValue
+		if err := json.Unmarshal([]byte(raw), &people); err != nil {
+			continue
+		}
+		for _, person := range people.PersonsAndTeams {
+			if person.Kind == "person" && strconv.FormatInt(person.ID, 10) == s.me.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// itemWithBoard is an item as answered by items(ids:), with the board it is on
+type itemWithBoard struct {
+	monday.Item
+	Board struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"board"`
+}
+
+// queryItems answers items(ids: [$itemId]) and items(ids: $itemIds), archived and deleted items
+// included
+func (s *Server) queryItems(vars map[string]interface{}) interface{} {
+	ids := []string{str(vars["itemId"])}
+	if list, ok := vars["itemIds"].([]interface{}); ok {
+		ids = ids[:0]
+		for _, id := range list {
+			ids = append(ids, str(id))
+		}
+	}
+	items := []itemWithBoard{}
+	for _, id := range ids {
+		b, item := s.findItem(id)
+		if item == nil {
+			b, item = s.findRemovedItem(id)
+		}
+		if item == nil {
+			continue
+		}
+		found := itemWithBoard{Item: *item}
+		found.URL = fmt.Sprintf("https://test.monday.com/boards/%s/pulses/%s", b.ID, item.ID)
+		found.Board.ID = b.ID
+		found.Board.Name = b.Name
+		items = append(items, found)
+	}
+	return map[string]interface{}{"items": items}
+}
+
+// queryUsers answers users(limit: $limit, page: $page), users(ids: $ids) and users(name: $name)
+func (s *Server) queryUsers(vars map[string]interface{}) interface{} {
+	if ids, ok := vars["ids"].([]interface{}); ok {
+		users := []monday.User{}
+		for _, user := range s.users {
+			for _, id := range ids {
+				if str(id) == user.ID {
+					users = append(users, user)
+				}
+			}
+		}
+		return map[string]interface{}{"users": users}
+	}
+	if name, ok := vars["name"].(string); ok {
+		users := []monday.User{}
+		for _, user := range s.users {
+			if strings.Contains(strings.ToLower(user.Name), strings.ToLower(name)) {
+				users = append(users, user)
+			}
+		}
+		return map[string]interface{}{"users": users}
+	}
+	limit := len(s.users)
+	if l, ok := vars["limit"].(float64); ok {
+		limit = int(l)
+	}
+	page := 1
+	if p, ok := vars["page"].(float64); ok {
+		page = int(p)
+	}
+	users := []monday.User{}
+	if start := (page - 1) * limit; start < len(s.users) {
+		end := start + limit
+		if end > len(s.users) {
+			end = len(s.users)
+		}
+		users = s.users[start:end]
+	}
+	return map[string]interface{}{"users": users}
+}
+
+// createItem answers create_item and appends the item to the board
+func (s *Server) createItem(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	b, ok := s.boards[str(vars["boardId"])]
+	if !ok {
+		return nil, []monday.GraphQLError{notFound("board", str(vars["boardId"]))}
+	}
+	values, err := parseColumnValues(vars["columnValues"])
+	if err != nil {
+		return nil, []monday.GraphQLError{{Message: err.Error()}}
+	}
+
+	s.nextID++
+	now := time.Now().UTC().Truncate(time.Second)
+	item := monday.Item{ID: strconv.Itoa(s.nextID), Name: str(vars["itemName"]), CreatedAt: now, UpdatedAt: now}
+	for _, group := range b.Groups {
+		if group.ID == str(vars["groupId"]) {
+			item.Group = &monday.Group{ID: group.ID, Title: group.Title}
+		}
+	}
+	setColumnValues(&item, values)
+	s.setTagTexts(&item)
+	b.items = append(b.items, item)
+	return map[string]interface{}{"create_item": map[string]string{"id": item.ID}}, nil
+}
+
+// createItems answers aliased create_item mutations item0, item1... with $itemNameN, $columnValuesN and $groupIdN
+func (s *Server) createItems(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	created := make(map[string]interface{})
+	for i := 0; vars["itemName"+strconv.Itoa(i)] != nil; i++ {
+		n := strconv.Itoa(i)
+		data, errs := s.createItem(map[string]interface{}{
+			"boardId":      vars["boardId"],
+			"itemName":     vars["itemName"+n],
+			"columnValues": vars["columnValues"+n],
+			"groupId":      vars["groupId"+n],
+		})
+		if errs != nil {
+			return nil, errs
+		}
+		created["item"+n] = data.(map[string]interface{})["create_item"]
+	}
+	return created, nil
+}
+
+// duplicateItem answers duplicate_item and appends the copy to the board after the item
+func (s *Server) duplicateItem(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	b, item := s.findItem(str(vars["itemId"]))
+	if item == nil || b.ID != str(vars["boardId"]) {
+		return nil, []monday.GraphQLError{notFound("item", str(vars["itemId"]))}
+	}
+	s.nextID++
+	now := time.Now().UTC().Truncate(time.Second)
+	copied := *item
+	copied.ID = strconv.Itoa(s.nextID)
+	copied.ColumnValues = append([]monday.ColumnValue{}, item.ColumnValues...)
+	copied.CreatedAt, copied.UpdatedAt = now, now
+	b.items = append(b.items, copied)
+	return map[string]interface{}{"duplicate_item": map[string]string{"id": copied.ID}}, nil
+}
+
+// moveItemToBoard answers move_item_to_board. Column values are renamed by $columnsMapping and
+// dropped when mapped to null, without a mapping they keep their column IDs.
+func (s *Server) moveItemToBoard(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	from, item := s.findItem(str(vars["itemId"]))
+	if item == nil {
+		return nil, []monday.GraphQLError{notFound("item", str(vars["itemId"]))}
+	}
+	to, ok := s.boards[str(vars["boardId"])]
+	if !ok {
+		return nil, []monday.GraphQLError{notFound("board", str(vars["boardId"]))}
+	}
+	moved := *item
+	moved.Group = nil
+	for _, group := range to.Groups {
+		if group.ID == str(vars["groupId"]) {
+			moved.Group = &monday.Group{ID: group.ID, Title: group.Title}
+		}
+	}
+	if moved.Group == nil {
+		return nil, []monday.GraphQLError{notFound("group", str(vars["groupId"]))}
+	}
+	if mapping, ok := vars["columnsMapping"].([]interface{}); ok {
+		targets := make(map[string]interface{})
+		for _, entry := range mapping {
+			m, _ := entry.(map[string]interface{})
+			targets[str(m["source"])] = m["target"]
+		}
+		moved.ColumnValues = nil
+		for _, cv := range item.ColumnValues {
+			if target, ok := targets[cv.ID]; ok && target != nil {
+				cv.ID = str(target)
+				moved.ColumnValues = append(moved.ColumnValues, cv)
+			}
+		}
+	}
+	moved.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+
+	for i := range from.items {
+		if from.items[i].ID == moved.ID {
+			from.items = append(from.items[:i], from.items[i+1:]...)
+			break
+		}
+	}
+	to.items = append(to.items, moved)
+	return map[string]interface{}{"move_item_to_board": map[string]string{"id": moved.ID}}, nil
+}
+
+// changeColumnValues answers change_multiple_column_values
+func (s *Server) changeColumnValues(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	_, item := s.findItem(str(vars["itemId"]))
+	if item == nil {
+		return nil, []monday.GraphQLError{notFound("item", str(vars["itemId"]))}
+	}
+	values, err := parseColumnValues(vars["columnValues"])
+	if err != nil {
+		return nil, []monday.GraphQLError{{Message: err.Error()}}
+	}
+	if name, ok := values["name"]; ok {
+		json.Unmarshal(name, &item.Name)
+		delete(values, "name")
+	}
+	setColumnValues(item, values)
+	s.setTagTexts(item)
+	item.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	return map[string]interface{}{"change_multiple_column_values": map[string]string{"id": item.ID}}, nil
+}
+
+// changeItems answers aliased change_multiple_column_values mutations item0, item1... with
+// $itemIdN and $columnValuesN
+func (s *Server) changeItems(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	changed := make(map[string]interface{})
+	for i := 0; vars["itemId"+strconv.Itoa(i)] != nil; i++ {
+		n := strconv.Itoa(i)
+		data, errs := s.changeColumnValues(map[string]interface{}{
+			"boardId":      vars["boardId"],
+			"itemId":       vars["itemId"+n],
+			"columnValues": vars["columnValues"+n],
+		})
+		if errs != nil {
+			return nil, errs
+		}
+		changed["item"+n] = data.(map[string]interface{})["change_multiple_column_values"]
+	}
+	return changed, nil
+}
+
+// createUpdate answers create_update and records the body
+func (s *Server) createUpdate(vars map[string]interface{}) (interface{}, []monday.GraphQLError) {
+	itemID := str(vars["itemId"])
+	if _, item := s.findItem(itemID); item == nil {
+		return nil, []monday.GraphQLError{notFound("item", itemID)}
+	}
+	s.nextID++
+	s.updates[itemID] = append(s.updates[itemID], str(vars["body"]))
+	return map[string]interface{}{"create_update": map[string]string{"id": strconv.Itoa(s.nextID)}}, nil
+}
+
+// Updates returns the bodies of the updates posted on an item
+func (s *Server) Updates(itemID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.updates[itemID]...)
+}
+
+// createOrGetTag answers create_or_get_tag, creating unknown tags
+func (s *Server) createOrGetTag(name string) interface{} {
+	id, ok := s.tags[name]
+	if !ok {
+		s.nextID++
+		id = s.nextID
+		s.tags[name] = id
+	}
+	return map[string]interface{}{"create_or_get_tag": map[string]interface{}{"id": strconv.Itoa(id), "name": name}}
+}
+
+// setTagTexts sets the text of tags column values to the tag names, like the API does
+func (s *Server) setTagTexts(item *monday.Item) {
+	names := make(map[int]string, len(s.tags))
+	for name, id := range s.tags {
+		names[id] = name
+	}
+	for i, cv := range item.ColumnValues {
+		var raw string
+		var value monday.TagsValue
+		if json.Unmarshal(cv.Value, &raw) != nil || json.Unmarshal([]byte(raw), &value) != nil || value.TagIDs == nil {
+			continue
+		}
+		var text []string
+		for _, id := range value.TagIDs {
+			text = append(text, names[int(id)])
+		}
+		item.ColumnValues[i].Text = strings.Join(text, ", ")
+	}
+}
+
+// findItem returns the board and a pointer to the item with the given ID
+func (s *Server) findItem(itemID string) (*board, *monday.Item) {
+	for _, b := range s.boards {
+		for i := range b.items {
+			if b.items[i].ID == itemID {
+				return b, &b.items[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// findRemovedItem finds an archived or deleted item on any board
+func (s *Server) findRemovedItem(itemID string) (*board, *monday.Item) {
+	for _, b := range s.boards {
+		for i := range b.removed {
+			if b.removed[i].ID == itemID {
+				return b, &b.removed[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// parseColumnValues decodes the JSON string passed as column_values
+func parseColumnValues(v interface{}) (map[string]json.RawMessage, error) {
+	values := make(map[string]json.RawMessage)
+	if v == nil {
+		return values, nil
+	}
+	if err := json.Unmarshal([]byte(str(v)), &values); err != nil {
+		return nil, fmt.Errorf("invalid column values: %v", err)
+	}
+	return values, nil
+}
+
+// setColumnValues replaces or adds column values on an item
+func setColumnValues(item *monday.Item, values map[string]json.RawMessage) {
+	columnIDs := make([]string, 0, len(values))
+	for columnID := range values {
+		columnIDs = append(columnIDs, columnID)
+	}
+	sort.Strings(columnIDs)
+
+	for _, columnID := range columnIDs {
+		cv := columnValue(columnID, values[columnID])
+		replaced := false
+		for i := range item.ColumnValues {
+			if item.ColumnValues[i].ID == columnID {
+				item.ColumnValues[i] = cv
+				replaced = true
+			}
+		}
+		if !replaced {
+			item.ColumnValues = append(item.ColumnValues, cv)
+		}
+	}
+}
+
+// columnValue builds a column value the way the API returns it, the value is a JSON encoded string
+func columnValue(columnID string, raw json.RawMessage) monday.ColumnValue {
+	encoded, _ := json.Marshal(string(raw))
+	return monday.ColumnValue{ID: columnID, Text: columnText(raw), Value: encoded}
+}
+
+// columnText derives the display text of a label, text or people value
+func columnText(raw json.RawMessage) string {
+	var value struct {
+		Label           string `json:"label"`
+		Text            string `json:"text"`
+		Date            string `json:"date"`
+		PersonsAndTeams []struct {
+			ID int `json:"id"`
+		} `json:"personsAndTeams"`
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var text string
+		json.Unmarshal(raw, &text)
+		return text
+	}
+	if value.Label != "" {
+		return value.Label
+	}
+	if value.Date != "" {
+		return value.Date
+	}
+	if len(value.PersonsAndTeams) > 0 {
+		var ids []string
+		for _, person := range value.PersonsAndTeams {
+			ids = append(ids, strconv.Itoa(person.ID))
+		}
+		return strings.Join(ids, ", ")
+	}
+	return value.Text
+}
+
+// operation returns the operation name of a GraphQL document
+func operation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) < 2 || fields[1] == "{" {
+		return ""
+	}
+	name, _, _ := strings.Cut(fields[1], "(")
+	return name
+}
+
+// notFound builds the error the API returns for unknown IDs
+func notFound(kind, id string) monday.GraphQLError {
+	err := monday.GraphQLError{Message: fmt.Sprintf("%s %s not found", kind, id)}
+	err.Extensions.Code = "ResourceNotFoundException"
+	return err
+}
+
+// str converts a variable to a string, IDs may be sent as strings or numbers
+func str(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// respond builds an HTTP response with a JSON body
+func respond(statusCode int, body interface{}) *http.Response {
+	data, _ := json.Marshal(body)
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(data)),
+	}
+}
@@ -0,0 +1,159 @@
+package mondaytest_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"monday-cli/monday"
+	"monday-cli/monday/mondaytest"
+)
+
+// newBoardServer returns a server with board 1 holding n items named Task 0, Task 1...
+func newBoardServer(n int) *mondaytest.Server {
+	server := mondaytest.NewServer()
+	columns := append(mondaytest.DefaultColumns(), monday.Column{ID: "person", Title: "Person", Type: "people"})
+	server.AddBoard(monday.Board{ID: "1", Name: "Board", Columns: columns, ItemsCount: n})
+	for i := 0; i < n; i++ {
+		server.AddItem("1", mondaytest.NewItem(fmt.Sprint(100+i), fmt.Sprintf("Task %d", i), map[string]string{"status": "Stuck"}))
+	}
+	return server
+}
+
+// countRequests counts the requests whose query contains text
+func countRequests(server *mondaytest.Server, text string) int {
+	count := 0
+	for _, req := range server.Requests {
+		if strings.Contains(req.Query, text) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestGetBoardItemsPages(t *testing.T) {
+	server := newBoardServer(25)
+	client := monday.NewClient(monday.WithAPIKey("test-token"), monday.WithTransport(server), monday.WithPageSize(10))
+
+	tasks, items, err := client.GetBoardItems("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 25 || len(tasks) != 25 {
+		t.Fatalf("got %d items and %d tasks, want 25", len(items), len(tasks))
+	}
+	for i, task := range tasks {
+		if want := fmt.Sprintf("Task %d", i); task.Name != want || task.LocalId != i+1 {
+			t.Errorf("task %d = %q #%d, want %q #%d", i, task.Name, task.LocalId, want, i+1)
+		}
+		if task.Status != "Stuck" {
+			t.Errorf("task %d status = %q, want Stuck", i, task.Status)
+		}
+	}
+	if n := countRequests(server, "query GetBoardItemsByOwner"); n != 3 {
+		t.Errorf("fetched %d pages, want 3", n)
+	}
+}
+
+func TestGetBoardItemsEmptyBoard(t *testing.T) {
+	server := newBoardServer(0)
+	tasks, items, err := server.Client().GetBoardItems("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 0 || len(items) != 0 {
+		t.Errorf("got %d tasks and %d items, want none", len(tasks), len(items))
+	}
+	if n := countRequests(server, "query GetBoardItemsByOwner"); n != 1 {
+		t.Errorf("fetched %d pages of an empty board, want 1", n)
+	}
+}
+
+func TestCreateTask(t *testing.T) {
+	server := newBoardServer(0)
+	client := server.Client()
+
+	task, err := client.CreateTask("1", "", "Fix login", "In Progress", "High", "Bug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.ID == "" || task.Name != "Fix login" {
+		t.Fatalf("created task = %+v", task)
+	}
+	if task.Status != "In Progress" || task.Priority != "High" || task.Type != "Bug" {
+		t.Errorf("created task labels = %s, %s, %s", task.Status, task.Priority, task.Type)
+	}
+
+	items := server.Items("1")
+	if len(items) != 1 || items[0].ID != task.ID || items[0].Name != "Fix login" {
+		t.Fatalf("board items = %+v, want the created task", items)
+	}
+	_, fetched, err := client.GetBoardItems("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fetched) != 1 || fetched[0].ID != task.ID {
+		t.Errorf("fetched items = %+v, want the created task", fetched)
+	}
+}
+
+func TestCreateTaskInvalidLabel(t *testing.T) {
+	server := newBoardServer(0)
+	if _, err := server.Client().CreateTask("1", "", "Fix login", "Sleeping", "", ""); err == nil {
+		t.Fatal("created a task with an unknown status label")
+	}
+	if items := server.Items("1"); len(items) != 0 {
+		t.Errorf("board has %d items after a rejected task", len(items))
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	server := newBoardServer(0)
+	server.Handle("GetBoard", func(req monday.GraphQLRequest) (interface{}, []monday.GraphQLError) {
+		graphqlErr := monday.GraphQLError{Message: "Budget exhausted"}
+		graphqlErr.Extensions.Code = "ComplexityException"
+		return nil, []monday.GraphQLError{graphqlErr}
+	})
+
+	_, err := server.Client().GetBoard("1")
+	var apiErr *monday.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want an APIError", err)
+	}
+	if !errors.Is(err, monday.ErrComplexityBudget) {
+		t.Errorf("error = %v, want ErrComplexityBudget", err)
+	}
+	if _, _, err := server.Client().GetBoardItems("1"); !errors.Is(err, monday.ErrComplexityBudget) {
+		t.Errorf("GetBoardItems error = %v, want ErrComplexityBudget", err)
+	}
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	server := newBoardServer(0)
+	if _, err := server.Client().GetBoard("404"); !errors.Is(err, monday.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSearchBoardItemsPages(t *testing.T) {
+	server := newBoardServer(25)
+	client := monday.NewClient(monday.WithAPIKey("test-token"), monday.WithTransport(server), monday.WithPageSize(2))
+
+	tasks, _, err := client.SearchBoardItems("1", "task 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Task 1 and Task 10 to Task 19
+	if len(tasks) != 11 {
+		t.Fatalf("found %d tasks, want 11", len(tasks))
+	}
+	if n := countRequests(server, "query SearchBoardItems"); n != 6 {
+		t.Errorf("fetched %d pages, want 6", n)
+	}
+	for _, req := range server.Requests[1:] {
+		if req.Variables["queryParams"] != nil {
+			t.Errorf("query_params sent with cursor %v", req.Variables["cursor"])
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GetBoardGroups returns the groups of a board, top group first
+func (c *Client) GetBoardGroups(boardID string) ([]Group, error) {
+	query := buildQuery("GetBoardGroups", "$boardId: ID!",
+		boardByID(scalars("id"), []field{newField("groups", scalars("id", "title"))}),
+	)
+	var result struct {
+		Boards []Board `json:"boards"`
+	}
+	if err := c.execute(c.ctx, query, map[string]interface{}{"boardId": boardID}, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Boards) == 0 {
+		return nil, fmt.Errorf("board %w", ErrNotFound)
+	}
+	return result.Boards[0].Groups, nil
+}
+
+// FindGroup finds a group by ID, then by title ignoring case
+func FindGroup(groups []Group, idOrTitle string) (Group, bool) {
+	for _, group := range groups {
+		if group.ID == idOrTitle {
+			return group, true
+		}
+	}
+	for _, group := range groups {
+		if strings.EqualFold(group.Title, idOrTitle) {
+			return group, true
+		}
+	}
+	return Group{}, false
+}
+
+// MoveItemToBoard moves an item into a group of another board. Without a column map Monday
+// matches the columns itself. With one, the columns it does not name are matched by ID, then
+// by title and type, and dropped when the target board has no such column.
+func (c *Client) MoveItemToBoard(itemID, boardID, groupID string, columnMap map[string]string) error {
+	vars := "$boardId: ID!, $groupId: ID!, $itemId: ID!"
+	mappingArg := ""
+	variables := map[string]interface{}{
+		"boardId": boardID,
+		"groupId": groupID,
+		"itemId":  itemID,
+	}
+	if len(columnMap) > 0 {
+		_, sourceBoardID, err := c.getItemWithBoard(itemID)
+		if err != nil {
+			return err
+		}
+		mapping, err := c.moveColumnMapping(sourceBoardID, boardID, columnMap)
+		if err != nil {
+			return err
+		}
+		vars += ", $columnsMapping: [ColumnMappingInput!]"
+		mappingArg = ", columns_mapping: $columnsMapping"
+		variables["columnsMapping"] = mapping
+	}
+
+	query := fmt.Sprintf(`
+		mutation MoveItemToBoard(%s) {
+			move_item_to_board(board_id: $boardId, group_id: $groupId, item_id: $itemId%s) {
+				id
+			}
+		}
+	`, vars, mappingArg)
+	resp, err := c.ExecuteQuery(query, variables)
+	if err != nil {
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+	var result struct {
+		MoveItemToBoard *struct {
+			ID string `json:"id"`
+		} `json:"move_item_to_board"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return fmt.Errorf("failed to parse moved item: %w", err)
+	}
+	if result.MoveItemToBoard == nil {
+		return fmt.Errorf("item %s %w", itemID, ErrNotFound)
+	}
+	logger.Info("moved item", "item", itemID, "board", boardID, "group", groupID)
+	return nil
+}
+
+// columnMapping maps a column of the source board to one of the target board in
+// move_item_to_board, a nil target drops the column's values
+type columnMapping struct {
+	Source string  `json:"source"`
+	Target *string `json:"target"`
+}
+
+// moveColumnMapping completes a column map with every other writable column of the source
+// board, as move_item_to_board drops the columns a mapping leaves out
+func (c *Client) moveColumnMapping(sourceBoardID, targetBoardID string, columnMap map[string]string) ([]columnMapping, error) {
+	source, err := c.GetBoard(sourceBoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board of the item: %w", err)
+	}
+	target, err := c.GetBoard(targetBoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target board: %w", err)
+	}
+
+	sourceColumns := make(map[string]bool, len(source.Columns))
+	for _, column := range source.Columns {
+		sourceColumns[column.ID] = true
+	}
+	targetColumns := make(map[string]bool, len(target.Columns))
+	for _, column := range target.Columns {
+		targetColumns[column.ID] = true
+	}
+	for from, to := range columnMap {
+		if !sourceColumns[from] {
+			return nil, fmt.Errorf("column %s of board %s %w", from, source.ID, ErrNotFound)
+		}
+		if !targetColumns[to] {
+			return nil, fmt.Errorf("column %s of board %s %w", to, target.ID, ErrNotFound)
+		}
+	}
+
+	var mapping []columnMapping
+	for _, column := range source.Columns {
+		if column.Type == "name" || column.Type == "formula" {
+			continue
+		}
+		entry := columnMapping{Source: column.ID}
+		if to, ok := columnMap[column.ID]; ok {
+			entry.Target = &to
+		} else if match, ok := matchColumn(column, target.Columns); ok {
+			entry.Target = &match.ID
+		}
+		mapping = append(mapping, entry)
+	}
+	return mapping, nil
+}
@@ -0,0 +1,86 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// myWorkBoardsPageSize is the number of boards requested per page when collecting assigned tasks
+const myWorkBoardsPageSize = 50
+
+// AssignedTasks are the tasks of one board assigned to the authenticated user
+type AssignedTasks struct {
+	Board Board
+	Tasks []Task
+}
+
+// GetAssignedTasks collects the tasks assigned to the authenticated user on every active board
+// with a people column. The items are filtered on Monday's side with the assigned_to_me rule,
+// so only boards and matching items are transferred. Boards without assigned tasks are left out.
+func (c *Client) GetAssignedTasks() ([]AssignedTasks, error) {
+	boards, err := c.getPeopleBoards()
+	if err != nil {
+		return nil, err
+	}
+	var assigned []AssignedTasks
+	for _, board := range boards {
+		rules := make([]map[string]interface{}, 0, len(board.Columns))
+		for _, column := range board.Columns {
+			rules = append(rules, map[string]interface{}{
+				"column_id":     column.ID,
+				"compare_value": []string{"assigned_to_me"},
+				"operator":      "any_of",
+			})
+		}
+		queryParams := map[string]interface{}{"rules": rules, "operator": "or"}
+		tasks, _, err := c.queryBoardItems("GetAssignedItems", board.ID, queryParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get assigned items of board %s: %w", board.Name, err)
+		}
+		if len(tasks) > 0 {
+			assigned = append(assigned, AssignedTasks{Board: board, Tasks: tasks})
+		}
+	}
+	return assigned, nil
+}
+
+// getPeopleBoards lists the active boards that have people columns, Columns only holds those
+func (c *Client) getPeopleBoards() ([]Board, error) {
+	var boards []Board
+	for page := 1; ; page++ {
+		query := buildQuery("GetPeopleBoards", "$limit: Int!, $page: Int!",
+			newField("boards",
+				scalars("id", "name", "type"),
+				[]field{newField("columns", scalars("id", "type")).withArgs("types: [people]")},
+			).withArgs("state: active, limit: $limit, page: $page"),
+		)
+		resp, err := c.ExecuteQuery(query, map[string]interface{}{"limit": myWorkBoardsPageSize, "page": page})
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Boards []Board `json:"boards"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal boards: %w", err)
+		}
+		for _, board := range result.Boards {
+			if board.Type != "" && board.Type != "board" {
+				continue // Subitem boards are reached through their parent items
+			}
+			var people []Column
+			for _, column := range board.Columns {
+				if column.Type == "people" {
+					people = append(people, column)
+				}
+			}
+			if len(people) > 0 {
+				board.Columns = people
+				boards = append(boards, board)
+			}
+		}
+		if len(result.Boards) < myWorkBoardsPageSize {
+			return boards, nil
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package monday
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoints of Monday's OAuth server
+const (
+	DefaultOAuthAuthURL  = "https://auth.monday.com/oauth2/authorize"
+	DefaultOAuthTokenURL = "https://auth.monday.com/oauth2/token"
+)
+
+// DefaultOAuthRedirectPort is the localhost port of the login callback, the app must allow
+// http://localhost:<port>/callback as redirect URL
+const DefaultOAuthRedirectPort = 8976
+
+// oauthExpiryMargin refreshes tokens this long before they expire, so requests in flight never fail
+const oauthExpiryMargin = time.Minute
+
+// OAuthApp is a Monday OAuth app used to log in instead of a personal API token
+type OAuthApp struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	Scopes       string `json:"scopes,omitempty"`        // Space separated, e.g. "me:read boards:read boards:write"
+	RedirectPort int    `json:"redirect_port,omitempty"` // DefaultOAuthRedirectPort when 0
+	AuthURL      string `json:"auth_url,omitempty"`      // DefaultOAuthAuthURL when empty
+	TokenURL     string `json:"token_url,omitempty"`     // DefaultOAuthTokenURL when empty
+}
+
+// OAuthToken is a token issued to an OAuth app. Tokens without expiry are valid until revoked.
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Expired reports whether the token expires within the refresh margin
+func (t *OAuthToken) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().Add(oauthExpiryMargin).After(t.Expiry)
+}
+
+// RedirectURI returns the callback URL of the login server
+func (a OAuthApp) RedirectURI() string {
+	port := a.RedirectPort
+	if port == 0 {
+		port = DefaultOAuthRedirectPort
+	}
+	return fmt.Sprintf("http://localhost:%d/callback", port)
+}
+
+// AuthCodeURL returns the page where the user grants the app access
+func (a OAuthApp) AuthCodeURL(state string) string {
+	authURL := a.AuthURL
+	if authURL == "" {
+		authURL = DefaultOAuthAuthURL
+	}
+	params := url.Values{"client_id": {a.ClientID}, "redirect_uri": {a.RedirectURI()}, "state": {state}}
+	if a.Scopes != "" {
+		params.Set("scope", a.Scopes)
+	}
+	return authURL + "?" + params.Encode()
+}
+
+// Exchange trades the code of the login callback for a token
+func (a OAuthApp) Exchange(ctx context.Context, transport Transport, code string) (*OAuthToken, error) {
+	return a.requestToken(ctx, transport, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {a.RedirectURI()},
+	})
+}
+
+// Refresh trades a refresh token for a new token
+func (a OAuthApp) Refresh(ctx context.Context, transport Transport, refreshToken string) (*OAuthToken, error) {
+	token, err := a.requestToken(ctx, transport, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+	if err == nil && token.RefreshToken == "" {
+		token.RefreshToken = refreshToken // The refresh token stays valid unless a new one is issued
+	}
+	return token, err
+}
+
+// requestToken posts a token request with the app credentials
+func (a OAuthApp) requestToken(ctx context.Context, transport Transport, form url.Values) (*OAuthToken, error) {
+	tokenURL := a.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultOAuthTokenURL
+	}
+	form.Set("client_id", a.ClientID)
+	form.Set("client_secret", a.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := transport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	var result struct {
+		AccessToken      string `json:"access_token"`
+		RefreshToken     string `json:"refresh_token"`
+		TokenType        string `json:"token_type"`
+		Scope            string `json:"scope"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, newAPIError(resp.StatusCode, "", strings.TrimSpace(string(body)), nil)
+	}
+	if result.Error != "" || result.AccessToken == "" {
+		message := strings.TrimSpace(result.Error + ": " + result.ErrorDescription)
+		return nil, fmt.Errorf("%w: token request failed: %s", ErrUnauthorized, strings.Trim(message, ": "))
+	}
+	token := &OAuthToken{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		Scope:        result.Scope,
+	}
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// Login runs the authorization code flow: it starts the callback server on localhost, calls
+// open with the page where the user grants access, and exchanges the code sent to the callback.
+// It returns when the callback was answered or ctx is done.
+func (a OAuthApp) Login(ctx context.Context, transport Transport, open func(authURL string) error) (*OAuthToken, error) {
+	if a.ClientID == "" {
+		return nil, fmt.Errorf("OAuth app has no client ID")
+	}
+	redirect, err := url.Parse(a.RedirectURI())
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:"+redirect.Port())
+	if err != nil {
+		return nil, fmt.Errorf("failed to start login callback on port %s: %w", redirect.Port(), err)
+	}
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+
+	type callback struct {
+		code string
+		err  error
+	}
+	result := make(chan callback, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(redirect.Path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var answer callback
+		switch {
+		case query.Get("state") != state:
+			answer.err = fmt.Errorf("login callback with unexpected state")
+		case query.Get("error") != "":
+			answer.err = fmt.Errorf("%w: %s %s", ErrUnauthorized, query.Get("error"), query.Get("error_description"))
+		case query.Get("code") == "":
+			answer.err = fmt.Errorf("login callback without code")
+		default:
+			answer.code = query.Get("code")
+		}
+		if answer.err != nil {
+			http.Error(w, "Login failed: "+answer.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Logged in to monday-cli, you can close this window.")
+		}
+		select {
+		case result <- answer:
+		default: // Only the first callback counts
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err := open(a.AuthCodeURL(state)); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("login not completed: %w", ctx.Err())
+	case answer := <-result:
+		if answer.err != nil {
+			return nil, answer.err
+		}
+		logger.Debug("login callback received")
+		return a.Exchange(ctx, transport, answer.code)
+	}
+}
+
+// randomState returns an unguessable value that ties the callback to this login
+func randomState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to create login state: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// GetOAuthTokenPath returns the file the OAuth token is kept in, next to the config file
+func GetOAuthTokenPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "oauth_token.json")
+}
+
+// LoadOAuthToken reads a saved token, ErrNotFound when nobody is logged in
+func LoadOAuthToken(path string) (*OAuthToken, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("OAuth token %w, run 'auth login'", ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OAuth token: %w", err)
+	}
+	var token OAuthToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse OAuth token %s: %w", path, err)
+	}
+	return &token, nil
+}
+
+// SaveOAuthToken writes a token readable only by the user
+func SaveOAuthToken(path string, token *OAuthToken) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode OAuth token: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write OAuth token: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write OAuth token: %w", err)
+	}
+	return nil
+}
+
+// DeleteOAuthToken removes a saved token, it is no error when there is none
+func DeleteOAuthToken(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete OAuth token: %w", err)
+	}
+	return nil
+}
+
+// RefreshableAuth is an auth provider whose token can be renewed. The client refreshes
+// once and retries when the API rejects a token.
+type RefreshableAuth interface {
+	AuthProvider
+	Refresh() error
+}
+
+// OAuthTokenProvider supplies the token saved by 'auth login' and refreshes it before it
+// expires, saving the new token for the next run
+type OAuthTokenProvider struct {
+	app       OAuthApp
+	path      string
+	transport Transport
+	mu        sync.Mutex
+	token     *OAuthToken
+}
+
+// NewOAuthTokenProvider creates a provider for the token of an app saved at path, tokens are
+// refreshed through transport, e.g. a client from NewHTTPClient
+func NewOAuthTokenProvider(app OAuthApp, path string, transport Transport) *OAuthTokenProvider {
+	return &OAuthTokenProvider{app: app, path: path, transport: transport}
+}
+
+// Token returns the access token, refreshing an expiring one
+func (p *OAuthTokenProvider) Token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == nil {
+		token, err := LoadOAuthToken(p.path)
+		if err != nil {
+			return "", err
+		}
+		p.token = token
+	}
+	if p.token.Expired() {
+		if err := p.refresh(); err != nil {
+			return "", err
+		}
+	}
+	return p.token.AccessToken, nil
+}
+
+// Refresh renews the token even if it has not expired, e.g. after it was revoked
+func (p *OAuthTokenProvider) Refresh() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == nil {
+		token, err := LoadOAuthToken(p.path)
+		if err != nil {
+			return err
+		}
+		p.token = token
+	}
+	return p.refresh()
+}
+
+// refresh renews the loaded token and saves it, the caller holds the lock
+func (p *OAuthTokenProvider) refresh() error {
+	if p.token.RefreshToken == "" {
+		return errors.New("OAuth token expired and cannot be refreshed, run 'auth login'")
+	}
+	token, err := p.app.Refresh(context.Background(), p.transport, p.token.RefreshToken)
+	if err != nil {
+		return err
+	}
+	p.token = token
+	logger.Info("refreshed OAuth token", "expiry", token.Expiry)
+	return SaveOAuthToken(p.path, token)
+}
@@ -0,0 +1,94 @@
+package monday
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kinds of queued operations
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+)
+
+// PendingOperation is a task mutation queued while the API was unreachable
+type PendingOperation struct {
+	ID         int         `json:"id"`
+	Kind       string      `json:"kind"`
+	QueuedAt   time.Time   `json:"queued_at"`
+	TaskID     string      `json:"task_id,omitempty"` // Empty for creates
+	TaskName   string      `json:"task_name"`
+	Details    TaskDetails `json:"details"`
+	TagChanges string      `json:"tag_changes,omitempty"` // Tag edits like "+backend,-urgent"
+	// BaseUpdatedAt is when the cached task the update was based on last changed,
+	// a newer updated_at on Monday means someone else changed the task meanwhile
+	BaseUpdatedAt time.Time `json:"base_updated_at,omitempty"`
+}
+
+// QueueOperation adds an operation to the queue of a board and returns it with its ID
+func (ds *DataStore) QueueOperation(boardID string, op PendingOperation) (PendingOperation, error) {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return op, fmt.Errorf("board %s %w in cache", boardID, ErrNotFound)
+	}
+	op.ID = 1
+	for _, pending := range cache.Pending {
+		if pending.ID >= op.ID {
+			op.ID = pending.ID + 1
+		}
+	}
+	op.QueuedAt = time.Now()
+	cache.Pending = append(cache.Pending, op)
+	ds.cache[boardID] = cache
+	return op, ds.Save()
+}
+
+// GetPendingOperations returns the queued operations of a board, oldest first
+func (ds *DataStore) GetPendingOperations(boardID string) []PendingOperation {
+	return ds.cache[boardID].Pending
+}
+
+// RemovePendingOperation drops an operation from the queue, e.g. after it was replayed
+func (ds *DataStore) RemovePendingOperation(boardID string, id int) error {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return fmt.Errorf("board %s %w in cache", boardID, ErrNotFound)
+	}
+	for i, pending := range cache.Pending {
+		if pending.ID == id {
+			cache.Pending = append(cache.Pending[:i], cache.Pending[i+1:]...)
+			ds.cache[boardID] = cache
+			return ds.Save()
+		}
+	}
+	return fmt.Errorf("queued operation %d %w", id, ErrNotFound)
+}
+
+// ReplayOperation sends a queued operation and returns the task before and after it.
+// Updates of tasks changed on Monday since they were cached fail with ErrConflict, unless force is set.
+func (c *Client) ReplayOperation(boardID string, op PendingOperation, force bool) (before Task, after *Task, err error) {
+	switch op.Kind {
+	case OperationCreate:
+		after, err = c.CreateTaskWithDetails(boardID, op.TaskName, op.Details)
+		return Task{}, after, err
+	case OperationUpdate:
+		current, err := c.GetTaskByID(op.TaskID)
+		if err != nil {
+			return Task{}, nil, err
+		}
+		if !force && current.UpdatedAt.After(op.BaseUpdatedAt) {
+			return *current, nil, fmt.Errorf("task %q %w (updated %s)", current.Name, ErrConflict, current.UpdatedAt.Local().Format("2006-01-02 15:04"))
+		}
+		details := op.Details
+		after, err = c.UpdateTask(boardID, "", *current, details.Status, details.Priority, details.Type)
+		if err == nil && op.TagChanges != "" {
+			var tags []string
+			if tags, err = ApplyTagChanges(after.Tags, op.TagChanges); err == nil {
+				after, err = c.UpdateTaskTags(boardID, *after, tags)
+			}
+		}
+		return *current, after, err
+	default:
+		return Task{}, nil, fmt.Errorf("unknown operation %q", op.Kind)
+	}
+}
@@ -0,0 +1,153 @@
+package monday
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultBaseURL is the endpoint of the Monday.com GraphQL API
+const DefaultBaseURL = "https://api.monday.com/v2"
+
+// DefaultTimeout is the request timeout of clients created without WithTimeout or WithTransport
+const DefaultTimeout = 30 * time.Second
+
+// ClientOption configures a Client created with NewClient
+type ClientOption func(*Client)
+
+// WithAPIKey authenticates requests with a fixed API key
+func WithAPIKey(apiKey string) ClientOption {
+	return func(c *Client) {
+		c.auth = NewStaticTokenProvider(apiKey)
+	}
+}
+
+// WithAuth authenticates requests with tokens from an auth provider, e.g. a command or OAuth
+func WithAuth(auth AuthProvider) ClientOption {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// WithTimeout sets the timeout of every request, including reading the response
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.http.timeout = timeout
+	}
+}
+
+// WithProxy sends requests through an HTTP or HTTPS proxy instead of the one from the
+// HTTPS_PROXY and NO_PROXY environment variables
+func WithProxy(proxy *url.URL) ClientOption {
+	return func(c *Client) {
+		c.http.proxy = proxy
+	}
+}
+
+// WithRootCAs verifies the API certificate against pool, e.g. the system roots plus the CA of
+// a corporate proxy loaded with LoadCABundle
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(c *Client) {
+		c.http.rootCAs = pool
+	}
+}
+
+// WithTransport sends requests through transport, e.g. a configured *http.Client or a test fake.
+// The timeout, proxy and CA options only apply to the HTTP client built without a transport.
+func WithTransport(transport Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithBaseURL changes the API endpoint, e.g. to point the client at a test server
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithColumnIDs only fetches the values of these columns with the tasks of a board, instead of
+// every column of every item. The owner column is always fetched so tasks can be filtered.
+func WithColumnIDs(ids []string) ClientOption {
+	return func(c *Client) {
+		c.columnIDs = ids
+	}
+}
+
+// WithDryRun makes the client write mutations to w instead of sending them.
+// Queries are still executed so mutations can be prepared as usual.
+func WithDryRun(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.dryRun = w
+	}
+}
+
+// NewHTTPClient returns the HTTP client NewClient builds from the timeout, proxy and CA options,
+// e.g. for requests outside the API such as OAuth token requests. Other options are ignored.
+func NewHTTPClient(opts ...ClientOption) *http.Client {
+	c := &Client{http: httpSettings{timeout: DefaultTimeout}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c.http.client()
+}
+
+// httpSettings configure the HTTP client built by NewClient
+type httpSettings struct {
+	timeout time.Duration
+	proxy   *url.URL
+	rootCAs *x509.CertPool
+}
+
+// client builds an HTTP client with its own transport, so the settings never leak into
+// http.DefaultTransport
+func (s httpSettings) client() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if s.proxy != nil {
+		transport.Proxy = http.ProxyURL(s.proxy)
+	}
+	if s.rootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: s.rootCAs, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Timeout: s.timeout, Transport: transport}
+}
+
+// ParseProxyURL parses a proxy setting, hosts without scheme are taken as http:// proxies
+func ParseProxyURL(proxy string) (*url.URL, error) {
+	parsed, err := url.Parse(proxy)
+	if err != nil || parsed.Host == "" {
+		parsed, err = url.Parse("http://" + proxy)
+	}
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q", proxy)
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+		return parsed, nil
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q, use http://, https:// or socks5://", proxy)
+	}
+}
+
+// LoadCABundle returns the system root certificates plus the PEM certificates in path
+func LoadCABundle(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		logger.Debug("system certificates unavailable", "error", err)
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no PEM certificates found in CA bundle %s", path)
+	}
+	return pool, nil
+}
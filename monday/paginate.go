@@ -0,0 +1,227 @@
+package monday
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// DefaultPageSize is the number of board items requested per page unless set with WithPageSize
+const DefaultPageSize = 25
+
+// MaxPageSize is the largest number of items the API returns per items_page
+const MaxPageSize = 500
+
+// minPageSize is the smallest page size the paginator shrinks to before giving up
+const minPageSize = 5
+
+// PageProgress reports a paginated fetch after every page, or a batch of CreateItems after
+// every batch
+type PageProgress struct {
+	Operation string // GraphQL operation, e.g. "GetBoardItemsByOwner"
+	BoardID   string
+	Page      int  // Number of pages fetched, starting at 1
+	Items     int  // Number of items fetched so far
+	Total     int  // Expected number of items, 0 when unknown. Fetches use the items_count of the board.
+	PageSize  int  // Items per request, smaller after the API rejected a page
+	Done      bool // The last page was fetched
+}
+
+// WithPageSize sets the number of items requested per page, instead of the default of each
+// query. Larger pages need fewer requests but use more of the complexity budget.
+func WithPageSize(size int) ClientOption {
+	return func(c *Client) {
+		c.pageSize = min(size, MaxPageSize)
+	}
+}
+
+// WithProgress calls fn after every page of a paginated fetch, e.g. to show how many items
+// of a large board were fetched
+func WithProgress(fn func(PageProgress)) ClientOption {
+	return func(c *Client) {
+		c.progress = fn
+	}
+}
+
+// PartialResultError is returned when a paginated fetch fails after some pages were fetched.
+// Items holds the items fetched so far and Cursor the position to continue from, see
+// ResumeBoardItems. Cursors expire after 60 minutes.
+type PartialResultError struct {
+	Operation string
+	BoardID   string
+	Items     []Item
+	Cursor    string
+	Err       error
+
+	columnIDs []string // Column values selected by the failed fetch
+}
+
+func (e *PartialResultError) Error() string {
+	return fmt.Sprintf("fetched %d items of board %s before: %v", len(e.Items), e.BoardID, e.Err)
+}
+
+// Unwrap returns the error of the failed page so errors.Is works with ErrRateLimited etc.
+func (e *PartialResultError) Unwrap() error {
+	return e.Err
+}
+
+// itemsPaginator fetches all items of a board with items_page(limit:, cursor:), one page per
+// request. The page size shrinks when the API rejects a page as too expensive or too slow.
+type itemsPaginator struct {
+	client      *Client
+	operation   string
+	boardID     string
+	boardFields []field // Board fields selected with every page, e.g. columns for a snapshot
+	itemFields  []field
+	columnIDs   []string               // Only fetch these column values, all when empty
+	queryParams map[string]interface{} // items_page query_params filtering the items, all when nil
+	total       int                    // Expected number of items for progress reports, 0 when unknown
+	pageSize    int
+	cursor      string // Position to start from, empty for the first page
+}
+
+// newItemsPaginator returns a paginator with the page size of the client or defaultPageSize
+func (c *Client) newItemsPaginator(operation, boardID string, defaultPageSize int, itemFields []field) *itemsPaginator {
+	pageSize := defaultPageSize
+	if c.pageSize > 0 {
+		pageSize = c.pageSize
+	}
+	return &itemsPaginator{
+		client:     c,
+		operation:  operation,
+		boardID:    boardID,
+		itemFields: itemFields,
+		pageSize:   pageSize,
+	}
+}
+
+// fetch returns the board fields of the first page and the items of all pages. When a page
+// fails after others were fetched, the error is a *PartialResultError.
+func (p *itemsPaginator) fetch() (*Board, []Item, error) {
+	vars := "$boardId: ID!, $limit: Int!, $cursor: String"
+	itemFields := p.itemFields
+	if len(p.columnIDs) > 0 {
+		vars += ", $columnIds: [String!]"
+		itemFields = withColumnIDs(itemFields)
+	}
+	args := "limit: $limit, cursor: $cursor"
+	if p.queryParams != nil {
+		vars += ", $queryParams: ItemsQuery"
+		args += ", query_params: $queryParams"
+	}
+	query := buildQuery(p.operation, vars,
+		boardByID(p.boardFields, []field{
+			newField("items_page", []field{newField("items", itemFields)}, scalars("cursor")).withArgs(args),
+		}),
+	)
+
+	var board *Board
+	var items []Item
+	cursor := p.cursor
+	for page := 1; ; {
+		variables := map[string]interface{}{
+			"boardId": p.boardID,
+			"limit":   p.pageSize,
+		}
+		if cursor != "" {
+			variables["cursor"] = cursor
+		} else if p.queryParams != nil {
+			// The cursor carries the filter of the first page, it is only sent with that one
+			variables["queryParams"] = p.queryParams
+		}
+		if len(p.columnIDs) > 0 {
+			variables["columnIds"] = p.columnIDs
+		}
+
+		// Pages are decoded while they are read, large boards are never held as raw JSON
+		var result struct {
+			Boards []struct {
+				Board
+				ItemsPage itemsPage `json:"items_page"`
+			} `json:"boards"`
+		}
+		err := p.client.execute(p.client.ctx, query, variables, &result)
+		if err != nil && p.shrink(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, p.partial(items, cursor, err)
+		}
+		if len(result.Boards) == 0 {
+			return nil, nil, fmt.Errorf("board %w", ErrNotFound)
+		}
+		if board == nil {
+			board = &result.Boards[0].Board
+		}
+
+		next := result.Boards[0].ItemsPage
+		items = append(items, next.Items...)
+		cursor = next.Cursor
+		done := cursor == "" || len(next.Items) < p.pageSize
+		if p.client.progress != nil {
+			p.client.progress(PageProgress{
+				Operation: p.operation,
+				BoardID:   p.boardID,
+				Page:      page,
+				Items:     len(items),
+				Total:     p.total,
+				PageSize:  p.pageSize,
+				Done:      done,
+			})
+		}
+		if done {
+			return board, items, nil
+		}
+		page++
+		logger.Info("fetching next page", "operation", p.operation, "items", len(items))
+	}
+}
+
+// shrink halves the page size when err says the page was too expensive or took too long,
+// and reports whether the page should be requested again
+func (p *itemsPaginator) shrink(err error) bool {
+	var netErr net.Error
+	tooLarge := errors.Is(err, ErrComplexityBudget) || (errors.As(err, &netErr) && netErr.Timeout())
+	if !tooLarge || p.pageSize <= minPageSize {
+		return false
+	}
+	p.pageSize = max(p.pageSize/2, minPageSize)
+	logger.Warn("page rejected, retrying with smaller pages", "operation", p.operation, "page_size", p.pageSize, "error", err)
+	return true
+}
+
+// partial wraps err in a PartialResultError once items were fetched, so they are not lost
+func (p *itemsPaginator) partial(items []Item, cursor string, err error) error {
+	if len(items) == 0 && cursor == p.cursor {
+		return err
+	}
+	return &PartialResultError{
+		Operation: p.operation,
+		BoardID:   p.boardID,
+		Items:     items,
+		Cursor:    cursor,
+		Err:       err,
+		columnIDs: p.columnIDs,
+	}
+}
+
+// ResumeBoardItems continues a GetBoardItems or search call that failed with a PartialResultError and
+// returns the tasks of all items, the ones fetched before the failure included. When it fails
+// again the error is a PartialResultError with all items fetched so far.
+func (c *Client) ResumeBoardItems(partial *PartialResultError) ([]Task, []Item, error) {
+	p := c.newItemsPaginator(partial.Operation, partial.BoardID, DefaultPageSize, itemFragment(columnValueFragment))
+	p.cursor = partial.Cursor
+	p.columnIDs = partial.columnIDs
+	items := append([]Item{}, partial.Items...)
+	_, rest, err := p.fetch()
+	var again *PartialResultError
+	switch {
+	case errors.As(err, &again):
+		again.Items = append(items, again.Items...)
+		return nil, nil, again
+	case err != nil:
+		return nil, nil, &PartialResultError{Operation: partial.Operation, BoardID: partial.BoardID, Items: items, Cursor: partial.Cursor, Err: err, columnIDs: partial.columnIDs}
+	}
+	items = append(items, rest...)
+	return boardItemTasks(items), items, nil
+}
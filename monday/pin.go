@@ -0,0 +1,50 @@
+package monday
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PinTask pins a task of a board, keeping it at the top of the task list. It returns false
+// when the task was pinned already.
+func (ds *DataStore) PinTask(boardID, taskID string) (bool, error) {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return false, fmt.Errorf("board %s %w in cache", boardID, ErrNotFound)
+	}
+	if slices.Contains(cache.Pinned, taskID) {
+		return false, nil
+	}
+	cache.Pinned = append(cache.Pinned, taskID)
+	ds.cache[boardID] = cache
+	return true, ds.Save()
+}
+
+// UnpinTask removes a task from the pinned tasks of a board. It returns false when the task
+// was not pinned.
+func (ds *DataStore) UnpinTask(boardID, taskID string) (bool, error) {
+	cache, exists := ds.cache[boardID]
+	if !exists {
+		return false, fmt.Errorf("board %s %w in cache", boardID, ErrNotFound)
+	}
+	index := slices.Index(cache.Pinned, taskID)
+	if index < 0 {
+		return false, nil
+	}
+	cache.Pinned = slices.Delete(cache.Pinned, index, index+1)
+	ds.cache[boardID] = cache
+	return true, ds.Save()
+}
+
+// GetPinnedTasks returns the cached tasks pinned on a board in the order they were pinned.
+// Pinned tasks missing from the cache, e.g. deleted ones, are skipped.
+func (ds *DataStore) GetPinnedTasks(boardID string) []Task {
+	cache := ds.cache[boardID]
+	var tasks []Task
+	for _, taskID := range cache.Pinned {
+		if task, ok := cache.Tasks[taskID]; ok {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
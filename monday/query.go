@@ -0,0 +1,115 @@
+package monday
+
+import "strings"
+
+// field is a GraphQL selection with optional arguments and sub-selections
+type field struct {
+	name   string
+	args   string
+	fields []field
+}
+
+// newField creates a selection, the sub-selections may be built from fragments
+func newField(name string, fields ...[]field) field {
+	f := field{name: name}
+	for _, fragment := range fields {
+		f.fields = append(f.fields, fragment...)
+	}
+	return f
+}
+
+// withArgs returns a copy of the field with arguments, e.g. "ids: [$boardId]"
+func (f field) withArgs(args string) field {
+	f.args = args
+	return f
+}
+
+// scalars creates selections for fields without sub-selections
+func scalars(names ...string) []field {
+	fields := make([]field, len(names))
+	for i, name := range names {
+		fields[i] = field{name: name}
+	}
+	return fields
+}
+
+// write formats the field with one selection per line
+func (f field) write(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("\t", depth))
+	b.WriteString(f.name)
+	if f.args != "" {
+		b.WriteString("(" + f.args + ")")
+	}
+	if len(f.fields) > 0 {
+		b.WriteString(" {\n")
+		for _, child := range f.fields {
+			child.write(b, depth+1)
+		}
+		b.WriteString(strings.Repeat("\t", depth) + "}")
+	}
+	b.WriteString("\n")
+}
+
+// buildOperation formats a query or mutation, vars is the variable list, e.g. "$boardId: ID!"
+func buildOperation(kind, name, vars string, fields ...field) string {
+	root := newField(kind + " " + name).withArgs(vars)
+	root.fields = fields
+	var b strings.Builder
+	root.write(&b, 0)
+	return b.String()
+}
+
+// buildQuery formats a query operation
+func buildQuery(name, vars string, fields ...field) string {
+	return buildOperation("query", name, vars, fields...)
+}
+
+// Shared fragments, so queries returning the same objects select the same fields
+
+// mirrorValueFragment selects the text of mirror columns, which have no value or text of their own
+var mirrorValueFragment = newField("... on MirrorValue", scalars("display_value"), []field{newField("column", scalars("title"))})
+
+// columnValueFragment selects what the parsers need from a column value, the type picks the
+// ColumnTypeHandler
+var columnValueFragment = append(scalars("id", "type", "text", "value"), mirrorValueFragment)
+
+// userFragment selects the user fields shown by the CLI
+var userFragment = append(scalars("id", "name", "email", "title", "photo_small", "enabled"), newField("teams", scalars("id", "name")))
+
+// itemFragment selects an item with its column values
+func itemFragment(columnValues []field) []field {
+	return []field{
+		{name: "id"},
+		{name: "name"},
+		newField("column_values", columnValues),
+		{name: "created_at"},
+		{name: "updated_at"},
+		newField("creator", scalars("id", "name")),
+	}
+}
+
+// withColumnIDs returns a copy of item fields whose column_values only select the columns in
+// $columnIds, a [String!] variable the query has to declare
+func withColumnIDs(itemFields []field) []field {
+	fields := make([]field, len(itemFields))
+	for i, f := range itemFields {
+		if f.name == "column_values" {
+			f = f.withArgs("ids: $columnIds")
+		}
+		fields[i] = f
+	}
+	return fields
+}
+
+// itemsPageFragment selects a page of items and the cursor for the next page
+func itemsPageFragment(args string, columnValues []field) field {
+	return newField("items_page",
+		[]field{newField("items", itemFragment(columnValues))},
+		scalars("cursor"),
+	).withArgs(args)
+}
+
+// boardByID selects the board with the ID in $boardId
+func boardByID(fields ...[]field) field {
+	return newField("boards", fields...).withArgs("ids: [$boardId]")
+}
@@ -0,0 +1,125 @@
+package monday
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuickTask is a task described in one line of text with inline tokens, e.g.
+// "Fix login crash !high #bug @alice ^Sprint 12 due:friday". Labels are kept as written,
+// mapping aliases and validating them against the board is left to the caller.
+type QuickTask struct {
+	Name     string
+	Priority string    // After !
+	Type     string    // After #
+	Assignee string    // After @, a name, user ID, "me" or "none"
+	Sprint   string    // After ^, the longest run of words naming a known sprint
+	DueDate  time.Time // After due:, midnight of the day in the location of now
+}
+
+// weekdays maps the names accepted in due: to their day
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// ParseQuickTask splits the inline tokens off a task description, the remaining words are
+// the name. Sprint names may have spaces, so ^ takes the longest run of words equal to one of
+// sprintNames, or a single word when none matches. A backslash keeps a word starting with a
+// token character in the name, e.g. \#123. Every token may be given once.
+func ParseQuickTask(text string, sprintNames []string, now time.Time) (QuickTask, error) {
+	var task QuickTask
+	var name []string
+	words := strings.Fields(text)
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if strings.HasPrefix(word, `\`) {
+			name = append(name, word[1:])
+			continue
+		}
+
+		var field *string
+		var label, value string
+		switch {
+		case len(word) > 1 && word[0] == '!':
+			field, label, value = &task.Priority, "priority", word[1:]
+		case len(word) > 1 && word[0] == '#':
+			field, label, value = &task.Type, "type", word[1:]
+		case len(word) > 1 && word[0] == '@':
+			field, label, value = &task.Assignee, "assignee", word[1:]
+		case len(word) > 1 && word[0] == '^':
+			n := sprintWords(words[i:], sprintNames)
+			field, label, value = &task.Sprint, "sprint", strings.Join(words[i:i+n], " ")[1:]
+			i += n - 1
+		case strings.HasPrefix(strings.ToLower(word), "due:"):
+			if !task.DueDate.IsZero() {
+				return QuickTask{}, fmt.Errorf("due date given twice")
+			}
+			due, err := ParseDueDate(word[len("due:"):], now)
+			if err != nil {
+				return QuickTask{}, err
+			}
+			task.DueDate = due
+			continue
+		default:
+			name = append(name, word)
+			continue
+		}
+		if *field != "" {
+			return QuickTask{}, fmt.Errorf("%s given twice: %s and %s", label, *field, value)
+		}
+		*field = value
+	}
+
+	task.Name = strings.Join(name, " ")
+	if task.Name == "" {
+		return QuickTask{}, fmt.Errorf("the task has no name, only tokens")
+	}
+	return task, nil
+}
+
+// sprintWords returns how many words starting with a ^ word name a known sprint, at least one
+func sprintWords(words []string, sprintNames []string) int {
+	longest := 1
+	for n := 2; n <= len(words); n++ {
+		candidate := strings.Join(words[:n], " ")[1:]
+		for _, sprintName := range sprintNames {
+			if strings.EqualFold(candidate, sprintName) {
+				longest = n
+			}
+		}
+	}
+	return longest
+}
+
+// ParseDueDate parses a due date as YYYY-MM-DD, today, tomorrow, a weekday name like friday
+// or fri (the next one, today included), or a number of days or weeks from now like 3d or 2w.
+// The date is midnight of the day in the location of now.
+func ParseDueDate(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(value)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch value {
+	case "":
+		return time.Time{}, fmt.Errorf("empty due date")
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+	if weekday, ok := weekdays[value]; ok {
+		return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7), nil
+	}
+	if date, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return date, nil
+	}
+	if duration, err := ParseRelativeDuration(value); err == nil && duration%(24*time.Hour) == 0 {
+		return today.AddDate(0, 0, int(duration/(24*time.Hour))), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid due date %q, use YYYY-MM-DD, today, tomorrow, a weekday or 3d", value)
+}
@@ -0,0 +1,120 @@
+package monday
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// quickNow is a Wednesday afternoon, away from UTC so dates must stay in its location
+var quickNow = time.Date(2026, 10, 14, 15, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+// quickDay returns midnight of a day of October 2026 in the location of quickNow
+func quickDay(day int) time.Time {
+	return time.Date(2026, 10, day, 0, 0, 0, 0, quickNow.Location())
+}
+
+func TestParseQuickTask(t *testing.T) {
+	sprints := []string{"Sprint 12", "Sprint 12 Hotfix", "Q4"}
+	tests := []struct {
+		name string
+		text string
+		want QuickTask
+	}{
+		{"name only", "Fix login crash", QuickTask{Name: "Fix login crash"}},
+		{"priority", "Fix login !high", QuickTask{Name: "Fix login", Priority: "high"}},
+		{"type", "#bug Fix login", QuickTask{Name: "Fix login", Type: "bug"}},
+		{"assignee", "Fix @alice login", QuickTask{Name: "Fix login", Assignee: "alice"}},
+		{"assignee me", "Fix login @me", QuickTask{Name: "Fix login", Assignee: "me"}},
+		{"sprint one word", "Fix login ^Q4", QuickTask{Name: "Fix login", Sprint: "Q4"}},
+		{"sprint unknown", "Fix login ^Next", QuickTask{Name: "Fix login", Sprint: "Next"}},
+		{"sprint several words", "Fix login ^Sprint 12 now", QuickTask{Name: "Fix login now", Sprint: "Sprint 12"}},
+		{"sprint longest match", "Fix ^sprint 12 hotfix login", QuickTask{Name: "Fix login", Sprint: "sprint 12 hotfix"}},
+		{"due date", "Fix login due:2026-10-20", QuickTask{Name: "Fix login", DueDate: quickDay(20)}},
+		{"due weekday", "Fix login DUE:Friday", QuickTask{Name: "Fix login", DueDate: quickDay(16)}},
+		{"all tokens", "Fix login crash !high #bug @alice ^Sprint 12 due:fri", QuickTask{
+			Name: "Fix login crash", Priority: "high", Type: "bug", Assignee: "alice", Sprint: "Sprint 12", DueDate: quickDay(16),
+		}},
+		{"escaped tokens", `Close \#123 for \@team`, QuickTask{Name: "Close #123 for @team"}},
+		{"escaped backslash", `Fix \\n parsing`, QuickTask{Name: `Fix \n parsing`}},
+		{"lone token characters", "Fix ! and # and @", QuickTask{Name: "Fix ! and # and @"}},
+		{"extra spaces", "  Fix   login  !low ", QuickTask{Name: "Fix login", Priority: "low"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuickTask(tt.text, sprints, quickNow)
+			if err != nil {
+				t.Fatalf("ParseQuickTask(%q) error: %v", tt.text, err)
+			}
+			if got.Name != tt.want.Name || got.Priority != tt.want.Priority || got.Type != tt.want.Type ||
+				got.Assignee != tt.want.Assignee || got.Sprint != tt.want.Sprint || !got.DueDate.Equal(tt.want.DueDate) {
+				t.Errorf("ParseQuickTask(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuickTaskErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"priority twice", "Fix login !high !low", "priority given twice"},
+		{"type twice", "Fix #bug login #feature", "type given twice"},
+		{"assignee twice", "@alice Fix @bob", "assignee given twice"},
+		{"sprint twice", "Fix ^Q4 ^Q1", "sprint given twice"},
+		{"due twice", "Fix due:today due:tomorrow", "due date given twice"},
+		{"invalid due date", "Fix due:someday", "invalid due date"},
+		{"empty due date", "Fix due:", "empty due date"},
+		{"tokens only", "!high #bug @alice", "no name"},
+		{"empty", "   ", "no name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseQuickTask(tt.text, nil, quickNow)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseQuickTask(%q) error = %v, want it to contain %q", tt.text, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"today", quickDay(14)},
+		{"Tomorrow", quickDay(15)},
+		{"wednesday", quickDay(14)}, // The weekday of now is today
+		{"wed", quickDay(14)},
+		{"thu", quickDay(15)},
+		{"friday", quickDay(16)},
+		{"sunday", quickDay(18)},
+		{"mon", quickDay(19)},
+		{"tuesday", quickDay(20)},
+		{"0d", quickDay(14)},
+		{"3d", quickDay(17)},
+		{"2w", quickDay(28)},
+		{"48h", quickDay(16)},
+		{"2026-10-31", quickDay(31)},
+		{"2026-11-02", time.Date(2026, 11, 2, 0, 0, 0, 0, quickNow.Location())},
+	}
+	for _, tt := range tests {
+		got, err := ParseDueDate(tt.value, quickNow)
+		if err != nil {
+			t.Errorf("ParseDueDate(%q) error: %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) || got.Location() != quickNow.Location() {
+			t.Errorf("ParseDueDate(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "someday", "fridays", "12h", "-3d", "3x", "2026-13-01", "14/10/2026"} {
+		if got, err := ParseDueDate(value, quickNow); err == nil {
+			t.Errorf("ParseDueDate(%q) = %v, want an error", value, got)
+		}
+	}
+}
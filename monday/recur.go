@@ -0,0 +1,299 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurringTask creates a task from a template every time its schedule is due, see recur run
+type RecurringTask struct {
+	Schedule string       `json:"schedule"` // Cron expression, e.g. "0 9 * * 1" for Mondays at 9:00
+	BoardID  string       `json:"board_id"`
+	Template TaskTemplate `json:"template"`
+	Since    time.Time    `json:"since"` // Occurrences before the task was added are never created
+}
+
+// RecurringTasks maps the names of recurring tasks to their schedule and template
+type RecurringTasks map[string]RecurringTask
+
+// TaskTemplate is the task created by a recurring task. The name may contain {date} and {week},
+// replaced with the date and ISO week of the occurrence.
+type TaskTemplate struct {
+	Name       string `json:"name"`
+	Status     string `json:"status,omitempty"`
+	Priority   string `json:"priority,omitempty"`
+	Type       string `json:"type,omitempty"`
+	AssigneeID string `json:"assignee_id,omitempty"`
+	DueDays    int    `json:"due_days,omitempty"` // Due date this many days after the occurrence, none when 0
+}
+
+// TaskName returns the name of the task created for an occurrence
+func (t TaskTemplate) TaskName(occurrence time.Time) string {
+	year, week := occurrence.ISOWeek()
+	return strings.NewReplacer(
+		"{date}", occurrence.Format("2006-01-02"),
+		"{week}", fmt.Sprintf("%d-W%02d", year, week),
+	).Replace(t.Name)
+}
+
+// Details returns the column values of the task created for an occurrence
+func (t TaskTemplate) Details(occurrence time.Time) TaskDetails {
+	details := TaskDetails{
+		Status:     t.Status,
+		Priority:   t.Priority,
+		Type:       t.Type,
+		AssigneeID: t.AssigneeID,
+	}
+	if t.DueDays > 0 {
+		details.DueDate = occurrence.AddDate(0, 0, t.DueDays)
+	}
+	return details
+}
+
+// SetRecurringTask adds or replaces a recurring task
+func (c *Config) SetRecurringTask(name string, task RecurringTask) {
+	if c.Recurring == nil {
+		c.Recurring = make(RecurringTasks)
+	}
+	c.Recurring[name] = task
+}
+
+// DeleteRecurringTask removes a recurring task
+func (c *Config) DeleteRecurringTask(name string) error {
+	if _, ok := c.Recurring[name]; !ok {
+		return fmt.Errorf("recurring task %s %w", name, ErrNotFound)
+	}
+	delete(c.Recurring, name)
+	return nil
+}
+
+// RecurringTaskNames returns the names of all recurring tasks in alphabetical order
+func (c *Config) RecurringTaskNames() []string {
+	names := make([]string, 0, len(c.Recurring))
+	for name := range c.Recurring {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Schedule is a parsed cron expression with the fields minute, hour, day of month, month and
+// day of week. Each field is *, a number, a range a-b, a list a,b or a step */n or a-b/n.
+type Schedule struct {
+	minutes, hours, days, months, weekdays []bool
+
+	anyDay, anyWeekday bool // Restricted days of month and week match either one, like cron
+}
+
+// scheduleShortcuts are the named schedules accepted instead of a cron expression
+var scheduleShortcuts = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@weekdays": "0 0 * * 1-5",
+	"@weekly":   "0 0 * * 1",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+}
+
+// ParseSchedule parses a cron expression like "0 9 * * 1-5" or a shortcut like @weekly
+func ParseSchedule(expr string) (*Schedule, error) {
+	if shortcut, ok := scheduleShortcuts[strings.TrimSpace(expr)]; ok {
+		expr = shortcut
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q needs 5 fields (minute hour day month weekday)", expr)
+	}
+
+	s := &Schedule{}
+	var err error
+	for i, spec := range []struct {
+		field    *[]bool
+		min, max int
+		name     string
+	}{
+		{&s.minutes, 0, 59, "minute"},
+		{&s.hours, 0, 23, "hour"},
+		{&s.days, 1, 31, "day"},
+		{&s.months, 1, 12, "month"},
+		{&s.weekdays, 0, 7, "weekday"},
+	} {
+		if *spec.field, err = parseScheduleField(fields[i], spec.min, spec.max); err != nil {
+			return nil, fmt.Errorf("schedule %q has an invalid %s: %w", expr, spec.name, err)
+		}
+	}
+	s.weekdays[0] = s.weekdays[0] || s.weekdays[7] // 7 is Sunday as well
+	s.anyDay = fields[2] == "*"
+	s.anyWeekday = fields[4] == "*"
+	return s, nil
+}
+
+// parseScheduleField returns the values of a cron field matched, indexed by value
+func parseScheduleField(field string, min, max int) ([]bool, error) {
+	matches := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if before, after, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step %q", after)
+			}
+			rangePart, step = before, n
+		}
+
+		from, to := min, max
+		if rangePart != "*" {
+			low, high, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if from, err = strconv.Atoi(low); err != nil {
+				return nil, fmt.Errorf("invalid value %q", low)
+			}
+			to = from
+			if isRange {
+				if to, err = strconv.Atoi(high); err != nil {
+					return nil, fmt.Errorf("invalid value %q", high)
+				}
+			} else if step > 1 {
+				to = max // 5/15 means from 5 on in steps of 15
+			}
+		}
+		if from < min || to > max || from > to {
+			return nil, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+		for value := from; value <= to; value += step {
+			matches[value] = true
+		}
+	}
+	return matches, nil
+}
+
+// matchesDay reports whether the schedule runs on the day of t. When both the day of month
+// and the day of week are restricted, either one matching is enough.
+func (s *Schedule) matchesDay(t time.Time) bool {
+	day, weekday := s.days[t.Day()], s.weekdays[t.Weekday()]
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+// Next returns the first time the schedule is due after t, the zero time when it never is
+// within five years, e.g. for February 30
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !s.months[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !s.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Due returns the latest occurrence after last and no later than now, and how many earlier
+// occurrences were missed in between. Missed occurrences are not created, a machine that was
+// off for a week creates a daily task once. The occurrence is the zero time when none is due,
+// always when last is the zero time.
+func (s *Schedule) Due(last, now time.Time) (occurrence time.Time, missed int) {
+	if last.IsZero() {
+		// Without a start nothing was missed, walking from year 1 would never end
+		last = now
+	}
+	for next := s.Next(last); !next.IsZero() && !next.After(now); next = s.Next(next) {
+		if !occurrence.IsZero() {
+			missed++
+		}
+		occurrence = next
+	}
+	return occurrence, missed
+}
+
+// RecurRun is the last occurrence a recurring task was created for
+type RecurRun struct {
+	Occurrence time.Time `json:"occurrence"`
+	TaskID     string    `json:"task_id"`
+	RanAt      time.Time `json:"ran_at"`
+}
+
+// recurStateKey is the store metadata key of the last runs of recurring tasks
+const recurStateKey = "recur"
+
+// GetRecurRuns returns the last run of every recurring task by name
+func (ds *DataStore) GetRecurRuns() (map[string]RecurRun, error) {
+	runs := make(map[string]RecurRun)
+	data, err := ds.store.GetMeta(recurStateKey)
+	if err != nil {
+		return runs, fmt.Errorf("failed to read recurring task runs: %w", err)
+	}
+	if data == nil {
+		return runs, nil
+	}
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return make(map[string]RecurRun), fmt.Errorf("failed to unmarshal recurring task runs: %w", err)
+	}
+	return runs, nil
+}
+
+// LockRecurRuns keeps other processes from running recurring tasks until the returned function
+// is called, so overlapping runs do not both create a due task. Stores other than the file
+// store are not shared between processes and need no lock.
+func (ds *DataStore) LockRecurRuns() (func(), error) {
+	if fs, ok := ds.store.(*FileStore); ok {
+		return fs.Lock(recurStateKey)
+	}
+	return func() {}, nil
+}
+
+// StoreRecurRun records the occurrence a recurring task was created for, so it is not created
+// again by the next run
+func (ds *DataStore) StoreRecurRun(name string, run RecurRun) error {
+	runs, err := ds.GetRecurRuns()
+	if err != nil {
+		return err
+	}
+	runs[name] = run
+	return ds.putRecurRuns(runs)
+}
+
+// RemoveRecurRun forgets the last run of a recurring task that was removed
+func (ds *DataStore) RemoveRecurRun(name string) error {
+	runs, err := ds.GetRecurRuns()
+	if err != nil {
+		return err
+	}
+	if _, ok := runs[name]; !ok {
+		return nil
+	}
+	delete(runs, name)
+	return ds.putRecurRuns(runs)
+}
+
+func (ds *DataStore) putRecurRuns(runs map[string]RecurRun) error {
+	data, err := json.Marshal(runs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recurring task runs: %w", err)
+	}
+	if err := ds.store.PutMeta(recurStateKey, data); err != nil {
+		return fmt.Errorf("failed to write recurring task runs: %w", err)
+	}
+	return nil
+}
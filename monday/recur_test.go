@@ -0,0 +1,90 @@
+package monday
+
+import (
+	"testing"
+	"time"
+)
+
+// recurAt returns a time of October 2026 in UTC, the 14th is a Wednesday
+func recurAt(day, hour, minute int) time.Time {
+	return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"every minute", "* * * * *", recurAt(14, 10, 0), recurAt(14, 10, 1)},
+		{"strictly after", "*/15 * * * *", recurAt(14, 10, 15), recurAt(14, 10, 30)},
+		{"step", "*/15 * * * *", recurAt(14, 10, 7), recurAt(14, 10, 15)},
+		{"step from a value", "5/20 * * * *", recurAt(14, 10, 6), recurAt(14, 10, 25)},
+		{"range with step", "0 9-17/4 * * *", recurAt(14, 10, 0), recurAt(14, 13, 0)},
+		{"list", "0 0 1,15 * *", recurAt(14, 10, 0), recurAt(15, 0, 0)},
+		{"weekday range", "0 9 * * 1-5", recurAt(14, 10, 0), recurAt(15, 9, 0)},
+		{"weekday range skips weekend", "0 9 * * 1-5", recurAt(16, 10, 0), recurAt(19, 9, 0)},
+		{"7 is Sunday", "0 9 * * 7", recurAt(14, 10, 0), recurAt(18, 9, 0)},
+		{"0 is Sunday", "0 9 * * 0", recurAt(14, 10, 0), recurAt(18, 9, 0)},
+		{"day of month or weekday, weekday first", "0 9 20 * 5", recurAt(14, 10, 0), recurAt(16, 9, 0)},
+		{"day of month or weekday, day first", "0 9 15 * 1", recurAt(14, 10, 0), recurAt(15, 9, 0)},
+		{"day of month only", "0 9 20 * *", recurAt(14, 10, 0), recurAt(20, 9, 0)},
+		{"next year", "0 0 1 1 *", recurAt(14, 10, 0), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"weekly shortcut", "@weekly", recurAt(14, 10, 0), recurAt(19, 0, 0)},
+		{"never", "0 0 30 2 *", recurAt(14, 10, 0), time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q) error: %v", tt.expr, err)
+			}
+			if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("%q after %v = %v, want %v", tt.expr, tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"* * * * 8", "*/0 * * * *", "*/x * * * *", "5-1 * * * *", "a * * * *", "1-b * * * *", "@often",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("ParseSchedule(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	tests := []struct {
+		name       string
+		expr       string
+		last, now  time.Time
+		occurrence time.Time
+		missed     int
+	}{
+		{"one due", "0 9 * * *", recurAt(13, 9, 0), recurAt(14, 10, 0), recurAt(14, 9, 0), 0},
+		{"due at now", "0 9 * * *", recurAt(13, 9, 0), recurAt(14, 9, 0), recurAt(14, 9, 0), 0},
+		{"missed days", "0 9 * * *", recurAt(10, 9, 0), recurAt(14, 10, 0), recurAt(14, 9, 0), 3},
+		{"missed hours", "0 * * * *", recurAt(14, 10, 0), recurAt(14, 15, 30), recurAt(14, 15, 0), 4},
+		{"missed weekdays only", "0 9 * * 1-5", recurAt(9, 9, 0), recurAt(14, 10, 0), recurAt(14, 9, 0), 2},
+		{"already ran", "0 9 * * *", recurAt(14, 9, 0), recurAt(14, 10, 0), time.Time{}, 0},
+		{"not yet due", "0 9 * * *", recurAt(13, 9, 0), recurAt(14, 8, 59), time.Time{}, 0},
+		{"no start", "* * * * *", time.Time{}, recurAt(14, 10, 0), time.Time{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			occurrence, missed := schedule.Due(tt.last, tt.now)
+			if !occurrence.Equal(tt.occurrence) || missed != tt.missed {
+				t.Errorf("Due(%v, %v) = %v, %d missed, want %v, %d missed", tt.last, tt.now, occurrence, missed, tt.occurrence, tt.missed)
+			}
+		})
+	}
+}
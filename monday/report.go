@@ -0,0 +1,145 @@
+package monday
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// ReportFormat is the output format of a rendered report
+type ReportFormat string
+
+const (
+	ReportMarkdown ReportFormat = "md"
+	ReportHTML     ReportFormat = "html"
+)
+
+// ReportFormatFromPath picks the report format from a file extension, markdown by default
+func ReportFormatFromPath(path string) (ReportFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case "", ".md", ".markdown":
+		return ReportMarkdown, nil
+	case ".html", ".htm":
+		return ReportHTML, nil
+	default:
+		return "", fmt.Errorf("unsupported report format %s (use .md or .html)", filepath.Ext(path))
+	}
+}
+
+// reportFuncs are the helpers available in report templates
+var reportFuncs = map[string]interface{}{
+	"date": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Local().Format("2006-01-02")
+	},
+	"assignee": func(task Task) string {
+		if task.UserName == "" {
+			return "Unassigned"
+		}
+		return task.UserName
+	},
+}
+
+// reportTemplates holds the templates of each report by name and format
+var reportTemplates = map[string]map[ReportFormat]string{
+	"weekly": {
+		ReportMarkdown: weeklyMarkdownTemplate,
+		ReportHTML:     weeklyHTMLTemplate,
+	},
+}
+
+// RenderReport renders the named report template with the given data.
+// HTML reports go through html/template so task names are escaped.
+func RenderReport(w io.Writer, name string, format ReportFormat, data interface{}) error {
+	source, ok := reportTemplates[name][format]
+	if !ok {
+		return fmt.Errorf("report %s in format %s %w", name, format, ErrNotFound)
+	}
+	if format == ReportHTML {
+		tmpl, err := htmltemplate.New(name).Funcs(reportFuncs).Parse(source)
+		if err != nil {
+			return fmt.Errorf("failed to parse report template: %w", err)
+		}
+		return tmpl.Execute(w, data)
+	}
+	tmpl, err := template.New(name).Funcs(reportFuncs).Parse(source)
+	if err != nil {
+		return fmt.Errorf("failed to parse report template: %w", err)
+	}
+	return tmpl.Execute(w, data)
+}
+
+const weeklyMarkdownTemplate = `# Weekly digest: {{.Sprint}}
+
+{{if .Board}}Board: {{.Board}}
+{{end}}Period: {{date .From}} to {{date .To}}
+Tasks in sprint: {{.TotalTasks}}
+
+## Completed ({{len .Completed}})
+{{range .Completed}}
+- {{.Name}} ({{assignee .}}){{end}}{{if not .Completed}}
+_Nothing completed this week._{{end}}
+
+## Carried over ({{len .CarriedOver}})
+{{range .CarriedOver}}
+- {{.Name}} - {{if .Status}}{{.Status}}{{else}}No status{{end}} ({{assignee .}}){{end}}{{if not .CarriedOver}}
+_Nothing left open._{{end}}
+
+## New bugs ({{len .NewBugs}})
+{{range .NewBugs}}
+- {{.Name}} - {{if .Status}}{{.Status}}{{else}}No status{{end}}, created {{date .CreatedAt}}{{end}}{{if not .NewBugs}}
+_No new bugs._{{end}}
+
+## Per assignee
+
+| Assignee | Done | Open | Total |
+|---|---:|---:|---:|
+{{range .Assignees}}| {{.Assignee}} | {{.Done}} | {{.Open}} | {{.Total}} |
+{{end}}`
+
+const weeklyHTMLTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Weekly digest: {{.Sprint}}</title>
+<style>
+body { font-family: sans-serif; max-width: 50em; margin: 2em auto; color: #333; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.8em; text-align: left; }
+td.num { text-align: right; }
+.empty { color: #888; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>Weekly digest: {{.Sprint}}</h1>
+<p>{{if .Board}}Board: {{.Board}}<br>{{end}}Period: {{date .From}} to {{date .To}}<br>Tasks in sprint: {{.TotalTasks}}</p>
+
+<h2>Completed ({{len .Completed}})</h2>
+{{if .Completed}}<ul>
+{{range .Completed}}<li>{{.Name}} ({{assignee .}})</li>
+{{end}}</ul>{{else}}<p class="empty">Nothing completed this week.</p>{{end}}
+
+<h2>Carried over ({{len .CarriedOver}})</h2>
+{{if .CarriedOver}}<ul>
+{{range .CarriedOver}}<li>{{.Name}} - {{if .Status}}{{.Status}}{{else}}No status{{end}} ({{assignee .}})</li>
+{{end}}</ul>{{else}}<p class="empty">Nothing left open.</p>{{end}}
+
+<h2>New bugs ({{len .NewBugs}})</h2>
+{{if .NewBugs}}<ul>
+{{range .NewBugs}}<li>{{.Name}} - {{if .Status}}{{.Status}}{{else}}No status{{end}}, created {{date .CreatedAt}}</li>
+{{end}}</ul>{{else}}<p class="empty">No new bugs.</p>{{end}}
+
+<h2>Per assignee</h2>
+<table>
+<tr><th>Assignee</th><th>Done</th><th>Open</th><th>Total</th></tr>
+{{range .Assignees}}<tr><td>{{.Assignee}}</td><td class="num">{{.Done}}</td><td class="num">{{.Open}}</td><td class="num">{{.Total}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`
@@ -0,0 +1,87 @@
+package monday
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// NextSprint returns the sprint following current: the first one starting after it, or when
+// the sprints have no dates the one listed after it on the sprint board. Completed sprints
+// are skipped.
+func NextSprint(sprints []Sprint, current Sprint, now time.Time) (Sprint, bool) {
+	var candidates []Sprint
+	for _, sprint := range sprints {
+		if sprint.ID != current.ID && sprint.HasDates() && current.HasDates() && sprint.StartDate.After(current.StartDate) &&
+			sprint.StateAt(now) != SprintStateCompleted {
+			candidates = append(candidates, sprint)
+		}
+	}
+	if len(candidates) > 0 {
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].StartDate.Before(candidates[j].StartDate) })
+		return candidates[0], true
+	}
+	for i, sprint := range sprints {
+		if sprint.ID != current.ID {
+			continue
+		}
+		for _, next := range sprints[i+1:] {
+			if next.StateAt(now) != SprintStateCompleted {
+				return next, true
+			}
+		}
+	}
+	return Sprint{}, false
+}
+
+// UnfinishedTasks returns the tasks of a sprint that are neither done nor removed
+func UnfinishedTasks(tasks []Task, sprintName string) []Task {
+	var unfinished []Task
+	for _, task := range tasksInSprint(tasks, sprintName) {
+		if !IsDoneStatus(task.Status) && !strings.Contains(strings.ToLower(string(task.Status)), "removed") {
+			unfinished = append(unfinished, task)
+		}
+	}
+	return unfinished
+}
+
+// MoveTasksToSprint links tasks to another sprint through the sprint column of the board, a
+// batch of tasks per request. It returns the IDs of the moved tasks, on an error those of the
+// batches moved before.
+func (c *Client) MoveTasksToSprint(boardID string, taskIDs []string, sprintID string, batchSize int) ([]string, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	values, err := taskColumnValues(board, TaskDetails{SprintID: sprintID})
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]ItemUpdate, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		updates = append(updates, ItemUpdate{ItemID: taskID, Values: values})
+	}
+	return c.ChangeItems(boardID, updates, batchSize)
+}
+
+// SetTaskSprint links a task to a sprint through the sprint board_relation column, replacing
+// the linked sprint. An empty sprint ID unlinks the task.
+func (c *Client) SetTaskSprint(boardID string, task Task, sprintID string) (*Task, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	values, err := editColumnValues(board, TaskEdit{SprintID: &sprintID})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.changeColumnValues(boardID, task.ID, values); err != nil {
+		return nil, err
+	}
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
+	}
+	return updatedTask, nil
+}
@@ -0,0 +1,202 @@
+package monday
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SearchQuery is a parsed search like `login status:done assignee:me sprint:"Sprint 12"`.
+// Values are lower case, tasks match when every word and every qualifier matches,
+// repeating a qualifier matches any of its values.
+type SearchQuery struct {
+	Words      []string
+	Qualifiers map[string][]string
+}
+
+// searchQualifiers maps qualifier names and aliases to the task field they match
+var searchQualifiers = map[string]string{
+	"status":   "status",
+	"s":        "status",
+	"priority": "priority",
+	"p":        "priority",
+	"type":     "type",
+	"t":        "type",
+	"sprint":   "sprint",
+	"assignee": "assignee",
+	"owner":    "assignee",
+	"user":     "assignee",
+}
+
+// ParseSearchQuery splits a query into free-text words and field qualifiers.
+// Double quotes group words, e.g. sprint:"Sprint 12" or "login page".
+func ParseSearchQuery(query string) (SearchQuery, error) {
+	q := SearchQuery{Qualifiers: make(map[string][]string)}
+	for _, token := range tokenizeSearchQuery(query) {
+		key, value, found := strings.Cut(token, ":")
+		if !found || strings.Contains(key, " ") {
+			q.Words = append(q.Words, strings.ToLower(token))
+			continue
+		}
+		field, ok := searchQualifiers[strings.ToLower(key)]
+		if !ok {
+			return q, fmt.Errorf("unknown search field: %s (use status, priority, type, sprint or assignee)", key)
+		}
+		if value == "" {
+			return q, fmt.Errorf("missing value for %s:", key)
+		}
+		q.Qualifiers[field] = append(q.Qualifiers[field], strings.ToLower(value))
+	}
+	return q, nil
+}
+
+// ParseFilterExpression parses a filter like `status:ready for testing priority:high` where the
+// words after a qualifier belong to its value, so values with spaces need no quotes. Free text
+// words go before the first qualifier.
+func ParseFilterExpression(expr string) (SearchQuery, error) {
+	var terms []string
+	for _, token := range tokenizeSearchQuery(expr) {
+		inQualifier := len(terms) > 0 && strings.Contains(terms[len(terms)-1], ":")
+		if strings.Contains(token, ":") || !inQualifier {
+			terms = append(terms, token)
+			continue
+		}
+		terms[len(terms)-1] += " " + token
+	}
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = term
+		if key, value, found := strings.Cut(term, ":"); found && strings.Contains(value, " ") {
+			quoted[i] = key + ":\"" + value + "\""
+		}
+	}
+	return ParseSearchQuery(strings.Join(quoted, " "))
+}
+
+// tokenizeSearchQuery splits on spaces outside of double quotes and removes the quotes.
+// An unterminated quote runs to the end of the query, the shell may have eaten the closing one.
+func tokenizeSearchQuery(query string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ' ' && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// IsEmpty reports whether the query has neither words nor qualifiers
+func (q SearchQuery) IsEmpty() bool {
+	return len(q.Words) == 0 && len(q.Qualifiers) == 0
+}
+
+// ReplaceValue replaces a qualifier value, e.g. assignee "me" with the user's name
+func (q SearchQuery) ReplaceValue(field, value, replacement string) {
+	for i, v := range q.Qualifiers[field] {
+		if v == value {
+			q.Qualifiers[field][i] = strings.ToLower(replacement)
+		}
+	}
+}
+
+// Matches reports whether a task matches all words and qualifiers
+func (q SearchQuery) Matches(task Task) bool {
+	name := strings.ToLower(task.Name)
+	for _, word := range q.Words {
+		if !strings.Contains(name, word) {
+			return false
+		}
+	}
+	fields := map[string][]string{
+		"status":   {string(task.Status)},
+		"priority": {string(task.Priority)},
+		"type":     {string(task.Type)},
+		"sprint":   {task.Sprint},
+		"assignee": {task.UserName, task.UserEmail},
+	}
+	for field, values := range q.Qualifiers {
+		if !matchesAny(fields[field], values) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAny reports whether one of the field values contains one of the wanted values
+func matchesAny(fieldValues []string, wanted []string) bool {
+	for _, fieldValue := range fieldValues {
+		fieldValue = strings.ToLower(fieldValue)
+		for _, value := range wanted {
+			if strings.Contains(fieldValue, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SearchTasks returns the tasks matching the query
+func SearchTasks(tasks []Task, q SearchQuery) []Task {
+	var matches []Task
+	for _, task := range tasks {
+		if q.Matches(task) {
+			matches = append(matches, task)
+		}
+	}
+	return matches
+}
+
+// searchPageSize is the default page size of searches, their results are usually small
+const searchPageSize = 100
+
+// SearchBoardItems searches item names on the server with items_page query_params.
+// Only the free text is sent, qualifiers have to be applied to the result with SearchTasks.
+func (c *Client) SearchBoardItems(boardID, text string) ([]Task, []Item, error) {
+	var queryParams map[string]interface{}
+	if text != "" {
+		queryParams = map[string]interface{}{
+			"rules": []map[string]interface{}{{
+				"column_id":     "name",
+				"compare_value": []string{text},
+				"operator":      "contains_text",
+			}},
+		}
+	}
+	return c.queryBoardItems("SearchBoardItems", boardID, queryParams)
+}
+
+// queryBoardItems returns all items of a board matching the query_params, following the cursor
+// through every page. Without query_params all items are returned.
+func (c *Client) queryBoardItems(operation, boardID string, queryParams map[string]interface{}) ([]Task, []Item, error) {
+	p := c.newItemsPaginator(operation, boardID, searchPageSize, itemFragment(columnValueFragment))
+	p.columnIDs = c.columnIDs
+	p.queryParams = queryParams
+	_, items, err := p.fetch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks := make([]Task, 0, len(items))
+	for _, item := range items {
+		tasks = append(tasks, parseBoardItem(item))
+	}
+	return tasks, items, nil
+}
+
+// itemsPage is a page of items as returned by items_page and next_items_page
+type itemsPage struct {
+	Items  []Item `json:"items"`
+	Cursor string `json:"cursor"`
+}
@@ -16,6 +16,7 @@ func NewBoardService(client *Client) *BoardService {
 
 // GetBoardByID retrieves a specific board with all its data
 func (bs *BoardService) GetBoardByID(boardID string) (*Board, error) {
+	logger.Debug("getting board", "board", boardID)
 	board, err := bs.client.GetBoard(boardID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get board %s: %w", boardID, err)
@@ -23,3 +24,53 @@ func (bs *BoardService) GetBoardByID(boardID string) (*Board, error) {
 
 	return board, nil
 }
+
+// ListBoards retrieves the active boards of all workspaces, without subitem boards and docs
+func (bs *BoardService) ListBoards() ([]Board, error) {
+	boards, err := bs.client.ListBoards()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list boards: %w", err)
+	}
+	var regular []Board
+	for _, board := range boards {
+		if board.Type == "" || board.Type == "board" {
+			regular = append(regular, board)
+		}
+	}
+	return regular, nil
+}
+
+// WorkspaceService handles workspace-related operations
+type WorkspaceService struct {
+	client *Client
+}
+
+// NewWorkspaceService creates a new workspace service
+func NewWorkspaceService(client *Client) *WorkspaceService {
+	return &WorkspaceService{client: client}
+}
+
+// ListWorkspaces retrieves all workspaces the user can access
+func (ws *WorkspaceService) ListWorkspaces() ([]Workspace, error) {
+	workspaces, err := ws.client.GetWorkspaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	return workspaces, nil
+}
+
+// GetWorkspaceBoards retrieves the active boards of a workspace, without subitem boards and docs
+func (ws *WorkspaceService) GetWorkspaceBoards(workspaceID string) ([]Board, error) {
+	logger.Debug("getting workspace boards", "workspace", workspaceID)
+	boards, err := ws.client.GetWorkspaceBoards(workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get boards of workspace %s: %w", workspaceID, err)
+	}
+	var regular []Board
+	for _, board := range boards {
+		if board.Type == "" || board.Type == "board" {
+			regular = append(regular, board)
+		}
+	}
+	return regular, nil
+}
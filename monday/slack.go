@@ -0,0 +1,253 @@
+package monday
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Slack limits of a message posted to an incoming webhook
+const (
+	slackMaxBlocks      = 50
+	slackMaxSectionText = 3000
+	slackMaxHeaderText  = 150
+)
+
+// SlackTemplateDefault is the name of the template used when no other one is picked
+const SlackTemplateDefault = "default"
+
+// SlackTemplate formats a report posted to Slack with text/template. Title is the header of
+// the message and gets the SlackReport, Task is the line of each task in Slack mrkdwn and gets
+// a SlackTask. Empty fields use the default template.
+type SlackTemplate struct {
+	Title string `json:"title,omitempty"`
+	Task  string `json:"task,omitempty"`
+}
+
+// DefaultSlackTemplate is the template of reports unless configured otherwise
+var DefaultSlackTemplate = SlackTemplate{
+	Title: "{{.Title}}{{if .Board}} · {{.Board}}{{end}}",
+	Task:  "• {{if .URL}}<{{.URL}}|{{escape .Name}}>{{else}}{{escape .Name}}{{end}}{{if .Priority}} · {{.Priority}}{{end}} · {{assignee .Task}}",
+}
+
+// withDefaults fills the empty fields from the default template
+func (t SlackTemplate) withDefaults() SlackTemplate {
+	if t.Title == "" {
+		t.Title = DefaultSlackTemplate.Title
+	}
+	if t.Task == "" {
+		t.Task = DefaultSlackTemplate.Task
+	}
+	return t
+}
+
+// SlackTemplates maps template names to templates
+type SlackTemplates map[string]SlackTemplate
+
+// SetSlackTemplate saves a template for 'report slack', replacing one with the same name
+func (c *Config) SetSlackTemplate(name string, tmpl SlackTemplate) {
+	if c.SlackTemplates == nil {
+		c.SlackTemplates = make(SlackTemplates)
+	}
+	c.SlackTemplates[name] = tmpl
+}
+
+// GetSlackTemplate returns the template with the given name and whether it is configured,
+// the default template otherwise
+func (c *Config) GetSlackTemplate(name string) (SlackTemplate, bool) {
+	if tmpl, ok := c.SlackTemplates[name]; ok {
+		return tmpl.withDefaults(), true
+	}
+	if tmpl, ok := c.SlackTemplates[SlackTemplateDefault]; ok {
+		return tmpl.withDefaults(), false
+	}
+	return DefaultSlackTemplate, false
+}
+
+// SetSlackWebhook sets the incoming webhook 'report slack' posts to, empty to remove it
+func (c *Config) SetSlackWebhook(url string) {
+	c.SlackWebhook = url
+}
+
+// GetSlackWebhook returns the incoming webhook 'report slack' posts to
+func (c *Config) GetSlackWebhook() string {
+	return c.SlackWebhook
+}
+
+// SlackReport is a task list posted to Slack, grouped by status
+type SlackReport struct {
+	Title       string // Name of the view, or Tasks
+	Board       string
+	Date        time.Time
+	Tasks       []Task // Sorted with the status first, see SortOrder.GroupBy
+	AccountSlug string // Builds the task links, tasks are not linked without it
+	BoardID     string
+}
+
+// SlackTask is a task as seen by the Task template
+type SlackTask struct {
+	Task
+	URL string // Web URL of the task, empty without the account slug
+}
+
+// Active returns the number of tasks that are not done
+func (r SlackReport) Active() int {
+	active := 0
+	for _, task := range r.Tasks {
+		if IsActiveStatus(task.Status) {
+			active++
+		}
+	}
+	return active
+}
+
+// Points returns the estimate of the tasks
+func (r SlackReport) Points() float64 {
+	return TotalEstimate(r.Tasks)
+}
+
+// SlackMessage is the body posted to a Slack incoming webhook. Text is shown in notifications
+// and by clients without block support.
+type SlackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a Block Kit block, a header, section or context
+type SlackBlock struct {
+	Type     string      `json:"type"`
+	Text     *SlackText  `json:"text,omitempty"`
+	Elements []SlackText `json:"elements,omitempty"`
+}
+
+// SlackText is a Block Kit text object
+type SlackText struct {
+	Type string `json:"type"` // plain_text or mrkdwn
+	Text string `json:"text"`
+}
+
+// slackEscape escapes the characters Slack reads as markup in mrkdwn text
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// slackFuncs are the helpers of Slack templates, the report helpers and escape
+var slackFuncs = func() template.FuncMap {
+	funcs := template.FuncMap{"escape": slackEscape}
+	for name, fn := range reportFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}()
+
+// Message renders the report as Block Kit blocks: a header, a context line with the counts and
+// a section per status. Sections over Slack's size limits are split, and tasks beyond the block
+// limit are left out with a note of how many.
+func (r SlackReport) Message(tmpl SlackTemplate) (SlackMessage, error) {
+	tmpl = tmpl.withDefaults()
+	titleTemplate, err := template.New("title").Funcs(slackFuncs).Parse(tmpl.Title)
+	if err != nil {
+		return SlackMessage{}, fmt.Errorf("failed to parse title template: %w", err)
+	}
+	taskTemplate, err := template.New("task").Funcs(slackFuncs).Parse(tmpl.Task)
+	if err != nil {
+		return SlackMessage{}, fmt.Errorf("failed to parse task template: %w", err)
+	}
+	var title bytes.Buffer
+	if err := titleTemplate.Execute(&title, r); err != nil {
+		return SlackMessage{}, fmt.Errorf("failed to render title: %w", err)
+	}
+	header := strings.TrimSpace(title.String())
+	if runes := []rune(header); len(runes) > slackMaxHeaderText {
+		header = string(runes[:slackMaxHeaderText-1]) + "…"
+	}
+
+	summary := fmt.Sprintf("%d tasks, %d active", len(r.Tasks), r.Active())
+	if points := r.Points(); points > 0 {
+		summary += fmt.Sprintf(", %s pts", FormatPoints(points))
+	}
+	summary += " · " + r.Date.Local().Format("Mon Jan 2")
+	message := SlackMessage{
+		Text: header + ": " + summary,
+		Blocks: []SlackBlock{
+			{Type: "header", Text: &SlackText{Type: "plain_text", Text: header}},
+			{Type: "context", Elements: []SlackText{{Type: "mrkdwn", Text: summary}}},
+		},
+	}
+
+	// One section per status, split before it gets too long for Slack
+	var section strings.Builder
+	status, omitted := Status(""), 0
+	flush := func() {
+		if section.Len() > 0 {
+			message.Blocks = append(message.Blocks, SlackBlock{Type: "section", Text: &SlackText{Type: "mrkdwn", Text: section.String()}})
+			section.Reset()
+		}
+	}
+	for i, task := range r.Tasks {
+		// Room is kept for the section being written and the note of omitted tasks
+		if len(message.Blocks) >= slackMaxBlocks-2 {
+			omitted = len(r.Tasks) - i
+			break
+		}
+		var line bytes.Buffer
+		data := SlackTask{Task: task}
+		if r.AccountSlug != "" {
+			data.URL = ItemURL(r.AccountSlug, r.BoardID, task.ID)
+		}
+		if err := taskTemplate.Execute(&line, data); err != nil {
+			return SlackMessage{}, fmt.Errorf("failed to render task %s: %w", task.Name, err)
+		}
+		if i == 0 || task.Status != status {
+			flush()
+			status = task.Status
+			label := string(status)
+			if label == "" {
+				label = "No status"
+			}
+			section.WriteString("*" + slackEscape(label) + "*")
+		}
+		if section.Len()+line.Len()+1 > slackMaxSectionText {
+			flush()
+		}
+		if section.Len() > 0 {
+			section.WriteString("\n")
+		}
+		section.Write(line.Bytes())
+	}
+	flush()
+	if omitted > 0 {
+		message.Blocks = append(message.Blocks, SlackBlock{Type: "context", Elements: []SlackText{{Type: "mrkdwn", Text: fmt.Sprintf("…and %d more tasks", omitted)}}})
+	}
+	return message, nil
+}
+
+// PostSlackMessage posts a message to a Slack incoming webhook
+func PostSlackMessage(ctx context.Context, transport Transport, webhookURL string, message SlackMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode Slack message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create Slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := transport.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to Slack: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// Slack answers errors with a short text such as invalid_payload or no_service
+		text, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("slack answered %d: %s", resp.StatusCode, strings.TrimSpace(string(text)))
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// snapshotVersion is the format version written to snapshot files
+const snapshotVersion = 1
+
+// snapshotPageSize is the number of items requested per page for a snapshot
+const snapshotPageSize = 100
+
+// readOnlyColumnTypes are column types whose values are computed by Monday and cannot be written
+var readOnlyColumnTypes = map[string]bool{
+	"auto_number":   true,
+	"button":        true,
+	"creation_log":  true,
+	"doc":           true,
+	"file":          true,
+	"formula":       true,
+	"integration":   true,
+	"item_id":       true,
+	"last_updated":  true,
+	"lookup":        true,
+	"mirror":        true,
+	"name":          true,
+	"progress":      true,
+	"subtasks":      true,
+	"time_tracking": true,
+	"vote":          true,
+}
+
+// BoardSnapshot is a backup of a board: its columns, groups and all items with full column values
+type BoardSnapshot struct {
+	Version int       `json:"version"`
+	TakenAt time.Time `json:"taken_at"`
+	Board   Board     `json:"board"`
+	Items   []Item    `json:"items"`
+}
+
+// GetBoardSnapshot downloads a board with its groups and all items including column types and values
+func (c *Client) GetBoardSnapshot(boardID string) (*BoardSnapshot, error) {
+	itemFields := append(itemFragment(columnValueFragment), newField("group", scalars("id", "title")))
+	p := c.newItemsPaginator("GetBoardSnapshot", boardID, snapshotPageSize, itemFields)
+	p.boardFields = append(scalars("id", "name", "description", "state", "updated_at"),
+		newField("columns", scalars("id", "title", "type", "settings_str")),
+		newField("groups", scalars("id", "title")),
+	)
+	board, items, err := p.fetch()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("took board snapshot", "board", boardID, "items", len(items))
+	return &BoardSnapshot{Version: snapshotVersion, TakenAt: time.Now().UTC(), Board: *board, Items: items}, nil
+}
+
+// SaveSnapshot writes a snapshot as indented JSON
+func SaveSnapshot(path string, snapshot *BoardSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by SaveSnapshot
+func LoadSnapshot(path string) (*BoardSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	var snapshot BoardSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", path, err)
+	}
+	if snapshot.Version == 0 || snapshot.Version > snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d in %s", snapshot.Version, path)
+	}
+	return &snapshot, nil
+}
+
+// RestoreItem is a snapshot item to create on, or update in, the target board
+type RestoreItem struct {
+	Source   Item
+	TargetID string // Existing item on the target board, empty when the item is created
+	GroupID  string
+	Values   ColumnValues
+	Changed  []string // Titles of the changed columns of an existing item
+}
+
+// RestorePlan lists the changes that make a board match a snapshot
+type RestorePlan struct {
+	Create         []RestoreItem
+	Update         []RestoreItem
+	Unchanged      int
+	SkippedColumns []string // Snapshot columns that have no writable column on the target board
+}
+
+// PlanRestore compares a snapshot with the items of the target board. Items are matched by ID when
+// the snapshot was taken of the target board, otherwise by name. Columns are matched by ID, then
+// by title and type, so a snapshot can also be restored to a duplicated board.
+func PlanRestore(snapshot *BoardSnapshot, target *Board, targetItems []Item) *RestorePlan {
+	plan := &RestorePlan{}
+	columns := make(map[string]Column) // Snapshot column ID to target column
+	for _, column := range snapshot.Board.Columns {
+		if readOnlyColumnTypes[column.Type] {
+			continue
+		}
+		if match, ok := matchColumn(column, target.Columns); ok {
+			columns[column.ID] = match
+		} else {
+			plan.SkippedColumns = append(plan.SkippedColumns, column.Title)
+		}
+	}
+
+	sameBoard := snapshot.Board.ID == target.ID
+	byID := make(map[string]Item)
+	byName := make(map[string][]Item)
+	for _, item := range targetItems {
+		byID[item.ID] = item
+		key := strings.ToLower(strings.TrimSpace(item.Name))
+		byName[key] = append(byName[key], item)
+	}
+	used := make(map[string]bool)
+
+	for _, source := range snapshot.Items {
+		var existing *Item
+		if item, ok := byID[source.ID]; ok && sameBoard {
+			existing = &item
+		} else {
+			for _, item := range byName[strings.ToLower(strings.TrimSpace(source.Name))] {
+				if !used[item.ID] {
+					existing = &item
+					break
+				}
+			}
+		}
+
+		restore := RestoreItem{Source: source, GroupID: matchGroup(source.Group, target.Groups), Values: ColumnValues{}}
+		if existing == nil {
+			for _, cv := range source.ColumnValues {
+				column, ok := columns[cv.ID]
+				if value, set := writableValue(column, cv); ok && set {
+					restore.Values[column.ID] = value
+				}
+			}
+			plan.Create = append(plan.Create, restore)
+			continue
+		}
+
+		used[existing.ID] = true
+		restore.TargetID = existing.ID
+		current := make(map[string]ColumnValue)
+		for _, cv := range existing.ColumnValues {
+			current[cv.ID] = cv
+		}
+		if existing.Name != source.Name {
+			restore.Values["name"] = source.Name
+			restore.Changed = append(restore.Changed, "Name")
+		}
+		for _, cv := range source.ColumnValues {
+			column, ok := columns[cv.ID]
+			if !ok || current[column.ID].Text == cv.Text {
+				continue
+			}
+			if value, set := writableValue(column, cv); set {
+				restore.Values[column.ID] = value
+			} else {
+				restore.Values[column.ID] = map[string]interface{}{} // An empty object clears a column
+			}
+			restore.Changed = append(restore.Changed, column.Title)
+		}
+		if len(restore.Changed) == 0 {
+			plan.Unchanged++
+			continue
+		}
+		plan.Update = append(plan.Update, restore)
+	}
+	return plan
+}
+
+// ApplyRestore creates and updates the items of a restore plan. It returns how many items were
+// created and updated before an error.
+func (c *Client) ApplyRestore(boardID string, plan *RestorePlan, batchSize int) (created, updated int, err error) {
+	items := make([]ItemInput, 0, len(plan.Create))
+	for _, restore := range plan.Create {
+		items = append(items, ItemInput{Name: restore.Source.Name, Values: restore.Values, GroupID: restore.GroupID})
+	}
+	ids, err := c.CreateItems(boardID, items, batchSize)
+	if err != nil {
+		return len(ids), 0, err
+	}
+	for _, restore := range plan.Update {
+		if err := c.changeColumnValues(boardID, restore.TargetID, restore.Values); err != nil {
+			return len(ids), updated, fmt.Errorf("failed to update %s: %w", restore.Source.Name, err)
+		}
+		updated++
+	}
+	return len(ids), updated, nil
+}
+
+// matchColumn finds the target column with the same ID and type, or else the same title and type
+func matchColumn(column Column, targets []Column) (Column, bool) {
+	for _, target := range targets {
+		if target.ID == column.ID && target.Type == column.Type {
+			return target, true
+		}
+	}
+	for _, target := range targets {
+		if strings.EqualFold(target.Title, column.Title) && target.Type == column.Type {
+			return target, true
+		}
+	}
+	return Column{}, false
+}
+
+// matchGroup finds the target group by ID, then by title; empty means the board's top group
+func matchGroup(group *Group, targets []Group) string {
+	if group == nil {
+		return ""
+	}
+	for _, target := range targets {
+		if target.ID == group.ID {
+			return target.ID
+		}
+	}
+	for _, target := range targets {
+		if strings.EqualFold(target.Title, group.Title) {
+			return target.ID
+		}
+	}
+	return ""
+}
+
+// writableValue converts a column value as read from the API into the value to write.
+// Status labels are written by text so they survive boards with other label indexes,
+// connected items are turned from linkedPulseIds into item_ids. It reports false for empty values.
+func writableValue(column Column, cv ColumnValue) (interface{}, bool) {
+	raw := cv.Value
+	var inner string
+	if json.Unmarshal(raw, &inner) == nil {
+		raw = json.RawMessage(inner) // The API sends values as JSON encoded strings
+	}
+	if len(raw) == 0 || string(raw) == "null" || string(raw) == "{}" {
+		return nil, false
+	}
+
+	switch column.Type {
+	case "status", "color":
+		if cv.Text == "" {
+			return nil, false
+		}
+		return map[string]string{"label": cv.Text}, true
+	case "board_relation", "dependency":
+		var linked struct {
+			LinkedPulseIDs []struct {
+				LinkedPulseID int64 `json:"linkedPulseId"`
+			} `json:"linkedPulseIds"`
+		}
+		if json.Unmarshal(raw, &linked) != nil || len(linked.LinkedPulseIDs) == 0 {
+			return nil, false
+		}
+		ids := make([]int64, 0, len(linked.LinkedPulseIDs))
+		for _, pulse := range linked.LinkedPulseIDs {
+			ids = append(ids, pulse.LinkedPulseID)
+		}
+		return map[string][]int64{"item_ids": ids}, true
+	case "text":
+		if unquoted, err := strconv.Unquote(string(raw)); err == nil {
+			return unquoted, unquoted != ""
+		}
+	}
+	return raw, true
+}
@@ -0,0 +1,213 @@
+package monday
+
+import (
+	"sort"
+	"time"
+)
+
+// ItemChange is the difference of a single item between two states of a board
+type ItemChange struct {
+	Kind   ChangeKind
+	ID     string
+	Name   string
+	Fields []FieldChange
+}
+
+// BoardDiff is the difference between an older and a newer state of a board, added items
+// first, then modified and removed ones
+type BoardDiff struct {
+	From    time.Time
+	To      time.Time
+	Changes []ItemChange
+}
+
+// Count returns the number of items changed in the given way
+func (d *BoardDiff) Count(kind ChangeKind) int {
+	count := 0
+	for _, change := range d.Changes {
+		if change.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// DiffSnapshots compares two snapshots of a board. Items are matched by ID and compared by
+// name, group and the text of every column; columns are named by their title in the newer snapshot.
+func DiffSnapshots(older, newer *BoardSnapshot) *BoardDiff {
+	diff := &BoardDiff{From: older.TakenAt, To: newer.TakenAt}
+	titles := make(map[string]string)
+	for _, board := range []Board{older.Board, newer.Board} {
+		for _, column := range board.Columns {
+			titles[column.ID] = column.Title
+		}
+	}
+
+	olderItems := make(map[string]Item)
+	for _, item := range older.Items {
+		olderItems[item.ID] = item
+	}
+	seen := make(map[string]bool)
+	var added, modified, removed []ItemChange
+	for _, item := range newer.Items {
+		seen[item.ID] = true
+		previous, ok := olderItems[item.ID]
+		if !ok {
+			added = append(added, ItemChange{Kind: ChangeAdded, ID: item.ID, Name: item.Name})
+			continue
+		}
+		if fields := diffItem(previous, item, titles); len(fields) > 0 {
+			modified = append(modified, ItemChange{Kind: ChangeModified, ID: item.ID, Name: item.Name, Fields: fields})
+		}
+	}
+	for _, item := range older.Items {
+		if !seen[item.ID] {
+			removed = append(removed, ItemChange{Kind: ChangeRemoved, ID: item.ID, Name: item.Name})
+		}
+	}
+	diff.Changes = append(append(added, modified...), removed...)
+	return diff
+}
+
+// diffItem compares the name, group and column texts of two states of an item
+func diffItem(older, newer Item, titles map[string]string) []FieldChange {
+	var changes []FieldChange
+	if older.Name != newer.Name {
+		changes = append(changes, FieldChange{Field: "Name", Old: older.Name, New: newer.Name})
+	}
+	if from, to := groupTitle(older.Group), groupTitle(newer.Group); from != to {
+		changes = append(changes, FieldChange{Field: "Group", Old: from, New: to})
+	}
+
+	texts := make(map[string]string)
+	for _, cv := range older.ColumnValues {
+		texts[cv.ID] = cv.Text
+	}
+	for _, cv := range newer.ColumnValues {
+		if from, ok := texts[cv.ID]; (ok || cv.Text != "") && from != cv.Text {
+			changes = append(changes, FieldChange{Field: columnTitle(cv.ID, titles), Old: from, New: cv.Text})
+		}
+		delete(texts, cv.ID)
+	}
+	// Columns only in the older snapshot were deleted from the board
+	for _, id := range sortedStringKeys(texts) {
+		if texts[id] != "" {
+			changes = append(changes, FieldChange{Field: columnTitle(id, titles), Old: texts[id]})
+		}
+	}
+	return changes
+}
+
+// DiffActivity builds the difference between a board at since and now from its activity log.
+// Changes of the same field are collapsed to the first old and the last new value, fields
+// changed back to their old value are left out.
+func DiffActivity(events []ActivityEvent, since, now time.Time) *BoardDiff {
+	diff := &BoardDiff{From: since, To: now}
+	created := make(map[string]bool)
+	deleted := make(map[string]bool)
+	names := make(map[string]string)
+	changes := make(map[string][]FieldChange)
+	var order []string
+
+	record := func(itemID, field, from, to string) {
+		if _, ok := changes[itemID]; !ok {
+			order = append(order, itemID)
+		}
+		for i := range changes[itemID] {
+			if changes[itemID][i].Field == field {
+				changes[itemID][i].New = to
+				return
+			}
+		}
+		changes[itemID] = append(changes[itemID], FieldChange{Field: field, Old: from, New: to})
+	}
+
+	for _, event := range events {
+		info := event.Info()
+		if info.ItemID == "" {
+			continue
+		}
+		if info.ItemName != "" {
+			names[info.ItemID] = info.ItemName
+		}
+		switch event := event.(type) {
+		case ItemCreated:
+			created[info.ItemID] = true
+		case ItemDeleted:
+			deleted[info.ItemID] = true
+		case ItemRenamed:
+			record(info.ItemID, "Name", event.From, event.To)
+			names[info.ItemID] = event.To
+		case ItemMovedToGroup:
+			record(info.ItemID, "Group", event.FromGroup, event.ToGroup)
+		case ColumnValueChanged:
+			title := event.ColumnTitle
+			if title == "" {
+				title = event.ColumnID
+			}
+			record(info.ItemID, title, event.From, event.To)
+		}
+	}
+
+	for _, id := range sortedBoolKeys(created) {
+		if !deleted[id] {
+			diff.Changes = append(diff.Changes, ItemChange{Kind: ChangeAdded, ID: id, Name: names[id]})
+		}
+	}
+	for _, id := range order {
+		if created[id] || deleted[id] {
+			continue
+		}
+		var fields []FieldChange
+		for _, change := range changes[id] {
+			if change.Old != change.New {
+				fields = append(fields, change)
+			}
+		}
+		if len(fields) > 0 {
+			diff.Changes = append(diff.Changes, ItemChange{Kind: ChangeModified, ID: id, Name: names[id], Fields: fields})
+		}
+	}
+	for _, id := range sortedBoolKeys(deleted) {
+		if !created[id] {
+			diff.Changes = append(diff.Changes, ItemChange{Kind: ChangeRemoved, ID: id, Name: names[id]})
+		}
+	}
+	return diff
+}
+
+// groupTitle returns the title of a group, empty when the group was not selected
+func groupTitle(group *Group) string {
+	if group == nil {
+		return ""
+	}
+	return group.Title
+}
+
+// columnTitle returns the title of a column, or its ID when the column is unknown
+func columnTitle(id string, titles map[string]string) string {
+	if title, ok := titles[id]; ok && title != "" {
+		return title
+	}
+	return id
+}
+
+// sortedStringKeys returns the keys of a map in order
+func sortedStringKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedBoolKeys returns the keys of a set in order
+func sortedBoolKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
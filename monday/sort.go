@@ -0,0 +1,152 @@
+package monday
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SortOrder is a list of fields to sort tasks by, later fields break ties of earlier ones
+type SortOrder []string
+
+// DefaultSortOrder groups tasks by status, then orders them by priority and type
+var DefaultSortOrder = SortOrder{"status", "priority", "type"}
+
+// sortFields maps sort field names and aliases to the field they sort by
+var sortFields = map[string]string{
+	"name":       "name",
+	"updated_at": "updated_at",
+	"updated":    "updated_at",
+	"priority":   "priority",
+	"status":     "status",
+	"sprint":     "sprint",
+	"assignee":   "assignee",
+	"owner":      "assignee",
+	"user":       "assignee",
+	"type":       "type",
+}
+
+// ParseSortOrder parses a comma separated list like "priority,updated_at".
+// An empty list returns DefaultSortOrder.
+func ParseSortOrder(spec string) (SortOrder, error) {
+	if strings.TrimSpace(spec) == "" {
+		return DefaultSortOrder, nil
+	}
+	var order SortOrder
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		field, ok := sortFields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field: %s (use name, updated_at, priority, status, sprint, assignee or type)", name)
+		}
+		order = append(order, field)
+	}
+	if len(order) == 0 {
+		return DefaultSortOrder, nil
+	}
+	return order, nil
+}
+
+// GroupsByStatus reports whether tasks sorted in this order are grouped by status
+func (o SortOrder) GroupsByStatus() bool {
+	return len(o) > 0 && o[0] == "status"
+}
+
+// groupFields are the sort fields tasks can also be grouped by
+var groupFields = []string{"status", "priority", "type", "sprint", "assignee"}
+
+// ParseGroupBy parses the field to group tasks by, like "assignee" or its alias "owner".
+// "none" returns an empty field, tasks are not grouped.
+func ParseGroupBy(spec string) (string, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if spec == "none" {
+		return "", nil
+	}
+	if field, ok := sortFields[spec]; ok && slices.Contains(groupFields, field) {
+		return field, nil
+	}
+	return "", fmt.Errorf("unknown group field: %s (use status, priority, type, sprint, assignee or none)", spec)
+}
+
+// GroupBy returns the order with field first, so tasks sorted by it are grouped by the field
+// and keep the rest of the order within each group
+func (o SortOrder) GroupBy(field string) SortOrder {
+	if field == "" || (len(o) > 0 && o[0] == field) {
+		return o
+	}
+	order := SortOrder{field}
+	for _, f := range o {
+		if f != field {
+			order = append(order, f)
+		}
+	}
+	return order
+}
+
+// GroupKey returns the value of the field a task is grouped by, empty when it is not set
+func GroupKey(task Task, field string) string {
+	switch field {
+	case "status":
+		return string(task.Status)
+	case "priority":
+		return string(task.Priority)
+	case "type":
+		return string(task.Type)
+	case "sprint":
+		return task.Sprint
+	case "assignee":
+		return task.UserName
+	}
+	return ""
+}
+
+// String returns the order as accepted by ParseSortOrder
+func (o SortOrder) String() string {
+	return strings.Join(o, ",")
+}
+
+// SortTasks sorts tasks in place by the given order and returns them. Names, sprints and
+// assignees sort alphabetically, status, priority and type in workflow order and
+// updated_at most recent first. Remaining ties keep the local index order.
+func SortTasks(tasks []Task, order SortOrder, reverse bool) []Task {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		for _, field := range order {
+			if c := compareTasks(tasks[i], tasks[j], field); c != 0 {
+				if reverse {
+					return c > 0
+				}
+				return c < 0
+			}
+		}
+		if reverse {
+			return tasks[i].LocalId > tasks[j].LocalId
+		}
+		return tasks[i].LocalId < tasks[j].LocalId
+	})
+	return tasks
+}
+
+// compareTasks compares two tasks by a single field
+func compareTasks(a, b Task, field string) int {
+	switch field {
+	case "name":
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case "updated_at":
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	case "priority":
+		return getSortablePriority(a) - getSortablePriority(b)
+	case "status":
+		return getSortableStatus(a) - getSortableStatus(b)
+	case "type":
+		return getSortableType(a) - getSortableType(b)
+	case "sprint":
+		return strings.Compare(strings.ToLower(a.Sprint), strings.ToLower(b.Sprint))
+	case "assignee":
+		return strings.Compare(strings.ToLower(a.UserName), strings.ToLower(b.UserName))
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+package monday
+
+import (
+	"strings"
+	"time"
+)
+
+// SprintProgress is how far a sprint is in working days, tasks and story points
+type SprintProgress struct {
+	Sprint     Sprint
+	Day        int // Working day of the sprint, 0 before it starts or without dates
+	Days       int // Working days of the sprint
+	Done       int
+	Open       int // Tasks neither done nor removed
+	DonePoints float64
+	Points     float64 // Points of the done and open tasks
+}
+
+// NewSprintProgress counts the done and open tasks of a sprint and their points. The tasks
+// are matched to the sprint by name, removed ones do not count.
+func NewSprintProgress(sprint Sprint, tasks []Task, now time.Time) SprintProgress {
+	progress := SprintProgress{Sprint: sprint}
+	progress.Day, progress.Days = sprint.DayAt(now)
+	for _, task := range tasksInSprint(tasks, sprint.Name) {
+		switch {
+		case IsDoneStatus(task.Status):
+			progress.Done++
+			progress.DonePoints += task.Estimate
+		case strings.Contains(strings.ToLower(string(task.Status)), "removed"):
+			continue
+		default:
+			progress.Open++
+		}
+		progress.Points += task.Estimate
+	}
+	return progress
+}
+
+// DaysLeft returns the working days after today until the sprint ends
+func (p SprintProgress) DaysLeft() int {
+	return p.Days - p.Day
+}
+
+// DoneShare returns the share of the work done from 0 to 1, by points when the tasks are
+// estimated and by task count otherwise
+func (p SprintProgress) DoneShare() float64 {
+	if p.Points > 0 {
+		return p.DonePoints / p.Points
+	}
+	if total := p.Done + p.Open; total > 0 {
+		return float64(p.Done) / float64(total)
+	}
+	return 0
+}
+
+// TimeShare returns the share of the working days passed from 0 to 1, 0 without dates
+func (p SprintProgress) TimeShare() float64 {
+	if p.Days == 0 {
+		return 0
+	}
+	return float64(p.Day) / float64(p.Days)
+}
+
+// SprintProgress computes the progress of a sprint from the cached tasks of a board
+func (as *AnalyticsService) SprintProgress(boardID string, sprint Sprint, now time.Time) (SprintProgress, error) {
+	tasks, err := as.SprintTasks(boardID, sprint.Name)
+	if err != nil {
+		return SprintProgress{}, err
+	}
+	return NewSprintProgress(sprint, tasks, now), nil
+}
@@ -0,0 +1,142 @@
+package monday
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// StatusAgeDefault is the status of the limit used for statuses without their own limit
+const StatusAgeDefault = "default"
+
+// DefaultStatusAgeLimit is the limit of statuses without their own limit unless configured
+var DefaultStatusAgeLimit = StatusAgeLimit{Warn: 3, Alert: 7}
+
+// StatusAgeLimit is the number of days in a status after which listings show the time in the
+// status as a warning and as an alert, 0 turns a level off
+type StatusAgeLimit struct {
+	Warn  int `json:"warn,omitempty"`
+	Alert int `json:"alert,omitempty"`
+}
+
+// StatusAgeLevel is how long a task has been in its status compared to the limit
+type StatusAgeLevel int
+
+const (
+	StatusAgeOK StatusAgeLevel = iota
+	StatusAgeWarn
+	StatusAgeAlert
+)
+
+// Level returns the level of a time in status
+func (l StatusAgeLimit) Level(age time.Duration) StatusAgeLevel {
+	days := int(age / (24 * time.Hour))
+	switch {
+	case l.Alert > 0 && days >= l.Alert:
+		return StatusAgeAlert
+	case l.Warn > 0 && days >= l.Warn:
+		return StatusAgeWarn
+	}
+	return StatusAgeOK
+}
+
+// StatusAgeLimits maps lower case status labels, or StatusAgeDefault, to their limits
+type StatusAgeLimits map[string]StatusAgeLimit
+
+// For returns the limit of a status, the default limit when it has none
+func (l StatusAgeLimits) For(status Status) StatusAgeLimit {
+	if limit, ok := l[strings.ToLower(string(status))]; ok {
+		return limit
+	}
+	if limit, ok := l[StatusAgeDefault]; ok {
+		return limit
+	}
+	return DefaultStatusAgeLimit
+}
+
+// Statuses returns the statuses with a limit, sorted with the default first
+func (l StatusAgeLimits) Statuses() []string {
+	statuses := make([]string, 0, len(l))
+	for status := range l {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if (statuses[i] == StatusAgeDefault) != (statuses[j] == StatusAgeDefault) {
+			return statuses[i] == StatusAgeDefault
+		}
+		return statuses[i] < statuses[j]
+	})
+	return statuses
+}
+
+// SetStatusAgeLimit sets the limit of a status, a limit without levels never warns
+func (c *Config) SetStatusAgeLimit(status string, limit StatusAgeLimit) {
+	if c.StatusAgeLimits == nil {
+		c.StatusAgeLimits = make(StatusAgeLimits)
+	}
+	c.StatusAgeLimits[strings.ToLower(status)] = limit
+}
+
+// RemoveStatusAgeLimit removes the limit of a status, so the default limit applies
+func (c *Config) RemoveStatusAgeLimit(status string) {
+	delete(c.StatusAgeLimits, strings.ToLower(status))
+}
+
+// GetStatusAgeLimits returns the configured limits of the time in status
+func (c *Config) GetStatusAgeLimits() StatusAgeLimits {
+	return c.StatusAgeLimits
+}
+
+// TimeInStatus returns how long a task has been in its current status at now. It is false
+// when the time the task entered its status is unknown, see SetStatusSince.
+func (t Task) TimeInStatus(now time.Time) (time.Duration, bool) {
+	if t.StatusSince.IsZero() {
+		return 0, false
+	}
+	return max(now.Sub(t.StatusSince), 0), true
+}
+
+// SetStatusSince sets when each task entered its current status from the activity events of
+// the board since the given time, oldest first. Tasks created since then without a status
+// change entered it when created, for the others it is only known to be before since.
+func SetStatusSince(tasks []Task, events []ActivityEvent, since time.Time) {
+	entered := make(map[string]ColumnValueChanged)
+	for _, event := range events {
+		change, ok := event.(ColumnValueChanged)
+		if !ok || !strings.Contains(strings.ToLower(change.ColumnID), "status") || change.From == change.To {
+			continue
+		}
+		entered[change.ItemID] = change
+	}
+	for i := range tasks {
+		task := &tasks[i]
+		change, changed := entered[task.ID]
+		switch {
+		case changed && strings.EqualFold(change.To, string(task.Status)):
+			task.StatusSince, task.SinceBefore = change.At, false
+		case changed:
+			// The log and the item disagree, e.g. the status changed while fetching
+			task.StatusSince, task.SinceBefore = time.Time{}, false
+		case task.CreatedAt.After(since):
+			task.StatusSince, task.SinceBefore = task.CreatedAt, false
+		default:
+			task.StatusSince, task.SinceBefore = since, true
+		}
+	}
+}
+
+// carryStatusSince keeps when a task entered its status when the cached task is replaced. The
+// cached time is kept while the status is the same and it tells more, a status that changed
+// without a known time is taken as changed now.
+func carryStatusSince(cached, task Task, now time.Time) Task {
+	if cached.Status != task.Status {
+		if task.StatusSince.IsZero() {
+			task.StatusSince, task.SinceBefore = now, false
+		}
+		return task
+	}
+	if !cached.StatusSince.IsZero() && (task.StatusSince.IsZero() || task.SinceBefore && cached.StatusSince.Before(task.StatusSince)) {
+		task.StatusSince, task.SinceBefore = cached.StatusSince, cached.SinceBefore
+	}
+	return task
+}
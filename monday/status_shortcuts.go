@@ -0,0 +1,46 @@
+package monday
+
+import "slices"
+
+// Status shortcuts, the task commands that move a task to a status in one step
+const (
+	ShortcutDone  = "done"
+	ShortcutStart = "start"
+	ShortcutBlock = "block"
+)
+
+// StatusShortcuts maps shortcut names to the status labels of the board
+type StatusShortcuts map[string]string
+
+// DefaultStatusShortcuts are the status labels of the shortcuts unless configured otherwise
+var DefaultStatusShortcuts = StatusShortcuts{
+	ShortcutDone:  "Done",
+	ShortcutStart: "In Progress",
+	ShortcutBlock: "Stuck",
+}
+
+// StatusShortcutNames returns the shortcut names in the order they are shown
+func StatusShortcutNames() []string {
+	return []string{ShortcutStart, ShortcutBlock, ShortcutDone}
+}
+
+// IsStatusShortcut reports whether name is one of the status shortcuts
+func IsStatusShortcut(name string) bool {
+	return slices.Contains(StatusShortcutNames(), name)
+}
+
+// SetStatusShortcut sets the status label a shortcut moves tasks to
+func (c *Config) SetStatusShortcut(name, label string) {
+	if c.Shortcuts == nil {
+		c.Shortcuts = make(StatusShortcuts)
+	}
+	c.Shortcuts[name] = label
+}
+
+// GetStatusShortcut returns the status label of a shortcut, or its default label
+func (c *Config) GetStatusShortcut(name string) string {
+	if label := c.Shortcuts[name]; label != "" {
+		return label
+	}
+	return DefaultStatusShortcuts[name]
+}
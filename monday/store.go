@@ -0,0 +1,326 @@
+package monday
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Store persists the cache of a DataStore: the board caches with their tasks, users and
+// sprints, and metadata such as the user directory stored as opaque blobs by key.
+type Store interface {
+	// LoadBoards returns all cached boards, an empty map when nothing is cached yet.
+	// Backends keeping the boards encoded use DecodeCache, which migrates older caches.
+	LoadBoards() (map[string]TaskCache, error)
+	// SaveBoards replaces the cached boards
+	SaveBoards(boards map[string]TaskCache) error
+	// GetMeta returns the metadata stored under key, nil when there is none
+	GetMeta(key string) ([]byte, error)
+	// PutMeta stores metadata under key
+	PutMeta(key string, data []byte) error
+}
+
+// StoreFactory opens a store of a backend at a location, e.g. a directory or a URL.
+// An empty location selects the default location of the backend.
+type StoreFactory func(location string) (Store, error)
+
+// Store backends available out of the box
+const (
+	StoreBackendFile   = "file"
+	StoreBackendMemory = "memory"
+)
+
+var (
+	storeBackends = map[string]StoreFactory{
+		StoreBackendFile: func(location string) (Store, error) {
+			if location == "" {
+				return DefaultFileStore()
+			}
+			return NewFileStore(location), nil
+		},
+		StoreBackendMemory: func(string) (Store, error) { return NewMemoryStore(), nil },
+	}
+	defaultStore Store
+	storeMu      sync.Mutex
+)
+
+// RegisterStoreBackend makes a backend available to OpenStore and the cache_backend setting
+func RegisterStoreBackend(name string, factory StoreFactory) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	storeBackends[name] = factory
+}
+
+// StoreBackends returns the names of the registered backends
+func StoreBackends() []string {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	names := make([]string, 0, len(storeBackends))
+	for name := range storeBackends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OpenStore opens a store of a registered backend, the file backend when backend is empty
+func OpenStore(backend, location string) (Store, error) {
+	if backend == "" {
+		backend = StoreBackendFile
+	}
+	storeMu.Lock()
+	factory, ok := storeBackends[backend]
+	storeMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown cache backend %q, available: %v", backend, StoreBackends())
+	}
+	return factory(location)
+}
+
+// SetDefaultStore makes NewDataStore use store, e.g. the backend selected in the configuration
+func SetDefaultStore(store Store) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	defaultStore = store
+}
+
+// getDefaultStore returns the store set with SetDefaultStore, or the cache files in the home directory
+func getDefaultStore() Store {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	if defaultStore == nil {
+		store, err := DefaultFileStore()
+		if err != nil {
+			logger.Warn("falling back to an in-memory cache", "error", err)
+			return NewMemoryStore()
+		}
+		defaultStore = store
+	}
+	return defaultStore
+}
+
+// FileStore keeps the cache in JSON files in a directory, the boards in tasks.json and
+// every metadata key in <key>.json. Files are replaced atomically and guarded by an advisory
+// lock on the .lock file, so concurrent CLI runs never read or leave a half written cache.
+type FileStore struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileStore creates a store writing its files to dir
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{dir: dir}
+}
+
+// DefaultFileStore returns the file store in ~/.cache/monday-cli
+func DefaultFileStore() (*FileStore, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return NewFileStore(filepath.Join(homeDir, ".cache", "monday-cli")), nil
+}
+
+// Dir returns the directory of the cache files
+func (fs *FileStore) Dir() string {
+	return fs.dir
+}
+
+func (fs *FileStore) boardsPath() string {
+	return filepath.Join(fs.dir, "tasks.json")
+}
+
+func (fs *FileStore) LoadBoards() (map[string]TaskCache, error) {
+	data, err := fs.read(fs.boardsPath())
+	if err != nil || data == nil {
+		return make(map[string]TaskCache), err
+	}
+	boards, err := DecodeCache(data)
+	if err != nil && !errors.Is(err, ErrCacheTooNew) {
+		// Keep the unreadable cache for inspection, it is replaced by the next save
+		backup := fmt.Sprintf("%s.corrupt-%d", fs.boardsPath(), time.Now().Unix())
+		if writeErr := fs.write(backup, data); writeErr == nil {
+			logger.Warn("unreadable cache backed up", "path", backup, "error", err)
+		}
+	}
+	if err != nil {
+		return make(map[string]TaskCache), err
+	}
+	logger.Debug("cache loaded", "path", fs.boardsPath(), "boards", len(boards))
+	return boards, nil
+}
+
+func (fs *FileStore) SaveBoards(boards map[string]TaskCache) error {
+	data, err := EncodeCache(boards)
+	if err != nil {
+		return err
+	}
+	if err := fs.write(fs.boardsPath(), data); err != nil {
+		return err
+	}
+	logger.Debug("cache saved", "path", fs.boardsPath(), "bytes", len(data))
+	return nil
+}
+
+func (fs *FileStore) GetMeta(key string) ([]byte, error) {
+	return fs.read(filepath.Join(fs.dir, key+".json"))
+}
+
+func (fs *FileStore) PutMeta(key string, data []byte) error {
+	return fs.write(filepath.Join(fs.dir, key+".json"), data)
+}
+
+// lock takes the in-process lock and the advisory lock of the cache directory shared by
+// all processes, it returns the function releasing both
+func (fs *FileStore) lock(exclusive bool) (func(), error) {
+	if exclusive {
+		fs.mu.Lock()
+	} else {
+		fs.mu.RLock()
+	}
+	unlock := func() {
+		if exclusive {
+			fs.mu.Unlock()
+		} else {
+			fs.mu.RUnlock()
+		}
+	}
+	if err := os.MkdirAll(fs.dir, 0755); err != nil {
+		unlock()
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Join(fs.dir, ".lock"), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		unlock()
+		return nil, fmt.Errorf("failed to open cache lock: %w", err)
+	}
+	if err := lockFile(file, exclusive); err != nil {
+		file.Close()
+		unlock()
+		return nil, fmt.Errorf("failed to lock cache: %w", err)
+	}
+	return func() {
+		unlockFile(file)
+		file.Close()
+		unlock()
+	}, nil
+}
+
+// Lock takes the exclusive advisory lock <name>.lock in the cache directory, for work spanning
+// several reads and writes that must not run twice at once. It blocks until the process
+// holding it calls the returned function.
+func (fs *FileStore) Lock(name string) (func(), error) {
+	if err := os.MkdirAll(fs.dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Join(fs.dir, name+".lock"), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s lock: %w", name, err)
+	}
+	if err := lockFile(file, true); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to lock %s: %w", name, err)
+	}
+	return func() {
+		unlockFile(file)
+		file.Close()
+	}, nil
+}
+
+// read returns the content of a cache file, nil when it does not exist yet
+func (fs *FileStore) read(path string) ([]byte, error) {
+	release, err := fs.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+	return data, nil
+}
+
+// write replaces a cache file by writing a temporary file next to it and renaming it,
+// so readers see either the old or the new content
+func (fs *FileStore) write(path string, data []byte) error {
+	release, err := fs.lock(true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	tmp, err := os.CreateTemp(fs.dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // Fails harmlessly once the file is renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace cache file: %w", err)
+	}
+	return nil
+}
+
+// MemoryStore keeps the cache in memory for the lifetime of the process, e.g. for tests.
+// Boards are stored encoded so data stores sharing it never share maps.
+type MemoryStore struct {
+	mu     sync.Mutex
+	boards []byte
+	meta   map[string][]byte
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{meta: make(map[string][]byte)}
+}
+
+func (ms *MemoryStore) LoadBoards() (map[string]TaskCache, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.boards == nil {
+		return make(map[string]TaskCache), nil
+	}
+	return DecodeCache(ms.boards)
+}
+
+func (ms *MemoryStore) SaveBoards(boards map[string]TaskCache) error {
+	data, err := EncodeCache(boards)
+	if err != nil {
+		return err
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.boards = data
+	return nil
+}
+
+func (ms *MemoryStore) GetMeta(key string) ([]byte, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return append([]byte(nil), ms.meta[key]...), nil
+}
+
+func (ms *MemoryStore) PutMeta(key string, data []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.meta[key] = append([]byte(nil), data...)
+	return nil
+}
@@ -0,0 +1,77 @@
+package monday
+
+import (
+	"slices"
+	"sort"
+)
+
+// TaskSummary counts a list of tasks without listing them
+type TaskSummary struct {
+	Total      int
+	Active     int
+	Points     float64
+	ByStatus   []FieldCount // In workflow order
+	ByPriority []FieldCount // Most urgent first
+	ByAssignee []FieldCount // Most tasks first, tasks with several people count for each
+}
+
+// FieldCount is the number of tasks and points with one value of a field, empty when unset
+type FieldCount struct {
+	Value  string
+	Count  int
+	Points float64
+}
+
+// SummarizeTasks counts tasks per status, priority and assignee
+func SummarizeTasks(tasks []Task) TaskSummary {
+	summary := TaskSummary{
+		Total:      len(tasks),
+		Points:     TotalEstimate(tasks),
+		ByStatus:   countByField(tasks, "status"),
+		ByPriority: countByField(tasks, "priority"),
+	}
+	byAssignee := make(map[string]*FieldCount)
+	for _, task := range tasks {
+		if IsActiveStatus(task.Status) {
+			summary.Active++
+		}
+		for _, name := range assigneeNames(task) {
+			count, ok := byAssignee[name]
+			if !ok {
+				count = &FieldCount{Value: name}
+				byAssignee[name] = count
+			}
+			count.Count++
+			count.Points += task.Estimate
+		}
+	}
+	for _, count := range byAssignee {
+		summary.ByAssignee = append(summary.ByAssignee, *count)
+	}
+	sort.Slice(summary.ByAssignee, func(i, j int) bool {
+		a, b := summary.ByAssignee[i], summary.ByAssignee[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Value < b.Value
+	})
+	return summary
+}
+
+// countByField counts tasks per value of a field, values in the order SortTasks sorts them
+func countByField(tasks []Task, field string) []FieldCount {
+	var counts []FieldCount
+	index := make(map[string]int)
+	for _, task := range SortTasks(slices.Clone(tasks), SortOrder{field}, false) {
+		key := GroupKey(task, field)
+		i, ok := index[key]
+		if !ok {
+			i = len(counts)
+			index[key] = i
+			counts = append(counts, FieldCount{Value: key})
+		}
+		counts[i].Count++
+		counts[i].Points += task.Estimate
+	}
+	return counts
+}
@@ -0,0 +1,142 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagsValue is the value of a tags column
+type TagsValue struct {
+	TagIDs []int64 `json:"tag_ids"`
+}
+
+// isTagsColumn reports whether a column ID looks like a tags column
+func isTagsColumn(columnID string) bool {
+	columnID = strings.ToLower(columnID)
+	return strings.HasPrefix(columnID, "tags") || strings.Contains(columnID, "_tags")
+}
+
+// parseTagsColumn returns the tag names of a tags column, the text lists them comma separated
+func parseTagsColumn(cv ColumnValue) []string {
+	var tags []string
+	for _, name := range strings.Split(cv.Text, ",") {
+		if name = strings.TrimSpace(name); name != "" && !slices.Contains(tags, name) {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// ApplyTagChanges applies a change list like "+backend,-urgent" to the current tags.
+// Names without a sign are added, tags are compared ignoring case.
+func ApplyTagChanges(current []string, changes string) ([]string, error) {
+	tags := slices.Clone(current)
+	for _, change := range strings.Split(changes, ",") {
+		change = strings.TrimSpace(change)
+		if change == "" {
+			continue
+		}
+		remove := strings.HasPrefix(change, "-")
+		name := strings.TrimSpace(strings.TrimLeft(change, "+-"))
+		if name == "" {
+			return nil, fmt.Errorf("invalid tag change %q", change)
+		}
+		index := slices.IndexFunc(tags, func(tag string) bool { return strings.EqualFold(tag, name) })
+		switch {
+		case remove && index >= 0:
+			tags = slices.Delete(tags, index, index+1)
+		case !remove && index < 0:
+			tags = append(tags, name)
+		}
+	}
+	return tags, nil
+}
+
+// HasAnyTag reports whether the task has one of the tags, ignoring case
+func (t Task) HasAnyTag(tags []string) bool {
+	for _, tag := range t.Tags {
+		for _, wanted := range tags {
+			if strings.EqualFold(tag, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UpdateTaskTags replaces the tags of a task. Tags are resolved to IDs with
+// create_or_get_tag, which creates tags the account does not have yet.
+func (c *Client) UpdateTaskTags(boardID string, task Task, tags []string) (*Task, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	var tagsColumnID string
+	for _, column := range board.Columns {
+		if column.Type == "tags" {
+			tagsColumnID = column.ID
+			break
+		}
+	}
+	if tagsColumnID == "" {
+		return nil, fmt.Errorf("tags column %w in board", ErrNotFound)
+	}
+
+	value := TagsValue{TagIDs: []int64{}}
+	for _, tag := range tags {
+		id, err := c.createOrGetTag(boardID, tag)
+		if err != nil {
+			return nil, err
+		}
+		value.TagIDs = append(value.TagIDs, id)
+	}
+	encoded, err := encodeColumnValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	query := buildOperation("mutation", "UpdateTaskTags", "$boardId: ID!, $itemId: ID!, $columnId: String!, $value: JSON!",
+		newField("change_column_value", scalars("id")).withArgs("board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value"),
+	)
+	variables := map[string]interface{}{
+		"boardId":  boardID,
+		"itemId":   task.ID,
+		"columnId": tagsColumnID,
+		"value":    encoded,
+	}
+	if _, err := c.ExecuteQuery(query, variables); err != nil {
+		return nil, fmt.Errorf("failed to update tags: %w", err)
+	}
+
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
+	}
+	return updatedTask, nil
+}
+
+// createOrGetTag returns the ID of a tag, creating it when needed
+func (c *Client) createOrGetTag(boardID, name string) (int64, error) {
+	query := buildOperation("mutation", "CreateOrGetTag", "$tagName: String!, $boardId: ID!",
+		newField("create_or_get_tag", scalars("id")).withArgs("tag_name: $tagName, board_id: $boardId"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"tagName": name, "boardId": boardID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tag %s: %w", name, err)
+	}
+	var result struct {
+		CreateOrGetTag struct {
+			ID json.Number `json:"id"`
+		} `json:"create_or_get_tag"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal tag: %w", err)
+	}
+	id, err := result.CreateOrGetTag.ID.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid tag ID %q: %w", result.CreateOrGetTag.ID, err)
+	}
+	return id, nil
+}
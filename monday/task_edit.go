@@ -0,0 +1,324 @@
+package monday
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EditableTask holds the fields of a task edited as text with 'task edit --editor'. Values are
+// kept as written: labels, the assignee and the sprint by name, the due date as YYYY-MM-DD.
+type EditableTask struct {
+	Name     string
+	Status   string
+	Priority string
+	Type     string
+	Assignee string
+	Sprint   string
+	Due      string
+	Tags     []string
+}
+
+// editableFields are the keys of the text form, in the order they are written
+var editableFields = []string{"name", "status", "priority", "type", "assignee", "sprint", "due", "tags"}
+
+// NewEditableTask returns the editable fields of a task
+func NewEditableTask(task Task) EditableTask {
+	edit := EditableTask{
+		Name:     task.Name,
+		Status:   string(task.Status),
+		Priority: string(task.Priority),
+		Type:     string(task.Type),
+		Assignee: task.UserName,
+		Sprint:   task.Sprint,
+		Tags:     slices.Clone(task.Tags),
+	}
+	if !task.DueDate.IsZero() {
+		edit.Due = task.DueDate.Format("2006-01-02")
+	}
+	return edit
+}
+
+// field returns a pointer to the string field of a key, nil for tags and unknown keys
+func (e *EditableTask) field(key string) *string {
+	switch key {
+	case "name":
+		return &e.Name
+	case "status":
+		return &e.Status
+	case "priority":
+		return &e.Priority
+	case "type":
+		return &e.Type
+	case "assignee":
+		return &e.Assignee
+	case "sprint":
+		return &e.Sprint
+	case "due":
+		return &e.Due
+	}
+	return nil
+}
+
+// value returns the text of a field, tags comma separated
+func (e EditableTask) value(key string) string {
+	if key == "tags" {
+		return strings.Join(e.Tags, ", ")
+	}
+	return *e.field(key)
+}
+
+// Text writes the task as YAML, each line of header becomes a comment above the fields
+func (e EditableTask) Text(header []string) string {
+	var b strings.Builder
+	for _, line := range header {
+		b.WriteString(strings.TrimSpace("# "+line) + "\n")
+	}
+	if len(header) > 0 {
+		b.WriteString("\n")
+	}
+	for _, key := range editableFields {
+		b.WriteString(strings.TrimRight(key+": "+e.value(key), " ") + "\n")
+	}
+	return b.String()
+}
+
+// ParseEditableTask reads the text written by Text. Fields missing from the text keep their
+// value in base, empty ones are cleared. The name cannot be empty, the due date is left to
+// ParseDueDate.
+func ParseEditableTask(text string, base EditableTask) (EditableTask, error) {
+	fields, sections, err := parseSimpleYAML([]byte(text))
+	if err != nil {
+		return EditableTask{}, err
+	}
+	// An empty value reads as the start of a section, which only matters when it has entries
+	for key, entries := range sections {
+		if len(entries) > 0 {
+			return EditableTask{}, fmt.Errorf("%s: indented lines are not supported", key)
+		}
+		fields[key] = ""
+	}
+
+	edit := base
+	edit.Tags = slices.Clone(base.Tags)
+	for key, value := range fields {
+		key = strings.ToLower(key)
+		switch {
+		case key == "tags":
+			edit.Tags = nil
+			for _, tag := range strings.Split(value, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(edit.Tags, tag) {
+					edit.Tags = append(edit.Tags, tag)
+				}
+			}
+		case edit.field(key) != nil:
+			*edit.field(key) = value
+		default:
+			return EditableTask{}, fmt.Errorf("unknown field %q, use %s", key, strings.Join(editableFields, ", "))
+		}
+	}
+	if edit.Name == "" {
+		return EditableTask{}, fmt.Errorf("the name cannot be empty")
+	}
+	return edit, nil
+}
+
+// Validate checks the labels against the columns of the board and returns the task with the
+// labels spelled as on the board. Empty labels clear the column.
+func (e EditableTask) Validate(board *Board) (EditableTask, error) {
+	statusColumn, priorityColumn, typeColumn := FindLabelColumns(board)
+	for _, label := range []struct {
+		column *Column
+		value  *string
+	}{
+		{statusColumn, &e.Status},
+		{priorityColumn, &e.Priority},
+		{typeColumn, &e.Type},
+	} {
+		if label.column == nil || *label.value == "" {
+			continue
+		}
+		valid, err := ValidateLabel(*label.column, *label.value)
+		if err != nil {
+			return EditableTask{}, err
+		}
+		*label.value = valid
+	}
+	return e, nil
+}
+
+// DiffEditableTasks returns the fields that differ, in the order of the text form
+func DiffEditableTasks(before, after EditableTask) []FieldChange {
+	var changes []FieldChange
+	for _, key := range editableFields {
+		if old, new := before.value(key), after.value(key); old != new {
+			changes = append(changes, FieldChange{Field: key, Old: old, New: new})
+		}
+	}
+	return changes
+}
+
+// TaskEdit holds the new values of the changed fields of a task for EditTask, nil fields are
+// kept. Empty values clear the column: no assignee, sprint, due date or label.
+type TaskEdit struct {
+	Name       *string
+	Status     *string
+	Priority   *string
+	Type       *string
+	AssigneeID *string
+	SprintID   *string
+	DueDate    *time.Time
+	Tags       *[]string
+}
+
+// clearedValue empties a column in change_multiple_column_values
+var clearedValue = struct{}{}
+
+// EditTask changes only the given fields of a task with a single change_multiple_column_values
+// mutation, labels are validated against the board first. Tags are resolved to IDs with
+// create_or_get_tag like UpdateTaskTags.
+func (c *Client) EditTask(boardID string, task Task, edit TaskEdit) (*Task, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	values, err := editColumnValues(board, edit)
+	if err != nil {
+		return nil, err
+	}
+	if edit.Tags != nil {
+		index := slices.IndexFunc(board.Columns, func(column Column) bool { return column.Type == "tags" })
+		if index < 0 {
+			return nil, fmt.Errorf("tags column %w in board", ErrNotFound)
+		}
+		value := TagsValue{TagIDs: []int64{}}
+		for _, tag := range *edit.Tags {
+			id, err := c.createOrGetTag(boardID, tag)
+			if err != nil {
+				return nil, err
+			}
+			value.TagIDs = append(value.TagIDs, id)
+		}
+		values[board.Columns[index].ID] = value
+	}
+	if len(values) == 0 {
+		return &task, nil
+	}
+
+	if err := c.changeColumnValues(boardID, task.ID, values); err != nil {
+		return nil, err
+	}
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated task: %w", err)
+	}
+	return updatedTask, nil
+}
+
+// RenameTask changes the name of a task, the name column of change_multiple_column_values.
+// Surrounding spaces are removed and the name cannot be empty.
+func (c *Client) RenameTask(boardID string, task Task, name string) (*Task, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("the name of a task cannot be empty")
+	}
+	if err := c.changeColumnValues(boardID, task.ID, ColumnValues{"name": name}); err != nil {
+		return nil, err
+	}
+	updatedTask, err := c.GetTaskByID(task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch renamed task: %w", err)
+	}
+	return updatedTask, nil
+}
+
+// editColumnValues returns the column values of the fields of an edit except the tags
+func editColumnValues(board *Board, edit TaskEdit) (ColumnValues, error) {
+	values := ColumnValues{}
+	if edit.Name != nil {
+		values["name"] = *edit.Name
+	}
+
+	statusColumn, priorityColumn, typeColumn := FindLabelColumns(board)
+	for _, label := range []struct {
+		name   string
+		column *Column
+		value  *string
+	}{
+		{"status", statusColumn, edit.Status},
+		{"priority", priorityColumn, edit.Priority},
+		{"type", typeColumn, edit.Type},
+	} {
+		switch {
+		case label.value == nil:
+		case label.column == nil:
+			return nil, fmt.Errorf("%s column %w on board %s", label.name, ErrNotFound, board.ID)
+		default:
+			// Labels are matched by column title, the type may be status or dropdown
+			value, err := columnTypeHandlers["status"].Mutate(*label.column, *label.value)
+			if err != nil {
+				return nil, err
+			}
+			values[label.column.ID] = value
+		}
+	}
+
+	if edit.AssigneeID != nil {
+		values["task_owner"] = clearedValue
+		if *edit.AssigneeID != "" {
+			if err := values.SetColumn(Column{ID: "task_owner", Type: "people"}, *edit.AssigneeID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if edit.SprintID != nil {
+		column := findColumn(board, "board_relation", "sprint")
+		if column == nil {
+			return nil, fmt.Errorf("sprint column %w on board %s", ErrNotFound, board.ID)
+		}
+		values[column.ID] = RelationValue{ItemIDs: []int64{}}
+		if *edit.SprintID != "" {
+			sprint, err := NewRelationValue(*edit.SprintID)
+			if err != nil {
+				return nil, err
+			}
+			values[column.ID] = sprint
+		}
+	}
+	if edit.DueDate != nil {
+		column := findColumn(board, "date", "due", "deadline")
+		if column == nil {
+			return nil, fmt.Errorf("due date column %w on board %s", ErrNotFound, board.ID)
+		}
+		values[column.ID] = clearedValue
+		if !edit.DueDate.IsZero() {
+			values[column.ID] = NewDateValue(*edit.DueDate)
+		}
+	}
+	return values, nil
+}
+
+// EditHints returns a line per label column listing its labels, as hints for the text form
+func EditHints(board *Board) []string {
+	var hints []string
+	statusColumn, priorityColumn, typeColumn := FindLabelColumns(board)
+	for _, label := range []struct {
+		name   string
+		column *Column
+	}{
+		{"status", statusColumn},
+		{"priority", priorityColumn},
+		{"type", typeColumn},
+	} {
+		if label.column == nil {
+			continue
+		}
+		settings, err := ParseColumnSettings(*label.column)
+		if err != nil || len(settings.LabelNames()) == 0 {
+			continue
+		}
+		hints = append(hints, fmt.Sprintf("%s: %s", label.name, strings.Join(settings.LabelNames(), ", ")))
+	}
+	return hints
+}
@@ -0,0 +1,45 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GetTeams retrieves the teams of the account
+func (c *Client) GetTeams() ([]Team, error) {
+	query := buildQuery("GetTeams", "", newField("teams", scalars("id", "name")))
+	resp, err := c.ExecuteQuery(query, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Teams []Team `json:"teams"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal teams: %w", err)
+	}
+	return result.Teams, nil
+}
+
+// FindTeam returns the team with the given name or ID, names are compared ignoring case
+func FindTeam(teams []Team, nameOrID string) (Team, error) {
+	for _, team := range teams {
+		if team.ID == nameOrID || strings.EqualFold(team.Name, nameOrID) {
+			return team, nil
+		}
+	}
+	return Team{}, fmt.Errorf("team %q %w", nameOrID, ErrNotFound)
+}
+
+// HasAnyTeam reports whether one of the given teams is assigned, ignoring case
+func (t Task) HasAnyTeam(teams []string) bool {
+	for _, team := range t.Teams {
+		for _, wanted := range teams {
+			if strings.EqualFold(team, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
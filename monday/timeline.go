@@ -0,0 +1,67 @@
+package monday
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// isTimelineColumn reports whether a column ID belongs to a timeline column, e.g. "timeline"
+func isTimelineColumn(columnID string) bool {
+	return strings.Contains(strings.ToLower(columnID), "timeline")
+}
+
+// parseTimelineColumn returns the first and last day of a timeline column, e.g.
+// "{\"from\":\"2024-01-01\",\"to\":\"2024-01-14\"}", or zero times
+func parseTimelineColumn(cv ColumnValue) (from, to time.Time) {
+	var jsonStr string
+	if err := json.Unmarshal(cv.Value, &jsonStr); err != nil {
+		return time.Time{}, time.Time{}
+	}
+	var timeline struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &timeline); err != nil {
+		return time.Time{}, time.Time{}
+	}
+	from, _ = time.ParseInLocation("2006-01-02", timeline.From, time.Local)
+	to, _ = time.ParseInLocation("2006-01-02", timeline.To, time.Local)
+	return from, to
+}
+
+// HasTimeline reports whether the task has a start and an end date
+func (t Task) HasTimeline() bool {
+	return !t.StartDate.IsZero() && !t.EndDate.IsZero()
+}
+
+// IsOverdue reports whether an unfinished task ended before the day of now
+func (t Task) IsOverdue(now time.Time) bool {
+	if t.EndDate.IsZero() || IsDoneStatus(t.Status) {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return t.EndDate.Before(today)
+}
+
+// TimelineTasks returns the tasks with a timeline ordered by start date, then end date and name
+func TimelineTasks(tasks []Task) []Task {
+	var timeline []Task
+	for _, task := range tasks {
+		if task.HasTimeline() {
+			timeline = append(timeline, task)
+		}
+	}
+	sort.Slice(timeline, func(i, j int) bool {
+		a, b := timeline[i], timeline[j]
+		if !a.StartDate.Equal(b.StartDate) {
+			return a.StartDate.Before(b.StartDate)
+		}
+		if !a.EndDate.Equal(b.EndDate) {
+			return a.EndDate.Before(b.EndDate)
+		}
+		return a.Name < b.Name
+	})
+	return timeline
+}
@@ -0,0 +1,124 @@
+package monday
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// TraceMaxSize is the size in bytes after which the trace file is rotated
+const TraceMaxSize = 5 * 1024 * 1024
+
+// redacted replaces secrets like the API key in trace files
+const redacted = "[REDACTED]"
+
+// GetTracePath returns the path to the request trace file
+func GetTracePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".cache", "monday-cli", "trace.log"), nil
+}
+
+// tracingTransport records every request and response passing through it to a trace file
+type tracingTransport struct {
+	next    Transport
+	path    string
+	maxSize int64
+	mu      sync.Mutex
+}
+
+// EnableTracing records all GraphQL requests and responses to the file at path.
+// The Authorization header is redacted, when the file grows past TraceMaxSize it is
+// moved to path + ".1" and a new file is started.
+func (c *Client) EnableTracing(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create trace directory: %w", err)
+	}
+	c.transport = &tracingTransport{next: c.transport, path: path, maxSize: TraceMaxSize}
+	logger.Debug("tracing enabled", "path", path)
+	return nil
+}
+
+// Do sends the request and writes both sides of the exchange to the trace file
+func (t *tracingTransport) Do(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	var entry bytes.Buffer
+	fmt.Fprintf(&entry, "=== %s %s %s\n", time.Now().Format(time.RFC3339), req.Method, req.URL)
+	writeTraceHeaders(&entry, req.Header)
+	entry.Write(reqBody)
+	entry.WriteString("\n")
+
+	start := time.Now()
+	resp, err := t.next.Do(req)
+	if err != nil {
+		fmt.Fprintf(&entry, "--- error after %s: %v\n\n", time.Since(start), err)
+		t.write(entry.Bytes())
+		return nil, err
+	}
+
+	respBody, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	fmt.Fprintf(&entry, "--- %s in %s\n", resp.Status, time.Since(start))
+	writeTraceHeaders(&entry, resp.Header)
+	entry.Write(respBody)
+	entry.WriteString("\n\n")
+	t.write(entry.Bytes())
+
+	if readErr != nil {
+		return nil, readErr
+	}
+	return resp, nil
+}
+
+// writeTraceHeaders writes headers one per line with secrets redacted
+func writeTraceHeaders(w io.Writer, header http.Header) {
+	for name, values := range header {
+		for _, value := range values {
+			if http.CanonicalHeaderKey(name) == "Authorization" {
+				value = redacted
+			}
+			fmt.Fprintf(w, "%s: %s\n", name, value)
+		}
+	}
+}
+
+// write appends an entry to the trace file, rotating it first when it is too large
+func (t *tracingTransport) write(entry []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if info, err := os.Stat(t.path); err == nil && info.Size()+int64(len(entry)) > t.maxSize {
+		if err := os.Rename(t.path, t.path+".1"); err != nil {
+			logger.Warn("failed to rotate trace file", "error", err)
+		}
+	}
+
+	file, err := os.OpenFile(t.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		logger.Warn("failed to open trace file", "error", err)
+		return
+	}
+	defer file.Close()
+	if _, err := file.Write(entry); err != nil {
+		logger.Warn("failed to write trace file", "error", err)
+	}
+}
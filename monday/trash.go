@@ -0,0 +1,142 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TrashWindow is how long Monday keeps deleted items before they are gone for good
+const TrashWindow = 30 * 24 * time.Hour
+
+// trashPageSize is the number of items requested by ID per query
+const trashPageSize = 100
+
+// TrashedItem is an item archived or deleted from a board that is still archived or deleted
+type TrashedItem struct {
+	Item   Item
+	At     time.Time // When it was archived or deleted
+	UserID string    // Who archived or deleted it
+}
+
+// GetTrashedItems returns the items archived or deleted from a board since the given time,
+// newest first. The activity log names the removed items and their state is read with an
+// items query, so items restored or unarchived on Monday since are left out.
+func (c *Client) GetTrashedItems(boardID string, since time.Time) ([]TrashedItem, error) {
+	logs, err := c.GetActivityLogs(boardID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity logs of board %s: %w", boardID, err)
+	}
+	removed := make(map[string]TrashedItem)
+	var ids []string
+	events := DecodeActivityLogs(logs)
+	for i := len(events) - 1; i >= 0; i-- {
+		info := events[i].Info()
+		if _, ok := events[i].(ItemDeleted); !ok || info.ItemID == "" {
+			continue
+		}
+		if _, seen := removed[info.ItemID]; !seen {
+			removed[info.ItemID] = TrashedItem{At: info.At, UserID: info.UserID}
+			ids = append(ids, info.ItemID)
+		}
+	}
+
+	items, err := c.getItemsWithState(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]Item, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	var trashed []TrashedItem
+	for _, id := range ids {
+		item, ok := byID[id]
+		if !ok || (item.State != "archived" && item.State != "deleted") {
+			continue
+		}
+		entry := removed[id]
+		entry.Item = item
+		trashed = append(trashed, entry)
+	}
+	return trashed, nil
+}
+
+// getItemsWithState fetches items by ID with their state, board, group and typed column values
+func (c *Client) getItemsWithState(ids []string) ([]Item, error) {
+	itemFields := append(itemFragment(columnValueFragment),
+		newField("board", scalars("id", "name")),
+		newField("group", scalars("id", "title")),
+		field{name: "state"},
+	)
+	query := buildQuery("GetItemsWithState", "$itemIds: [ID!], $limit: Int!",
+		newField("items", itemFields).withArgs("ids: $itemIds, limit: $limit"),
+	)
+	var items []Item
+	for start := 0; start < len(ids); start += trashPageSize {
+		page := ids[start:min(start+trashPageSize, len(ids))]
+		resp, err := c.ExecuteQuery(query, map[string]interface{}{"itemIds": page, "limit": len(page)})
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Items []Item `json:"items"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal items: %w", err)
+		}
+		items = append(items, result.Items...)
+	}
+	return items, nil
+}
+
+// GetTrashedItem fetches an item by ID with its state, group and column values. Items deleted
+// longer than TrashWindow ago are not found.
+func (c *Client) GetTrashedItem(itemID string) (*Item, error) {
+	items, err := c.getItemsWithState([]string{itemID})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("item %s %w", itemID, ErrNotFound)
+	}
+	return &items[0], nil
+}
+
+// RestoreTrashedItem brings back an archived or deleted item. The API cannot unarchive items,
+// so the item is created again in its group with the values of its writable columns, like
+// 'board restore' does from a snapshot. The new item has a new ID; updates and files are not
+// copied.
+func (c *Client) RestoreTrashedItem(boardID string, item Item) (*Task, error) {
+	board, err := c.GetBoard(boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	columns := make(map[string]Column, len(board.Columns))
+	for _, column := range board.Columns {
+		columns[column.ID] = column
+	}
+	input := ItemInput{Name: item.Name, Values: ColumnValues{}}
+	if item.Group != nil {
+		input.GroupID = item.Group.ID
+	}
+	for _, cv := range item.ColumnValues {
+		column, ok := columns[cv.ID]
+		if !ok || readOnlyColumnTypes[column.Type] {
+			continue
+		}
+		if value, set := writableValue(column, cv); set {
+			input.Values[column.ID] = value
+		}
+	}
+	ids, err := c.CreateItems(boardID, []ItemInput{input}, 1)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("restored item", "board", boardID, "item", item.ID, "new_item", ids[0])
+	task, err := c.GetTaskByID(ids[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch restored task: %w", err)
+	}
+	return task, nil
+}
@@ -0,0 +1,73 @@
+package monday
+
+import (
+	"strings"
+	"time"
+)
+
+// UpdaterWindow is how far back the activity log is read for the last updaters of tasks and
+// the time they entered their status
+const UpdaterWindow = 14 * 24 * time.Hour
+
+// UserRef names a user found in the activity log, the name is empty for unknown users
+type UserRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// SetTaskUpdaters sets who last changed each task and who changed it at all from the
+// activity events of the board, oldest first. Users are named from the user directory.
+func SetTaskUpdaters(tasks []Task, events []ActivityEvent, users map[string]User) {
+	indexes := make(map[string]int, len(tasks))
+	for i, task := range tasks {
+		indexes[task.ID] = i
+	}
+	for _, event := range events {
+		info := event.Info()
+		i, ok := indexes[info.ItemID]
+		if !ok || info.UserID == "" {
+			continue
+		}
+		ref := UserRef{ID: info.UserID, Name: users[info.UserID].Name}
+		task := &tasks[i]
+		task.UpdaterID, task.UpdaterName = ref.ID, ref.Name
+		touched := false
+		for _, user := range task.TouchedBy {
+			touched = touched || user.ID == ref.ID
+		}
+		if !touched {
+			task.TouchedBy = append(task.TouchedBy, ref)
+		}
+	}
+}
+
+// SetTaskActivity reads the activity log of a board since the given time and sets the last
+// updater of the tasks and when they entered their status, see SetTaskUpdaters and
+// SetStatusSince. Unknown users keep only their ID.
+func (as *AnalyticsService) SetTaskActivity(boardID string, tasks []Task, since time.Time) error {
+	events, err := as.BoardActivity(boardID, since)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		userIDs = append(userIDs, event.Info().UserID)
+	}
+	users, _ := as.dataStore.ResolveUsers(as.client, userIDs)
+	SetTaskUpdaters(tasks, events, users)
+	SetStatusSince(tasks, events, since)
+	return nil
+}
+
+// TouchedByAny reports whether one of the given users changed the task within the activity
+// window of the last fetch, users are matched by name ignoring case or by user ID
+func (t Task) TouchedByAny(users []string) bool {
+	for _, touched := range t.TouchedBy {
+		for _, user := range users {
+			if user == touched.ID || (touched.Name != "" && strings.EqualFold(user, touched.Name)) {
+				return true
+			}
+		}
+	}
+	return false
+}
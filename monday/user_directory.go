@@ -0,0 +1,225 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// userDirectoryMaxAge is how long the user directory is used before it is refreshed
+	userDirectoryMaxAge = 24 * time.Hour
+	// userDirectoryMinAge avoids refetching on every call when an ID is not in the account (e.g. deleted users)
+	userDirectoryMinAge = 10 * time.Minute
+)
+
+// UserDirectory is the account-wide user cache, mapping user ID to User
+type UserDirectory struct {
+	Users     map[string]User `json:"users"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// IsStale reports whether the directory should be refreshed
+func (ud *UserDirectory) IsStale() bool {
+	return len(ud.Users) == 0 || time.Since(ud.Timestamp) > userDirectoryMaxAge
+}
+
+// GetAllUsers retrieves all users of the account using pagination
+func (c *Client) GetAllUsers() ([]User, error) {
+	var allUsers []User
+	page := 1
+	limit := 100
+
+	for {
+		query := buildQuery("GetUsers", "$limit: Int!, $page: Int!",
+			newField("users", userFragment).withArgs("limit: $limit, page: $page"),
+		)
+
+		variables := map[string]interface{}{
+			"limit": limit,
+			"page":  page,
+		}
+
+		resp, err := c.ExecuteQuery(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Users []User `json:"users"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+		}
+
+		allUsers = append(allUsers, result.Users...)
+		if len(result.Users) < limit {
+			break
+		}
+		page++
+	}
+
+	return allUsers, nil
+}
+
+// GetUsersByIDs retrieves the given users of the account
+func (c *Client) GetUsersByIDs(userIDs []string) ([]User, error) {
+	query := buildQuery("GetUsersByIDs", "$ids: [ID!], $limit: Int!",
+		newField("users", userFragment).withArgs("ids: $ids, limit: $limit"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"ids": userIDs, "limit": len(userIDs)})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Users []User `json:"users"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+	}
+	return result.Users, nil
+}
+
+// SearchUsers retrieves the users whose name matches on Monday's side
+func (c *Client) SearchUsers(name string) ([]User, error) {
+	query := buildQuery("SearchUsers", "$name: String!",
+		newField("users", userFragment).withArgs("name: $name"),
+	)
+	resp, err := c.ExecuteQuery(query, map[string]interface{}{"name": name})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Users []User `json:"users"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+	}
+	return result.Users, nil
+}
+
+// Search returns the users whose name, email, title or team contains the query, ignoring case,
+// sorted by name
+func (ud *UserDirectory) Search(query string) []User {
+	query = strings.ToLower(strings.TrimSpace(query))
+	var matches []User
+	for _, user := range ud.Users {
+		if userMatches(user, query) {
+			matches = append(matches, user)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return strings.ToLower(matches[i].Name) < strings.ToLower(matches[j].Name)
+	})
+	return matches
+}
+
+// userMatches reports whether a lower case query is part of the user's name, email, title or teams
+func userMatches(user User, query string) bool {
+	for _, text := range []string{user.Name, user.Email, user.Title} {
+		if strings.Contains(strings.ToLower(text), query) {
+			return true
+		}
+	}
+	for _, team := range user.Teams {
+		if strings.Contains(strings.ToLower(team.Name), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// userDirectoryKey is the store metadata key of the user directory
+const userDirectoryKey = "users"
+
+// GetUserDirectory loads the cached user directory, returning an empty one if none exists
+func (ds *DataStore) GetUserDirectory() (*UserDirectory, error) {
+	directory := &UserDirectory{Users: make(map[string]User)}
+
+	data, err := ds.store.GetMeta(userDirectoryKey)
+	if err != nil {
+		return directory, fmt.Errorf("failed to read user directory: %w", err)
+	}
+	if data == nil {
+		return directory, nil // Not an error if the directory doesn't exist yet
+	}
+
+	if err := json.Unmarshal(data, directory); err != nil {
+		return &UserDirectory{Users: make(map[string]User)}, fmt.Errorf("failed to unmarshal user directory: %w", err)
+	}
+	if directory.Users == nil {
+		directory.Users = make(map[string]User)
+	}
+	return directory, nil
+}
+
+// StoreUserDirectory replaces the cached user directory
+func (ds *DataStore) StoreUserDirectory(users []User) (*UserDirectory, error) {
+	directory := &UserDirectory{
+		Users:     make(map[string]User),
+		Timestamp: time.Now(),
+	}
+	for _, user := range users {
+		directory.Users[user.ID] = user
+	}
+
+	data, err := json.Marshal(directory)
+	if err != nil {
+		return directory, fmt.Errorf("failed to marshal user directory: %w", err)
+	}
+	if err := ds.store.PutMeta(userDirectoryKey, data); err != nil {
+		return directory, fmt.Errorf("failed to write user directory: %w", err)
+	}
+	return directory, nil
+}
+
+// RefreshUserDirectory fetches all account users and stores them in the directory
+func (ds *DataStore) RefreshUserDirectory(client *Client) (*UserDirectory, error) {
+	users, err := client.GetAllUsers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	return ds.StoreUserDirectory(users)
+}
+
+// ResolveUsers returns the directory entries for the given user IDs.
+// The directory is only refreshed from the API when it is stale or an ID is unknown.
+func (ds *DataStore) ResolveUsers(client *Client, userIDs []string) (map[string]User, error) {
+	directory, err := ds.GetUserDirectory()
+	if err != nil {
+		logger.Warn("failed to load user directory", "error", err)
+	}
+
+	needsRefresh := directory.IsStale()
+	if !needsRefresh && time.Since(directory.Timestamp) > userDirectoryMinAge {
+		for _, id := range userIDs {
+			if _, exists := directory.Users[id]; !exists {
+				needsRefresh = true
+				break
+			}
+		}
+	}
+
+	if needsRefresh {
+		logger.Debug("refreshing user directory", "cached_users", len(directory.Users))
+		refreshed, err := ds.RefreshUserDirectory(client)
+		if err != nil {
+			// Fall back to whatever is cached
+			if len(directory.Users) == 0 {
+				return nil, err
+			}
+		} else {
+			directory = refreshed
+		}
+	}
+
+	resolved := make(map[string]User)
+	for _, id := range userIDs {
+		if user, exists := directory.Users[id]; exists {
+			resolved[id] = user
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,55 @@
+package monday
+
+import (
+	"fmt"
+	"sort"
+)
+
+// View is a named snapshot of the filters and sort order, e.g. "standup" or "all bugs"
+type View struct {
+	Filters Filters `json:"filters"`
+	Sort    string  `json:"sort,omitempty"` // Comma separated sort fields, empty for the default order
+}
+
+// SaveView stores the current filters under a name, replacing an existing view
+func (c *Config) SaveView(name, sortOrder string) {
+	if c.Views == nil {
+		c.Views = make(map[string]View)
+	}
+	c.Views[name] = View{Filters: c.Filters.Clone(), Sort: sortOrder}
+}
+
+// GetView returns the view with the given name
+func (c *Config) GetView(name string) (View, bool) {
+	view, ok := c.Views[name]
+	return view, ok
+}
+
+// DeleteView removes a view
+func (c *Config) DeleteView(name string) error {
+	if _, ok := c.Views[name]; !ok {
+		return fmt.Errorf("view %s %w", name, ErrNotFound)
+	}
+	delete(c.Views, name)
+	return nil
+}
+
+// UseView replaces the current filters with the ones saved in a view
+func (c *Config) UseView(name string) error {
+	view, ok := c.Views[name]
+	if !ok {
+		return fmt.Errorf("view %s %w", name, ErrNotFound)
+	}
+	c.Filters = view.Filters.Clone()
+	return nil
+}
+
+// ViewNames returns the names of all views in alphabetical order
+func (c *Config) ViewNames() []string {
+	names := make([]string, 0, len(c.Views))
+	for name := range c.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
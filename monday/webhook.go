@@ -0,0 +1,144 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// WebhookEventType represents a Monday.com webhook event subscription type
+type WebhookEventType string
+
+const (
+	WebhookCreateItem        WebhookEventType = "create_item"
+	WebhookChangeColumnValue WebhookEventType = "change_column_value"
+	WebhookChangeName        WebhookEventType = "change_name"
+	WebhookItemDeleted       WebhookEventType = "item_deleted"
+	WebhookItemArchived      WebhookEventType = "item_archived"
+)
+
+// BoardWebhookEvents are the events needed to keep the local cache up to date
+var BoardWebhookEvents = []WebhookEventType{
+	WebhookCreateItem,
+	WebhookChangeColumnValue,
+	WebhookChangeName,
+	WebhookItemDeleted,
+	WebhookItemArchived,
+}
+
+// Webhook represents a webhook subscription on a board
+type Webhook struct {
+	ID      string           `json:"id"`
+	BoardID string           `json:"board_id"`
+	Event   WebhookEventType `json:"event"`
+	Config  string           `json:"config,omitempty"` // JSON settings of the event, e.g. {"columnId":"status"}
+}
+
+// ConfigColumnID returns the column a column change webhook is limited to, if any
+func (w Webhook) ConfigColumnID() string {
+	var config struct {
+		ColumnID string `json:"columnId"`
+	}
+	if w.Config == "" || json.Unmarshal([]byte(w.Config), &config) != nil {
+		return ""
+	}
+	return config.ColumnID
+}
+
+// WebhookPayload represents the body Monday.com posts to a webhook URL.
+// The first request only carries a challenge that has to be echoed back.
+type WebhookPayload struct {
+	Challenge string       `json:"challenge,omitempty"`
+	Event     WebhookEvent `json:"event"`
+}
+
+// WebhookEvent represents a single board event delivered by a webhook
+type WebhookEvent struct {
+	Type     string `json:"type"`
+	BoardID  int64  `json:"boardId"`
+	PulseID  int64  `json:"pulseId"`
+	ColumnID string `json:"columnId,omitempty"`
+}
+
+// ItemID returns the item ID the event refers to
+func (e WebhookEvent) ItemID() string {
+	if e.PulseID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", e.PulseID)
+}
+
+// IsRemoval reports whether the event removes the item from the board
+func (e WebhookEvent) IsRemoval() bool {
+	eventType := strings.ToLower(e.Type)
+	return strings.Contains(eventType, "delete") || strings.Contains(eventType, "archive")
+}
+
+// CreateWebhook subscribes the given URL to an event on a board
+func (c *Client) CreateWebhook(boardID, url string, event WebhookEventType) (*Webhook, error) {
+	query := buildOperation("mutation", "CreateWebhook", "$boardId: ID!, $url: String!, $event: WebhookEventType!",
+		newField("create_webhook", scalars("id", "board_id")).withArgs("board_id: $boardId, url: $url, event: $event"),
+	)
+
+	variables := map[string]interface{}{
+		"boardId": boardID,
+		"url":     url,
+		"event":   string(event),
+	}
+
+	resp, err := c.ExecuteQuery(query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	var result struct {
+		CreateWebhook Webhook `json:"create_webhook"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook: %w", err)
+	}
+	result.CreateWebhook.Event = event
+
+	return &result.CreateWebhook, nil
+}
+
+// DeleteWebhook removes a webhook subscription
+func (c *Client) DeleteWebhook(webhookID string) error {
+	query := buildOperation("mutation", "DeleteWebhook", "$id: ID!",
+		newField("delete_webhook", scalars("id")).withArgs("id: $id"),
+	)
+
+	variables := map[string]interface{}{
+		"id": webhookID,
+	}
+
+	if _, err := c.ExecuteQuery(query, variables); err != nil {
+		return fmt.Errorf("failed to delete webhook %s: %w", webhookID, err)
+	}
+	return nil
+}
+
+// ListWebhooks returns the webhook subscriptions of a board: those of integrations and apps
+// as well as the ones of serve. Automation recipes are not exposed by the API.
+func (c *Client) ListWebhooks(boardID string) ([]Webhook, error) {
+	query := buildQuery("ListWebhooks", "$boardId: ID!",
+		newField("webhooks", scalars("id", "event", "board_id", "config")).withArgs("board_id: $boardId"),
+	)
+
+	variables := map[string]interface{}{
+		"boardId": boardID,
+	}
+
+	resp, err := c.ExecuteQuery(query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks of board %s: %w", boardID, err)
+	}
+
+	var result struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhooks: %w", err)
+	}
+	return result.Webhooks, nil
+}
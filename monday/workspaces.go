@@ -0,0 +1,84 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// workspacesPageSize is the number of workspaces or boards requested per page
+const workspacesPageSize = 100
+
+// GetWorkspaces retrieves all workspaces of the account using pagination
+func (c *Client) GetWorkspaces() ([]Workspace, error) {
+	var workspaces []Workspace
+	for page := 1; ; page++ {
+		query := buildQuery("GetWorkspaces", "$limit: Int!, $page: Int!",
+			newField("workspaces", scalars("id", "name", "kind", "description", "state")).withArgs("limit: $limit, page: $page"),
+		)
+		resp, err := c.ExecuteQuery(query, map[string]interface{}{"limit": workspacesPageSize, "page": page})
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Workspaces []Workspace `json:"workspaces"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workspaces: %w", err)
+		}
+		workspaces = append(workspaces, result.Workspaces...)
+		if len(result.Workspaces) < workspacesPageSize {
+			return workspaces, nil
+		}
+	}
+}
+
+// GetWorkspaceBoards retrieves the active boards of a workspace using pagination
+func (c *Client) GetWorkspaceBoards(workspaceID string) ([]Board, error) {
+	var boards []Board
+	for page := 1; ; page++ {
+		query := buildQuery("GetWorkspaceBoards", "$workspaceId: ID!, $limit: Int!, $page: Int!",
+			newField("boards", scalars("id", "name", "description", "state", "updated_at", "type", "workspace_id", "items_count")).
+				withArgs("workspace_ids: [$workspaceId], state: active, limit: $limit, page: $page"),
+		)
+		variables := map[string]interface{}{"workspaceId": workspaceID, "limit": workspacesPageSize, "page": page}
+		resp, err := c.ExecuteQuery(query, variables)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Boards []Board `json:"boards"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal boards: %w", err)
+		}
+		boards = append(boards, result.Boards...)
+		if len(result.Boards) < workspacesPageSize {
+			return boards, nil
+		}
+	}
+}
+
+// ListBoards retrieves the active boards of all workspaces the user can access using pagination
+func (c *Client) ListBoards() ([]Board, error) {
+	var boards []Board
+	for page := 1; ; page++ {
+		query := buildQuery("ListBoards", "$limit: Int!, $page: Int!",
+			newField("boards", scalars("id", "name", "description", "state", "updated_at", "type", "workspace_id", "items_count")).
+				withArgs("state: active, limit: $limit, page: $page"),
+		)
+		resp, err := c.ExecuteQuery(query, map[string]interface{}{"limit": workspacesPageSize, "page": page})
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Boards []Board `json:"boards"`
+		}
+		if err := json.Unmarshal(resp.Data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal boards: %w", err)
+		}
+		boards = append(boards, result.Boards...)
+		if len(result.Boards) < workspacesPageSize {
+			return boards, nil
+		}
+	}
+}